@@ -0,0 +1,530 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ack implements a garbage-collected and snapshottable store of alert
+// acknowledgements, which is replicated across the cluster. An
+// acknowledgement records that someone is looking into a firing alert without
+// silencing it.
+package ack
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/matttproud/golang_protobuf_extensions/pbutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+
+	pb "github.com/prometheus/alertmanager/ack/ackpb"
+	"github.com/prometheus/alertmanager/cluster"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// ErrNotFound is returned if an alert has not been acknowledged.
+var ErrNotFound = errors.New("acknowledgement not found")
+
+// ErrInvalidState is returned if the state isn't valid.
+var ErrInvalidState = errors.New("invalid state")
+
+// Acknowledgements holds the acknowledgements of alerts.
+type Acknowledgements struct {
+	clock clock.Clock
+
+	logger    log.Logger
+	metrics   *metrics
+	retention time.Duration
+
+	mtx       sync.RWMutex
+	st        state
+	broadcast func([]byte)
+}
+
+// MaintenanceFunc represents the function to run as part of the periodic maintenance for acknowledgements.
+// It returns the size of the snapshot taken or an error if it failed.
+type MaintenanceFunc func() (int64, error)
+
+type metrics struct {
+	gcDuration              prometheus.Summary
+	snapshotDuration        prometheus.Summary
+	snapshotSize            prometheus.Gauge
+	propagatedMessagesTotal prometheus.Counter
+	maintenanceTotal        prometheus.Counter
+	maintenanceErrorsTotal  prometheus.Counter
+}
+
+func newMetrics(r prometheus.Registerer) *metrics {
+	m := &metrics{}
+
+	m.gcDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "alertmanager_acknowledgements_gc_duration_seconds",
+		Help:       "Duration of the last acknowledgements garbage collection cycle.",
+		Objectives: map[float64]float64{},
+	})
+	m.snapshotDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "alertmanager_acknowledgements_snapshot_duration_seconds",
+		Help:       "Duration of the last acknowledgements snapshot.",
+		Objectives: map[float64]float64{},
+	})
+	m.snapshotSize = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "alertmanager_acknowledgements_snapshot_size_bytes",
+		Help: "Size of the last acknowledgements snapshot in bytes.",
+	})
+	m.propagatedMessagesTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "alertmanager_acknowledgements_gossip_messages_propagated_total",
+		Help: "Number of received gossip messages that have been further gossiped.",
+	})
+	m.maintenanceTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "alertmanager_acknowledgements_maintenance_total",
+		Help: "How many maintenances were executed for acknowledgements.",
+	})
+	m.maintenanceErrorsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "alertmanager_acknowledgements_maintenance_errors_total",
+		Help: "How many maintenances were executed for acknowledgements that failed.",
+	})
+
+	if r != nil {
+		r.MustRegister(
+			m.gcDuration,
+			m.snapshotDuration,
+			m.snapshotSize,
+			m.propagatedMessagesTotal,
+			m.maintenanceTotal,
+			m.maintenanceErrorsTotal,
+		)
+	}
+	return m
+}
+
+// Options configures a new Acknowledgements implementation.
+type Options struct {
+	SnapshotReader io.Reader
+	SnapshotFile   string
+
+	// Retention is how long acknowledgements are kept after they were last
+	// updated.
+	Retention time.Duration
+
+	Logger  log.Logger
+	Metrics prometheus.Registerer
+}
+
+func (o *Options) validate() error {
+	if o.SnapshotFile != "" && o.SnapshotReader != nil {
+		return errors.New("only one of SnapshotFile and SnapshotReader must be set")
+	}
+	return nil
+}
+
+// New creates a new acknowledgements store based on the provided options.
+// The snapshot is loaded into the store if it is set.
+func New(o Options) (*Acknowledgements, error) {
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+
+	a := &Acknowledgements{
+		clock:     clock.New(),
+		retention: o.Retention,
+		logger:    log.NewNopLogger(),
+		st:        state{},
+		broadcast: func([]byte) {},
+		metrics:   newMetrics(o.Metrics),
+	}
+
+	if o.Logger != nil {
+		a.logger = o.Logger
+	}
+
+	if o.SnapshotFile != "" {
+		if r, err := os.Open(o.SnapshotFile); err != nil {
+			if !os.IsNotExist(err) {
+				return nil, err
+			}
+			level.Debug(a.logger).Log("msg", "acknowledgements snapshot file doesn't exist", "err", err)
+		} else {
+			o.SnapshotReader = r
+			defer r.Close()
+		}
+	}
+
+	if o.SnapshotReader != nil {
+		if err := a.loadSnapshot(o.SnapshotReader); err != nil {
+			return a, err
+		}
+	}
+
+	return a, nil
+}
+
+func (a *Acknowledgements) nowUTC() time.Time {
+	return a.clock.Now().UTC()
+}
+
+// Acknowledge records that the alert with the given fingerprint, which
+// started firing at alertStartsAt, is being looked into.
+func (a *Acknowledgements) Acknowledge(fp model.Fingerprint, alertStartsAt time.Time, by, comment string) error {
+	if by == "" {
+		return errors.New("acknowledging person missing")
+	}
+	return a.set(&pb.Acknowledgement{
+		Fingerprint:    uint64(fp),
+		AlertStartsAt:  alertStartsAt,
+		AcknowledgedBy: by,
+		Comment:        comment,
+	})
+}
+
+// Withdraw withdraws the acknowledgement of the alert with the given
+// fingerprint.
+func (a *Acknowledgements) Withdraw(fp model.Fingerprint) error {
+	a.mtx.RLock()
+	prev, ok := a.st[uint64(fp)]
+	a.mtx.RUnlock()
+	if !ok || prev.Acknowledgement.Withdrawn {
+		return ErrNotFound
+	}
+
+	ack := *prev.Acknowledgement
+	ack.Withdrawn = true
+	return a.set(&ack)
+}
+
+func (a *Acknowledgements) set(ack *pb.Acknowledgement) error {
+	now := a.nowUTC()
+	ack.UpdatedAt = now
+
+	e := &pb.MeshAcknowledgement{
+		Acknowledgement: ack,
+		ExpiresAt:       now.Add(a.retention),
+	}
+	b, err := marshalMeshAcknowledgement(e)
+	if err != nil {
+		return err
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	a.st[ack.Fingerprint] = e
+	a.broadcast(b)
+
+	return nil
+}
+
+// Get returns the acknowledgement of the alert with the given fingerprint
+// that started firing at alertStartsAt.
+func (a *Acknowledgements) Get(fp model.Fingerprint, alertStartsAt time.Time) (*pb.Acknowledgement, error) {
+	a.mtx.RLock()
+	defer a.mtx.RUnlock()
+
+	e, ok := a.st[uint64(fp)]
+	if !ok || e.Acknowledgement.Withdrawn || !e.Acknowledgement.AlertStartsAt.Equal(alertStartsAt) {
+		return nil, ErrNotFound
+	}
+	ack := *e.Acknowledgement
+	return &ack, nil
+}
+
+// GC removes the acknowledgements that have not been updated for longer than
+// the retention time.
+func (a *Acknowledgements) GC() (int, error) {
+	start := time.Now()
+	defer func() { a.metrics.gcDuration.Observe(time.Since(start).Seconds()) }()
+
+	now := a.nowUTC()
+	var n int
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	for fp, e := range a.st {
+		if e.ExpiresAt.IsZero() {
+			return n, errors.New("unexpected zero expiration timestamp")
+		}
+		if !e.ExpiresAt.After(now) {
+			delete(a.st, fp)
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+// Maintenance garbage collects the acknowledgements at the given interval. If the snapshot
+// file is set, a snapshot is written to it afterwards.
+// Terminates on receiving from stopc.
+// If not nil, the last argument is an override for what to do as part of the maintenance - for advanced usage.
+func (a *Acknowledgements) Maintenance(interval time.Duration, snapf string, stopc <-chan struct{}, override MaintenanceFunc) {
+	if interval == 0 || stopc == nil {
+		level.Error(a.logger).Log("msg", "interval or stop signal are missing - not running maintenance")
+		return
+	}
+	t := a.clock.Ticker(interval)
+	defer t.Stop()
+
+	var doMaintenance MaintenanceFunc
+	doMaintenance = func() (int64, error) {
+		var size int64
+		if _, err := a.GC(); err != nil {
+			return size, err
+		}
+		if snapf == "" {
+			return size, nil
+		}
+		f, err := openReplace(snapf)
+		if err != nil {
+			return size, err
+		}
+		if size, err = a.Snapshot(f); err != nil {
+			f.Close()
+			return size, err
+		}
+		return size, f.Close()
+	}
+
+	if override != nil {
+		doMaintenance = override
+	}
+
+	runMaintenance := func(do func() (int64, error)) error {
+		a.metrics.maintenanceTotal.Inc()
+		start := a.nowUTC()
+		level.Debug(a.logger).Log("msg", "Running maintenance")
+		size, err := do()
+		a.metrics.snapshotSize.Set(float64(size))
+		if err != nil {
+			a.metrics.maintenanceErrorsTotal.Inc()
+			return err
+		}
+		level.Debug(a.logger).Log("msg", "Maintenance done", "duration", a.nowUTC().Sub(start), "size", size)
+		return nil
+	}
+
+Loop:
+	for {
+		select {
+		case <-stopc:
+			break Loop
+		case <-t.C:
+			if err := runMaintenance(doMaintenance); err != nil {
+				level.Error(a.logger).Log("msg", "Running maintenance failed", "err", err)
+			}
+		}
+	}
+
+	// No need to run final maintenance if we don't want to snapshot.
+	if snapf == "" {
+		return
+	}
+	if err := runMaintenance(doMaintenance); err != nil {
+		level.Error(a.logger).Log("msg", "Creating shutdown snapshot failed", "err", err)
+	}
+}
+
+// loadSnapshot loads a snapshot generated by Snapshot() into the state.
+func (a *Acknowledgements) loadSnapshot(r io.Reader) error {
+	st, err := decodeState(r)
+	if err != nil {
+		return err
+	}
+
+	a.mtx.Lock()
+	a.st = st
+	a.mtx.Unlock()
+
+	return nil
+}
+
+// Snapshot writes the full internal state into the writer and returns the number of bytes
+// written.
+func (a *Acknowledgements) Snapshot(w io.Writer) (int64, error) {
+	start := time.Now()
+	defer func() { a.metrics.snapshotDuration.Observe(time.Since(start).Seconds()) }()
+
+	a.mtx.RLock()
+	defer a.mtx.RUnlock()
+
+	b, err := a.st.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+
+	return io.Copy(w, bytes.NewReader(b))
+}
+
+// MarshalBinary serializes all acknowledgements.
+func (a *Acknowledgements) MarshalBinary() ([]byte, error) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	return a.st.MarshalBinary()
+}
+
+// Merge merges acknowledgement state received from the cluster with the local state.
+func (a *Acknowledgements) Merge(b []byte) error {
+	st, err := decodeState(bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	now := a.nowUTC()
+
+	for _, e := range st {
+		if merged := a.st.merge(e, now); merged && !cluster.OversizedMessage(b) {
+			// If this is the first we've seen the message and it's
+			// not oversized, gossip it to other nodes. We don't
+			// propagate oversized messages because they're sent to
+			// all nodes already.
+			a.broadcast(b)
+			a.metrics.propagatedMessagesTotal.Inc()
+			level.Debug(a.logger).Log("msg", "Gossiping new acknowledgement", "acknowledgement", e)
+		}
+	}
+	return nil
+}
+
+// SetBroadcast sets the provided function as the one creating data to be
+// broadcast.
+func (a *Acknowledgements) SetBroadcast(f func([]byte)) {
+	a.mtx.Lock()
+	a.broadcast = f
+	a.mtx.Unlock()
+}
+
+// Acknowledger binds together Acknowledgements and a Marker to determine
+// whether alerts have been acknowledged.
+type Acknowledger struct {
+	acks   *Acknowledgements
+	marker types.Marker
+}
+
+// NewAcknowledger returns a new Acknowledger.
+func NewAcknowledger(acks *Acknowledgements, marker types.Marker) *Acknowledger {
+	return &Acknowledger{acks: acks, marker: marker}
+}
+
+// Acknowledged returns true if the alert has been acknowledged since it
+// started firing. The acknowledgement is recorded in the marker.
+func (a *Acknowledger) Acknowledged(alert *types.Alert) bool {
+	fp := alert.Fingerprint()
+	ack, err := a.acks.Get(fp, alert.StartsAt)
+	if err != nil {
+		a.marker.SetAcknowledged(fp, nil)
+		return false
+	}
+	a.marker.SetAcknowledged(fp, &types.Acknowledgement{
+		AcknowledgedBy: ack.AcknowledgedBy,
+		Comment:        ack.Comment,
+		AcknowledgedAt: ack.UpdatedAt,
+	})
+	return true
+}
+
+type state map[uint64]*pb.MeshAcknowledgement
+
+// merge returns true or false whether the MeshAcknowledgement was merged or
+// not. This information is used to decide to gossip the message further.
+func (s state) merge(e *pb.MeshAcknowledgement, now time.Time) bool {
+	if e.ExpiresAt.Before(now) {
+		return false
+	}
+	fp := e.Acknowledgement.Fingerprint
+
+	prev, ok := s[fp]
+	if !ok || prev.Acknowledgement.UpdatedAt.Before(e.Acknowledgement.UpdatedAt) {
+		s[fp] = e
+		return true
+	}
+	return false
+}
+
+func (s state) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+
+	for _, e := range s {
+		if _, err := pbutil.WriteDelimited(&buf, e); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeState(r io.Reader) (state, error) {
+	st := state{}
+	for {
+		var e pb.MeshAcknowledgement
+		_, err := pbutil.ReadDelimited(r, &e)
+		if err == nil {
+			if e.Acknowledgement == nil {
+				return nil, ErrInvalidState
+			}
+			st[e.Acknowledgement.Fingerprint] = &e
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		return nil, err
+	}
+	return st, nil
+}
+
+func marshalMeshAcknowledgement(e *pb.MeshAcknowledgement) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := pbutil.WriteDelimited(&buf, e); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// replaceFile wraps a file that is moved to another filename on closing.
+type replaceFile struct {
+	*os.File
+	filename string
+}
+
+func (f *replaceFile) Close() error {
+	if err := f.File.Sync(); err != nil {
+		return err
+	}
+	if err := f.File.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.File.Name(), f.filename)
+}
+
+// openReplace opens a new temporary file that is moved to filename on closing.
+func openReplace(filename string) (*replaceFile, error) {
+	tmpFilename := fmt.Sprintf("%s.%x", filename, uint64(rand.Int63()))
+
+	f, err := os.Create(tmpFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	rf := &replaceFile{
+		File:     f,
+		filename: filename,
+	}
+	return rf, nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ack
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/types"
+)
+
+func newTestAcknowledgements(t *testing.T, c clock.Clock) *Acknowledgements {
+	a, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+	a.clock = c
+	return a
+}
+
+func TestAcknowledgeAndWithdraw(t *testing.T) {
+	c := clock.NewMock()
+	a := newTestAcknowledgements(t, c)
+	startsAt := c.Now().Add(-time.Minute)
+
+	_, err := a.Get(1, startsAt)
+	require.Equal(t, ErrNotFound, err)
+	require.Error(t, a.Acknowledge(1, startsAt, "", "no author"))
+
+	require.NoError(t, a.Acknowledge(1, startsAt, "alice", "looking into it"))
+	ack, err := a.Get(1, startsAt)
+	require.NoError(t, err)
+	require.Equal(t, "alice", ack.AcknowledgedBy)
+	require.Equal(t, "looking into it", ack.Comment)
+	require.Equal(t, c.Now().UTC(), ack.UpdatedAt)
+
+	// An alert that fires again is not acknowledged anymore.
+	_, err = a.Get(1, startsAt.Add(time.Hour))
+	require.Equal(t, ErrNotFound, err)
+
+	require.NoError(t, a.Withdraw(1))
+	_, err = a.Get(1, startsAt)
+	require.Equal(t, ErrNotFound, err)
+	require.Equal(t, ErrNotFound, a.Withdraw(1))
+	require.Equal(t, ErrNotFound, a.Withdraw(2))
+}
+
+func TestAcknowledgementsGC(t *testing.T) {
+	c := clock.NewMock()
+	a := newTestAcknowledgements(t, c)
+
+	require.NoError(t, a.Acknowledge(1, c.Now(), "alice", ""))
+	c.Add(30 * time.Minute)
+	require.NoError(t, a.Acknowledge(2, c.Now(), "bob", ""))
+	c.Add(45 * time.Minute)
+
+	n, err := a.GC()
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.NotContains(t, a.st, uint64(1))
+	require.Contains(t, a.st, uint64(2))
+}
+
+func TestAcknowledgementsMerge(t *testing.T) {
+	c := clock.NewMock()
+	a1 := newTestAcknowledgements(t, c)
+	a2 := newTestAcknowledgements(t, c)
+
+	var broadcasts int
+	a2.SetBroadcast(func([]byte) { broadcasts++ })
+
+	startsAt := c.Now()
+	require.NoError(t, a1.Acknowledge(1, startsAt, "alice", "first"))
+	b, err := a1.MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, a2.Merge(b))
+	require.Equal(t, 1, broadcasts)
+
+	ack, err := a2.Get(1, startsAt)
+	require.NoError(t, err)
+	require.Equal(t, "alice", ack.AcknowledgedBy)
+
+	// Merging the same state again is a no-op.
+	require.NoError(t, a2.Merge(b))
+	require.Equal(t, 1, broadcasts)
+
+	// The latest update wins, including withdrawals.
+	c.Add(time.Minute)
+	require.NoError(t, a1.Withdraw(1))
+	b, err = a1.MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, a2.Merge(b))
+	_, err = a2.Get(1, startsAt)
+	require.Equal(t, ErrNotFound, err)
+}
+
+func TestAcknowledgementsSnapshot(t *testing.T) {
+	c := clock.NewMock()
+	a := newTestAcknowledgements(t, c)
+	startsAt := c.Now()
+	require.NoError(t, a.Acknowledge(1, startsAt, "alice", "looking into it"))
+	require.NoError(t, a.Acknowledge(2, startsAt, "bob", ""))
+
+	f := filepath.Join(t.TempDir(), "acks")
+	w, err := os.Create(f)
+	require.NoError(t, err)
+	_, err = a.Snapshot(w)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	a2, err := New(Options{SnapshotFile: f})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	_, err = a.Snapshot(&buf)
+	require.NoError(t, err)
+	a3, err := New(Options{SnapshotReader: &buf})
+	require.NoError(t, err)
+
+	for _, loaded := range []*Acknowledgements{a2, a3} {
+		require.Len(t, loaded.st, 2)
+		for fp, e := range a.st {
+			require.Contains(t, loaded.st, fp)
+			require.Equal(t, e.Acknowledgement.AcknowledgedBy, loaded.st[fp].Acknowledgement.AcknowledgedBy)
+			require.Equal(t, e.Acknowledgement.Comment, loaded.st[fp].Acknowledgement.Comment)
+			require.True(t, e.Acknowledgement.AlertStartsAt.Equal(loaded.st[fp].Acknowledgement.AlertStartsAt))
+			require.True(t, e.ExpiresAt.Equal(loaded.st[fp].ExpiresAt))
+		}
+	}
+}
+
+func TestAcknowledger(t *testing.T) {
+	c := clock.NewMock()
+	a := newTestAcknowledgements(t, c)
+	marker := types.NewMarker(prometheus.NewRegistry())
+	acknowledger := NewAcknowledger(a, marker)
+
+	alert := &types.Alert{Alert: model.Alert{
+		Labels:   model.LabelSet{"alertname": "test"},
+		StartsAt: c.Now(),
+	}}
+	fp := alert.Fingerprint()
+
+	require.False(t, acknowledger.Acknowledged(alert))
+	_, ok := marker.Acknowledged(fp)
+	require.False(t, ok)
+
+	require.NoError(t, a.Acknowledge(fp, alert.StartsAt, "alice", "looking into it"))
+	require.True(t, acknowledger.Acknowledged(alert))
+	ack, ok := marker.Acknowledged(fp)
+	require.True(t, ok)
+	require.Equal(t, &types.Acknowledgement{
+		AcknowledgedBy: "alice",
+		Comment:        "looking into it",
+		AcknowledgedAt: c.Now().UTC(),
+	}, ack)
+
+	require.NoError(t, a.Withdraw(fp))
+	require.False(t, acknowledger.Acknowledged(alert))
+	_, ok = marker.Acknowledged(fp)
+	require.False(t, ok)
+}
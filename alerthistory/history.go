@@ -0,0 +1,518 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package alerthistory implements a garbage-collected and snapshottable
+// record of the state transitions of alerts: when they fired and resolved,
+// got silenced and inhibited, and were notified. The history outlives the
+// alerts themselves, it is kept for the retention time.
+package alerthistory
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/matttproud/golang_protobuf_extensions/pbutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+
+	pb "github.com/prometheus/alertmanager/alerthistory/historypb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// ErrNotFound is returned if there is no history for an alert.
+var ErrNotFound = errors.New("alert history not found")
+
+// ErrInvalidState is returned if the state isn't valid.
+var ErrInvalidState = errors.New("invalid state")
+
+// maxEvents is the maximum number of events kept per alert. The oldest ones
+// are dropped first, so that flapping alerts cannot grow the history
+// without bounds.
+const maxEvents = 1000
+
+// History holds the state transitions of alerts.
+type History struct {
+	clock clock.Clock
+
+	logger    log.Logger
+	metrics   *metrics
+	retention time.Duration
+
+	mtx sync.RWMutex
+	st  state
+}
+
+// MaintenanceFunc represents the function to run as part of the periodic maintenance for the alert history.
+// It returns the size of the snapshot taken or an error if it failed.
+type MaintenanceFunc func() (int64, error)
+
+type metrics struct {
+	eventsTotal            *prometheus.CounterVec
+	gcDuration             prometheus.Summary
+	snapshotDuration       prometheus.Summary
+	snapshotSize           prometheus.Gauge
+	maintenanceTotal       prometheus.Counter
+	maintenanceErrorsTotal prometheus.Counter
+}
+
+func newMetrics(r prometheus.Registerer) *metrics {
+	m := &metrics{}
+
+	m.eventsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "alertmanager_alert_history_events_total",
+		Help: "How many alert state transitions were recorded.",
+	}, []string{"type"})
+	m.gcDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "alertmanager_alert_history_gc_duration_seconds",
+		Help:       "Duration of the last alert history garbage collection cycle.",
+		Objectives: map[float64]float64{},
+	})
+	m.snapshotDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "alertmanager_alert_history_snapshot_duration_seconds",
+		Help:       "Duration of the last alert history snapshot.",
+		Objectives: map[float64]float64{},
+	})
+	m.snapshotSize = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "alertmanager_alert_history_snapshot_size_bytes",
+		Help: "Size of the last alert history snapshot in bytes.",
+	})
+	m.maintenanceTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "alertmanager_alert_history_maintenance_total",
+		Help: "How many maintenances were executed for the alert history.",
+	})
+	m.maintenanceErrorsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "alertmanager_alert_history_maintenance_errors_total",
+		Help: "How many maintenances were executed for the alert history that failed.",
+	})
+
+	if r != nil {
+		r.MustRegister(
+			m.eventsTotal,
+			m.gcDuration,
+			m.snapshotDuration,
+			m.snapshotSize,
+			m.maintenanceTotal,
+			m.maintenanceErrorsTotal,
+		)
+	}
+	return m
+}
+
+// Options configures a new History implementation.
+type Options struct {
+	SnapshotReader io.Reader
+	SnapshotFile   string
+
+	// Retention is how long events are kept.
+	Retention time.Duration
+
+	Logger  log.Logger
+	Metrics prometheus.Registerer
+}
+
+func (o *Options) validate() error {
+	if o.SnapshotFile != "" && o.SnapshotReader != nil {
+		return errors.New("only one of SnapshotFile and SnapshotReader must be set")
+	}
+	return nil
+}
+
+// New creates a new alert history based on the provided options. The
+// snapshot is loaded into the history if it is set.
+func New(o Options) (*History, error) {
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+
+	h := &History{
+		clock:     clock.New(),
+		retention: o.Retention,
+		logger:    log.NewNopLogger(),
+		st:        state{},
+		metrics:   newMetrics(o.Metrics),
+	}
+
+	if o.Logger != nil {
+		h.logger = o.Logger
+	}
+
+	if o.SnapshotFile != "" {
+		if r, err := os.Open(o.SnapshotFile); err != nil {
+			if !os.IsNotExist(err) {
+				return nil, err
+			}
+			level.Debug(h.logger).Log("msg", "alert history snapshot file doesn't exist", "err", err)
+		} else {
+			o.SnapshotReader = r
+			defer r.Close()
+		}
+	}
+
+	if o.SnapshotReader != nil {
+		if err := h.loadSnapshot(o.SnapshotReader); err != nil {
+			return h, err
+		}
+	}
+
+	return h, nil
+}
+
+func (h *History) nowUTC() time.Time {
+	return h.clock.Now().UTC()
+}
+
+// Firing records that the alert fired, unless it is already recorded as
+// firing since it started.
+func (h *History) Firing(a *types.Alert) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	e := h.entry(a.Fingerprint())
+	e.Labels = labelsToProto(a.Labels)
+	if last := lastLifecycleEvent(e); last != nil && last.Type == pb.Event_FIRING && last.Timestamp.Equal(a.StartsAt.UTC()) {
+		return
+	}
+	h.add(e, &pb.Event{Type: pb.Event_FIRING, Timestamp: a.StartsAt.UTC()})
+}
+
+// Resolved records that the alert resolved, unless it is already recorded as
+// resolved.
+func (h *History) Resolved(a *types.Alert) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	e := h.entry(a.Fingerprint())
+	if last := lastLifecycleEvent(e); last == nil || last.Type == pb.Event_RESOLVED {
+		return
+	}
+	h.add(e, &pb.Event{Type: pb.Event_RESOLVED, Timestamp: a.EndsAt.UTC()})
+}
+
+// Notified records that notifications for the alerts were sent to the
+// integration of the receiver. It implements notify.NotificationRecorder.
+func (h *History) Notified(alerts []*types.Alert, receiver, integration string, now time.Time) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	for _, a := range alerts {
+		h.add(h.entry(a.Fingerprint()), &pb.Event{
+			Type:        pb.Event_NOTIFIED,
+			Timestamp:   now.UTC(),
+			Receiver:    receiver,
+			Integration: integration,
+			Resolved:    a.ResolvedAt(now),
+		})
+	}
+}
+
+// silenced records a change of the silences muting the alert.
+func (h *History) silenced(fp model.Fingerprint, ids []string) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	ev := &pb.Event{Type: pb.Event_UNSILENCED, Timestamp: h.nowUTC()}
+	if len(ids) > 0 {
+		ev.Type, ev.SilencedBy = pb.Event_SILENCED, ids
+	}
+	h.add(h.entry(fp), ev)
+}
+
+// inhibited records a change of the alerts inhibiting the alert.
+func (h *History) inhibited(fp model.Fingerprint, ids []string) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	ev := &pb.Event{Type: pb.Event_UNINHIBITED, Timestamp: h.nowUTC()}
+	if len(ids) > 0 {
+		ev.Type, ev.InhibitedBy = pb.Event_INHIBITED, ids
+	}
+	h.add(h.entry(fp), ev)
+}
+
+// entry returns the history of the alert, creating it if needed. It must be
+// called with h.mtx held.
+func (h *History) entry(fp model.Fingerprint) *pb.AlertHistory {
+	e, ok := h.st[uint64(fp)]
+	if !ok {
+		e = &pb.AlertHistory{Fingerprint: uint64(fp)}
+		h.st[uint64(fp)] = e
+	}
+	return e
+}
+
+// add must be called with h.mtx held.
+func (h *History) add(e *pb.AlertHistory, ev *pb.Event) {
+	e.Events = append(e.Events, ev)
+	if len(e.Events) > maxEvents {
+		e.Events = e.Events[len(e.Events)-maxEvents:]
+	}
+	h.metrics.eventsTotal.WithLabelValues(eventTypeLabel(ev.Type)).Inc()
+}
+
+func eventTypeLabel(t pb.Event_Type) string {
+	switch t {
+	case pb.Event_FIRING:
+		return "firing"
+	case pb.Event_RESOLVED:
+		return "resolved"
+	case pb.Event_SILENCED:
+		return "silenced"
+	case pb.Event_UNSILENCED:
+		return "unsilenced"
+	case pb.Event_INHIBITED:
+		return "inhibited"
+	case pb.Event_UNINHIBITED:
+		return "uninhibited"
+	}
+	return "notified"
+}
+
+// lastLifecycleEvent returns the last firing or resolved event.
+func lastLifecycleEvent(e *pb.AlertHistory) *pb.Event {
+	for i := len(e.Events) - 1; i >= 0; i-- {
+		if t := e.Events[i].Type; t == pb.Event_FIRING || t == pb.Event_RESOLVED {
+			return e.Events[i]
+		}
+	}
+	return nil
+}
+
+// Get returns the history of the alert with the given fingerprint.
+func (h *History) Get(fp model.Fingerprint) (*pb.AlertHistory, error) {
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+
+	e, ok := h.st[uint64(fp)]
+	if !ok || len(e.Events) == 0 {
+		return nil, ErrNotFound
+	}
+	res := *e
+	res.Events = append([]*pb.Event(nil), e.Events...)
+	return &res, nil
+}
+
+// GC removes the events older than the retention time, and the alerts left
+// without events.
+func (h *History) GC() (int, error) {
+	start := time.Now()
+	defer func() { h.metrics.gcDuration.Observe(time.Since(start).Seconds()) }()
+
+	cutoff := h.nowUTC().Add(-h.retention)
+	var n int
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	for fp, e := range h.st {
+		kept := e.Events[:0]
+		for _, ev := range e.Events {
+			if ev.Timestamp.Before(cutoff) {
+				n++
+				continue
+			}
+			kept = append(kept, ev)
+		}
+		e.Events = kept
+		if len(e.Events) == 0 {
+			delete(h.st, fp)
+		}
+	}
+
+	return n, nil
+}
+
+// Maintenance garbage collects the alert history at the given interval. If the snapshot
+// file is set, a snapshot is written to it afterwards.
+// Terminates on receiving from stopc.
+// If not nil, the last argument is an override for what to do as part of the maintenance - for advanced usage.
+func (h *History) Maintenance(interval time.Duration, snapf string, stopc <-chan struct{}, override MaintenanceFunc) {
+	if interval == 0 || stopc == nil {
+		level.Error(h.logger).Log("msg", "interval or stop signal are missing - not running maintenance")
+		return
+	}
+	t := h.clock.Ticker(interval)
+	defer t.Stop()
+
+	var doMaintenance MaintenanceFunc
+	doMaintenance = func() (int64, error) {
+		var size int64
+		if _, err := h.GC(); err != nil {
+			return size, err
+		}
+		if snapf == "" {
+			return size, nil
+		}
+		f, err := openReplace(snapf)
+		if err != nil {
+			return size, err
+		}
+		if size, err = h.Snapshot(f); err != nil {
+			f.Close()
+			return size, err
+		}
+		return size, f.Close()
+	}
+
+	if override != nil {
+		doMaintenance = override
+	}
+
+	runMaintenance := func(do func() (int64, error)) error {
+		h.metrics.maintenanceTotal.Inc()
+		start := h.nowUTC()
+		level.Debug(h.logger).Log("msg", "Running maintenance")
+		size, err := do()
+		h.metrics.snapshotSize.Set(float64(size))
+		if err != nil {
+			h.metrics.maintenanceErrorsTotal.Inc()
+			return err
+		}
+		level.Debug(h.logger).Log("msg", "Maintenance done", "duration", h.nowUTC().Sub(start), "size", size)
+		return nil
+	}
+
+Loop:
+	for {
+		select {
+		case <-stopc:
+			break Loop
+		case <-t.C:
+			if err := runMaintenance(doMaintenance); err != nil {
+				level.Error(h.logger).Log("msg", "Running maintenance failed", "err", err)
+			}
+		}
+	}
+
+	// No need to run final maintenance if we don't want to snapshot.
+	if snapf == "" {
+		return
+	}
+	if err := runMaintenance(doMaintenance); err != nil {
+		level.Error(h.logger).Log("msg", "Creating shutdown snapshot failed", "err", err)
+	}
+}
+
+// loadSnapshot loads a snapshot generated by Snapshot() into the state.
+func (h *History) loadSnapshot(r io.Reader) error {
+	st, err := decodeState(r)
+	if err != nil {
+		return err
+	}
+
+	h.mtx.Lock()
+	h.st = st
+	h.mtx.Unlock()
+
+	return nil
+}
+
+// Snapshot writes the full internal state into the writer and returns the number of bytes
+// written.
+func (h *History) Snapshot(w io.Writer) (int64, error) {
+	start := time.Now()
+	defer func() { h.metrics.snapshotDuration.Observe(time.Since(start).Seconds()) }()
+
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+
+	b, err := h.st.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+
+	return io.Copy(w, bytes.NewReader(b))
+}
+
+type state map[uint64]*pb.AlertHistory
+
+func (s state) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+
+	for _, e := range s {
+		if _, err := pbutil.WriteDelimited(&buf, e); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeState(r io.Reader) (state, error) {
+	st := state{}
+	for {
+		var e pb.AlertHistory
+		_, err := pbutil.ReadDelimited(r, &e)
+		if err == nil {
+			if e.Fingerprint == 0 {
+				return nil, ErrInvalidState
+			}
+			st[e.Fingerprint] = &e
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		return nil, err
+	}
+	return st, nil
+}
+
+func labelsToProto(lset model.LabelSet) []*pb.Label {
+	res := make([]*pb.Label, 0, len(lset))
+	for name, value := range lset {
+		res = append(res, &pb.Label{Name: string(name), Value: string(value)})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
+
+// replaceFile wraps a file that is moved to another filename on closing.
+type replaceFile struct {
+	*os.File
+	filename string
+}
+
+func (f *replaceFile) Close() error {
+	if err := f.File.Sync(); err != nil {
+		return err
+	}
+	if err := f.File.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.File.Name(), f.filename)
+}
+
+// openReplace opens a new temporary file that is moved to filename on closing.
+func openReplace(filename string) (*replaceFile, error) {
+	tmpFilename := fmt.Sprintf("%s.%x", filename, uint64(rand.Int63()))
+
+	f, err := os.Create(tmpFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	rf := &replaceFile{
+		File:     f,
+		filename: filename,
+	}
+	return rf, nil
+}
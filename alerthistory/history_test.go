@@ -0,0 +1,181 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerthistory
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/prometheus/alertmanager/alerthistory/historypb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func newTestHistory(t *testing.T, c clock.Clock) *History {
+	h, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+	h.clock = c
+	return h
+}
+
+func newTestAlert(startsAt, endsAt time.Time) *types.Alert {
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "test"},
+			StartsAt: startsAt,
+			EndsAt:   endsAt,
+		},
+	}
+}
+
+func eventTypes(h *pb.AlertHistory) []pb.Event_Type {
+	var res []pb.Event_Type
+	for _, e := range h.Events {
+		res = append(res, e.Type)
+	}
+	return res
+}
+
+func TestFiringAndResolved(t *testing.T) {
+	c := clock.NewMock()
+	h := newTestHistory(t, c)
+	a := newTestAlert(c.Now(), time.Time{})
+
+	_, err := h.Get(a.Fingerprint())
+	require.Equal(t, ErrNotFound, err)
+
+	// An alert that was never seen firing doesn't resolve.
+	h.Resolved(a)
+	_, err = h.Get(a.Fingerprint())
+	require.Equal(t, ErrNotFound, err)
+
+	h.Firing(a)
+	h.Firing(a)
+	a.EndsAt = c.Now().Add(5 * time.Minute)
+	h.Resolved(a)
+	h.Resolved(a)
+
+	// The alert fires again.
+	h.Firing(newTestAlert(c.Now().Add(time.Hour), time.Time{}))
+
+	e, err := h.Get(a.Fingerprint())
+	require.NoError(t, err)
+	require.Equal(t, uint64(a.Fingerprint()), e.Fingerprint)
+	require.Equal(t, []*pb.Label{{Name: "alertname", Value: "test"}}, e.Labels)
+	require.Equal(t, []pb.Event_Type{pb.Event_FIRING, pb.Event_RESOLVED, pb.Event_FIRING}, eventTypes(e))
+	require.Equal(t, a.StartsAt.UTC(), e.Events[0].Timestamp)
+	require.Equal(t, a.EndsAt.UTC(), e.Events[1].Timestamp)
+}
+
+func TestNotified(t *testing.T) {
+	c := clock.NewMock()
+	h := newTestHistory(t, c)
+	firing := newTestAlert(c.Now(), time.Time{})
+	resolved := newTestAlert(c.Now().Add(-time.Hour), c.Now().Add(-time.Minute))
+	resolved.Labels = model.LabelSet{"alertname": "other"}
+
+	h.Notified([]*types.Alert{firing, resolved}, "team-X", "webhook[0]", c.Now())
+
+	e, err := h.Get(firing.Fingerprint())
+	require.NoError(t, err)
+	require.Equal(t, []*pb.Event{{
+		Type:        pb.Event_NOTIFIED,
+		Timestamp:   c.Now().UTC(),
+		Receiver:    "team-X",
+		Integration: "webhook[0]",
+	}}, e.Events)
+
+	e, err = h.Get(resolved.Fingerprint())
+	require.NoError(t, err)
+	require.True(t, e.Events[0].Resolved)
+}
+
+func TestMaxEvents(t *testing.T) {
+	c := clock.NewMock()
+	h := newTestHistory(t, c)
+	for i := 0; i < maxEvents+10; i++ {
+		h.silenced(1, []string{"a"})
+	}
+	e, err := h.Get(1)
+	require.NoError(t, err)
+	require.Len(t, e.Events, maxEvents)
+}
+
+func TestHistoryGC(t *testing.T) {
+	c := clock.NewMock()
+	h := newTestHistory(t, c)
+
+	h.silenced(1, []string{"a"})
+	c.Add(30 * time.Minute)
+	h.silenced(1, nil)
+	h.inhibited(2, []string{"b"})
+	c.Add(45 * time.Minute)
+
+	n, err := h.GC()
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	e, err := h.Get(1)
+	require.NoError(t, err)
+	require.Equal(t, []pb.Event_Type{pb.Event_UNSILENCED}, eventTypes(e))
+
+	c.Add(time.Hour)
+	n, err = h.GC()
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Empty(t, h.st)
+}
+
+func TestHistorySnapshot(t *testing.T) {
+	c := clock.NewMock()
+	h := newTestHistory(t, c)
+	h.Firing(newTestAlert(c.Now(), time.Time{}))
+	h.silenced(1, []string{"a", "b"})
+	h.inhibited(2, []string{"c"})
+
+	f := filepath.Join(t.TempDir(), "alert_history")
+	w, err := os.Create(f)
+	require.NoError(t, err)
+	_, err = h.Snapshot(w)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	h2, err := New(Options{SnapshotFile: f})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	_, err = h.Snapshot(&buf)
+	require.NoError(t, err)
+	h3, err := New(Options{SnapshotReader: &buf})
+	require.NoError(t, err)
+
+	for _, loaded := range []*History{h2, h3} {
+		require.Len(t, loaded.st, 3)
+		for fp, e := range h.st {
+			require.Contains(t, loaded.st, fp)
+			require.Equal(t, e.Labels, loaded.st[fp].Labels)
+			require.Equal(t, eventTypes(e), eventTypes(loaded.st[fp]))
+			for i, ev := range e.Events {
+				require.True(t, ev.Timestamp.Equal(loaded.st[fp].Events[i].Timestamp))
+				require.Equal(t, ev.SilencedBy, loaded.st[fp].Events[i].SilencedBy)
+				require.Equal(t, ev.InhibitedBy, loaded.st[fp].Events[i].InhibitedBy)
+			}
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerthistory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/provider"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// resolveInterval is the interval at which firing alerts are checked for
+// having resolved without being updated.
+const resolveInterval = 15 * time.Second
+
+// Marker wraps a types.Marker to record the changes to the silences and the
+// inhibitions of alerts.
+type Marker struct {
+	types.Marker
+	history *History
+
+	mtx sync.Mutex
+}
+
+// NewMarker returns a Marker recording into the history.
+func NewMarker(m types.Marker, h *History) *Marker {
+	return &Marker{Marker: m, history: h}
+}
+
+// SetActiveOrSilenced implements types.Marker.
+func (m *Marker) SetActiveOrSilenced(alert model.Fingerprint, version int, activeSilenceIDs, pendingSilenceIDs []string) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	prev, _, _, _ := m.Marker.Silenced(alert)
+	m.Marker.SetActiveOrSilenced(alert, version, activeSilenceIDs, pendingSilenceIDs)
+	if !sameIDs(prev, activeSilenceIDs) {
+		m.history.silenced(alert, sortedIDs(activeSilenceIDs))
+	}
+}
+
+// SetInhibited implements types.Marker.
+func (m *Marker) SetInhibited(alert model.Fingerprint, alertIDs ...string) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	prev, _ := m.Marker.Inhibited(alert)
+	m.Marker.SetInhibited(alert, alertIDs...)
+	if !sameIDs(prev, alertIDs) {
+		m.history.inhibited(alert, sortedIDs(alertIDs))
+	}
+}
+
+func sortedIDs(ids []string) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	res := append([]string(nil), ids...)
+	sort.Strings(res)
+	return res
+}
+
+func sameIDs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = sortedIDs(a), sortedIDs(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Recorder records when alerts fire and resolve.
+type Recorder struct {
+	alerts  provider.Alerts
+	history *History
+	logger  log.Logger
+
+	// firing holds the latest version of the alerts recorded as firing.
+	firing map[model.Fingerprint]*types.Alert
+}
+
+// NewRecorder returns a Recorder of the alerts into the history.
+func NewRecorder(alerts provider.Alerts, h *History, l log.Logger) *Recorder {
+	if l == nil {
+		l = log.NewNopLogger()
+	}
+	return &Recorder{
+		alerts:  alerts,
+		history: h,
+		logger:  l,
+		firing:  map[model.Fingerprint]*types.Alert{},
+	}
+}
+
+// Run follows the alerts until stopc is closed.
+func (r *Recorder) Run(stopc <-chan struct{}) {
+	it := r.alerts.Subscribe()
+	defer it.Close()
+
+	t := time.NewTicker(resolveInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-stopc:
+			return
+		case a, ok := <-it.Next():
+			if !ok {
+				if err := it.Err(); err != nil {
+					level.Error(r.logger).Log("msg", "Error iterating alerts", "err", err)
+				}
+				return
+			}
+			r.observe(a, time.Now())
+		case now := <-t.C:
+			for _, a := range r.firing {
+				r.observe(a, now)
+			}
+		}
+	}
+}
+
+func (r *Recorder) observe(a *types.Alert, now time.Time) {
+	fp := a.Fingerprint()
+	if a.ResolvedAt(now) {
+		r.history.Resolved(a)
+		delete(r.firing, fp)
+		return
+	}
+	r.history.Firing(a)
+	r.firing[fp] = a
+}
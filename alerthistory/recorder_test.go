@@ -0,0 +1,72 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerthistory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/prometheus/alertmanager/alerthistory/historypb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestMarker(t *testing.T) {
+	c := clock.NewMock()
+	h := newTestHistory(t, c)
+	m := NewMarker(types.NewMarker(prometheus.NewRegistry()), h)
+
+	m.SetActiveOrSilenced(1, 0, []string{"b", "a"}, nil)
+	// Unchanged silences are not recorded again.
+	m.SetActiveOrSilenced(1, 0, []string{"a", "b"}, nil)
+	m.SetInhibited(1, "x")
+	m.SetInhibited(1, "x")
+	m.SetInhibited(1)
+	m.SetActiveOrSilenced(1, 0, nil, nil)
+
+	e, err := h.Get(1)
+	require.NoError(t, err)
+	require.Equal(t, []pb.Event_Type{
+		pb.Event_SILENCED,
+		pb.Event_INHIBITED,
+		pb.Event_UNINHIBITED,
+		pb.Event_UNSILENCED,
+	}, eventTypes(e))
+	require.Equal(t, []string{"a", "b"}, e.Events[0].SilencedBy)
+	require.Equal(t, []string{"x"}, e.Events[1].InhibitedBy)
+
+	// The wrapped marker is still updated.
+	require.Equal(t, types.AlertStateActive, m.Status(1).State)
+}
+
+func TestRecorderObserve(t *testing.T) {
+	c := clock.NewMock()
+	h := newTestHistory(t, c)
+	r := NewRecorder(nil, h, nil)
+
+	a := newTestAlert(c.Now(), c.Now().Add(5*time.Minute))
+	r.observe(a, c.Now())
+	require.Contains(t, r.firing, a.Fingerprint())
+
+	// The alert resolves once its end time has passed.
+	r.observe(a, c.Now().Add(10*time.Minute))
+	require.NotContains(t, r.firing, a.Fingerprint())
+
+	e, err := h.Get(a.Fingerprint())
+	require.NoError(t, err)
+	require.Equal(t, []pb.Event_Type{pb.Event_FIRING, pb.Event_RESOLVED}, eventTypes(e))
+}
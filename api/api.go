@@ -25,6 +25,9 @@ import (
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/common/route"
 
+	"github.com/prometheus/alertmanager/ack"
+	"github.com/prometheus/alertmanager/alerthistory"
+	"github.com/prometheus/alertmanager/api/auth"
 	apiv2 "github.com/prometheus/alertmanager/api/v2"
 	"github.com/prometheus/alertmanager/cluster"
 	"github.com/prometheus/alertmanager/config"
@@ -38,6 +41,7 @@ import (
 type API struct {
 	v2                *apiv2.API
 	deprecationRouter *V1DeprecationRouter
+	authenticator     *auth.Authenticator
 
 	requestsInFlight         prometheus.Gauge
 	concurrencyLimitExceeded prometheus.Counter
@@ -45,13 +49,24 @@ type API struct {
 	inFlightSem              chan struct{}
 }
 
-// Options for the creation of an API object. Alerts, Silences, and StatusFunc
-// are mandatory to set. The zero value for everything else is a safe default.
+// Options for the creation of an API object. Alerts, Silences,
+// Acknowledgements, and StatusFunc are mandatory to set. The zero value for everything else is a safe default.
 type Options struct {
 	// Alerts to be used by the API. Mandatory.
 	Alerts provider.Alerts
 	// Silences to be used by the API. Mandatory.
 	Silences *silence.Silences
+	// Acknowledgements to be used by the API. Mandatory.
+	Acknowledgements *ack.Acknowledgements
+	// History of the alerts to be used by the API. If nil, the API reports
+	// no history.
+	History *alerthistory.History
+	// Configs manages the configuration through the API. If nil, the
+	// configuration can't be changed through the API.
+	Configs *config.Coordinator
+	// EnableConfigWrites allows to apply and roll back configurations
+	// through the API. It has no effect if Configs is nil.
+	EnableConfigWrites bool
 	// StatusFunc is used be the API to retrieve the AlertStatus of an
 	// alert. Mandatory.
 	StatusFunc func(model.Fingerprint) types.AlertStatus
@@ -70,6 +85,9 @@ type Options struct {
 	// Registry is used to register Prometheus metrics. If nil, no metrics
 	// registration will happen.
 	Registry prometheus.Registerer
+	// Authenticator authenticates and authorizes the requests to APIv2. If
+	// nil, all requests are allowed.
+	Authenticator *auth.Authenticator
 	// GroupFunc returns a list of alert groups. The alerts are grouped
 	// according to the current active configuration. Alerts returned are
 	// filtered by the arguments provided to the function.
@@ -83,6 +101,9 @@ func (o Options) validate() error {
 	if o.Silences == nil {
 		return errors.New("mandatory field Silences not set")
 	}
+	if o.Acknowledgements == nil {
+		return errors.New("mandatory field Acknowledgements not set")
+	}
 	if o.StatusFunc == nil {
 		return errors.New("mandatory field StatusFunc not set")
 	}
@@ -115,6 +136,10 @@ func New(opts Options) (*API, error) {
 		opts.GroupFunc,
 		opts.StatusFunc,
 		opts.Silences,
+		opts.Acknowledgements,
+		opts.History,
+		opts.Configs,
+		opts.EnableConfigWrites,
 		opts.Peer,
 		log.With(l, "version", "v2"),
 		opts.Registry,
@@ -147,6 +172,7 @@ func New(opts Options) (*API, error) {
 	return &API{
 		deprecationRouter:        NewV1DeprecationRouter(log.With(l, "version", "v1")),
 		v2:                       v2,
+		authenticator:            opts.Authenticator,
 		requestsInFlight:         requestsInFlight,
 		concurrencyLimitExceeded: concurrencyLimitExceeded,
 		timeout:                  opts.Timeout,
@@ -178,18 +204,28 @@ func (api *API) Register(r *route.Router, routePrefix string) *http.ServeMux {
 	// limitHandler below).
 	mux.Handle(
 		apiPrefix+"/api/v2/",
-		api.limitHandler(http.StripPrefix(apiPrefix, api.v2.Handler)),
+		api.limitHandler(http.StripPrefix(apiPrefix, api.authHandler(api.v2.Handler))),
 	)
+	// The alert stream is long-lived, it is subject to neither the timeout
+	// nor the concurrency limit.
+	mux.Handle(apiPrefix+"/api/v2/alerts/stream", http.StripPrefix(apiPrefix, api.authHandler(api.v2.Handler)))
 
 	return mux
 }
 
 // Update config and resolve timeout of each API. APIv2 also needs
 // setAlertStatus to be updated.
-func (api *API) Update(cfg *config.Config, setAlertStatus func(model.LabelSet)) {
+func (api *API) Update(cfg *config.Config, setAlertStatus func(*types.Alert)) {
 	api.v2.Update(cfg, setAlertStatus)
 }
 
+func (api *API) authHandler(h http.Handler) http.Handler {
+	if api.authenticator == nil {
+		return h
+	}
+	return api.authenticator.Handler(h)
+}
+
 func (api *API) limitHandler(h http.Handler) http.Handler {
 	concLimiter := http.HandlerFunc(func(rsp http.ResponseWriter, req *http.Request) {
 		if req.Method == http.MethodGet { // Only limit concurrency of GETs.
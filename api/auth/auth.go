@@ -0,0 +1,387 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auth authenticates API clients by their tokens and authorizes
+// their requests by role.
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/common/model"
+	"gopkg.in/yaml.v2"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/pkg/labels"
+)
+
+// Role is a set of permissions granted to API clients.
+type Role string
+
+// Roles of API clients, each one granting the permissions of the previous
+// ones except for alert-writer and silence-editor, which only have their own.
+const (
+	RoleReadOnly      Role = "read-only"
+	RoleAlertWriter   Role = "alert-writer"
+	RoleSilenceEditor Role = "silence-editor"
+	RoleAdmin         Role = "admin"
+)
+
+// Permission is what a request requires of the role of its client.
+type Permission int
+
+const (
+	// PermissionRead allows to read alerts, silences and the status.
+	PermissionRead Permission = iota
+	// PermissionWriteAlerts allows to post and acknowledge alerts.
+	PermissionWriteAlerts
+	// PermissionWriteSilences allows to create, update, expire, approve and
+	// reject silences.
+	PermissionWriteSilences
+	// PermissionAdmin allows everything else.
+	PermissionAdmin
+)
+
+func (p Permission) String() string {
+	switch p {
+	case PermissionRead:
+		return "read"
+	case PermissionWriteAlerts:
+		return "write alerts"
+	case PermissionWriteSilences:
+		return "write silences"
+	}
+	return "admin"
+}
+
+// Allows returns true if the role grants the permission.
+func (r Role) Allows(p Permission) bool {
+	switch r {
+	case RoleAdmin:
+		return true
+	case RoleAlertWriter:
+		return p == PermissionRead || p == PermissionWriteAlerts
+	case RoleSilenceEditor:
+		return p == PermissionRead || p == PermissionWriteSilences
+	case RoleReadOnly:
+		return p == PermissionRead
+	}
+	return false
+}
+
+func (r Role) validate() error {
+	switch r {
+	case RoleReadOnly, RoleAlertWriter, RoleSilenceEditor, RoleAdmin:
+		return nil
+	}
+	return fmt.Errorf("unknown role %q", r)
+}
+
+// Config is the configuration of the API authentication.
+type Config struct {
+	// AnonymousRole is the role of requests without credentials. If empty,
+	// they are rejected.
+	AnonymousRole Role     `yaml:"anonymous_role,omitempty"`
+	Tokens        []*Token `yaml:"tokens"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for Config.
+func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain Config
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.AnonymousRole != "" {
+		if err := c.AnonymousRole.validate(); err != nil {
+			return fmt.Errorf("anonymous_role: %w", err)
+		}
+	}
+	names := map[string]struct{}{}
+	for _, t := range c.Tokens {
+		if _, ok := names[t.Name]; ok {
+			return fmt.Errorf("duplicate token name %q", t.Name)
+		}
+		names[t.Name] = struct{}{}
+	}
+	return nil
+}
+
+// RestrictsAdmin returns an error unless the admin role is granted to at
+// least one token and not to requests without credentials.
+func (c *Config) RestrictsAdmin() error {
+	if c.AnonymousRole == RoleAdmin {
+		return errors.New("the anonymous role must not be admin")
+	}
+	for _, t := range c.Tokens {
+		if t.Role == RoleAdmin {
+			return nil
+		}
+	}
+	return errors.New("no token has the admin role")
+}
+
+// Token is the credential of an API client.
+type Token struct {
+	// Name identifies the client. It is recorded as the creator of the
+	// silences it creates.
+	Name      string        `yaml:"name"`
+	Token     config.Secret `yaml:"token,omitempty"`
+	TokenFile string        `yaml:"token_file,omitempty"`
+	Role      Role          `yaml:"role"`
+	// Matchers restrict the alerts the client may post and acknowledge and
+	// the silences it may edit to the ones matching them.
+	Matchers config.Matchers `yaml:"matchers,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for Token.
+func (t *Token) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain Token
+	if err := unmarshal((*plain)(t)); err != nil {
+		return err
+	}
+	if t.Name == "" {
+		return errors.New("missing name in token")
+	}
+	if t.Token == "" && t.TokenFile == "" {
+		return fmt.Errorf("one of token and token_file must be set for token %q", t.Name)
+	}
+	if t.Token != "" && t.TokenFile != "" {
+		return fmt.Errorf("at most one of token and token_file must be set for token %q", t.Name)
+	}
+	if err := t.Role.validate(); err != nil {
+		return fmt.Errorf("token %q: %w", t.Name, err)
+	}
+	return nil
+}
+
+// Load parses the YAML input s into a Config.
+func Load(s string) (*Config, error) {
+	cfg := &Config{}
+	if err := yaml.UnmarshalStrict([]byte(s), cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// LoadFile parses the given YAML file into a Config, reading the token files
+// relative to its directory.
+func LoadFile(filename string) (*Config, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := Load(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("parsing YAML file %s: %w", filename, err)
+	}
+	for _, t := range cfg.Tokens {
+		if t.TokenFile == "" {
+			continue
+		}
+		path := t.TokenFile
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(filename), path)
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading token file of token %q: %w", t.Name, err)
+		}
+		t.Token = config.Secret(strings.TrimSpace(string(b)))
+		if t.Token == "" {
+			return nil, fmt.Errorf("empty token file of token %q", t.Name)
+		}
+	}
+	return cfg, nil
+}
+
+// Principal is an authenticated API client. A nil Principal, for requests
+// made while authentication is disabled, is allowed everything.
+type Principal struct {
+	// Name is empty for anonymous clients.
+	Name     string
+	Role     Role
+	Matchers labels.Matchers
+}
+
+// Matches returns true if the client may act on alerts with the label set.
+func (p *Principal) Matches(lset model.LabelSet) bool {
+	if p == nil {
+		return true
+	}
+	return p.Matchers.Matches(lset)
+}
+
+// MaySilence returns true if the client may edit silences with the given
+// matchers, that is if they include all matchers of the client.
+func (p *Principal) MaySilence(matchers labels.Matchers) bool {
+	if p == nil {
+		return true
+	}
+	for _, pm := range p.Matchers {
+		found := false
+		for _, m := range matchers {
+			if m.Name == pm.Name && m.Type == pm.Type && m.Value == pm.Value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+type principalKey struct{}
+
+// NewContext returns a context holding the principal.
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal of the context, or nil if there is none.
+func FromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
+
+type credential struct {
+	token     []byte
+	principal *Principal
+}
+
+// Authenticator authenticates and authorizes the requests to the API.
+type Authenticator struct {
+	logger log.Logger
+
+	mtx         sync.RWMutex
+	enabled     bool
+	anonymous   *Principal
+	credentials []credential
+}
+
+// NewAuthenticator returns an Authenticator. It lets all requests through
+// until it is updated with a configuration.
+func NewAuthenticator(l log.Logger) *Authenticator {
+	if l == nil {
+		l = log.NewNopLogger()
+	}
+	return &Authenticator{logger: l}
+}
+
+// Update sets the configuration of the authenticator. A nil configuration
+// disables authentication.
+func (a *Authenticator) Update(c *Config) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	a.enabled = c != nil
+	a.anonymous, a.credentials = nil, nil
+	if c == nil {
+		return
+	}
+	if c.AnonymousRole != "" {
+		a.anonymous = &Principal{Role: c.AnonymousRole}
+	}
+	for _, t := range c.Tokens {
+		a.credentials = append(a.credentials, credential{
+			token: []byte(t.Token),
+			principal: &Principal{
+				Name:     t.Name,
+				Role:     t.Role,
+				Matchers: labels.Matchers(t.Matchers),
+			},
+		})
+	}
+}
+
+// authenticate returns the principal of the request, whether it presented
+// credentials, and whether they are valid.
+func (a *Authenticator) authenticate(r *http.Request) (*Principal, bool) {
+	token := r.Header.Get("X-API-Key")
+	// Other schemes, such as the basic authentication of the web
+	// configuration, are left alone.
+	if scheme, t, _ := strings.Cut(r.Header.Get("Authorization"), " "); strings.EqualFold(scheme, "Bearer") {
+		token = strings.TrimSpace(t)
+	}
+	if token == "" {
+		return a.anonymous, a.anonymous != nil
+	}
+
+	var found *Principal
+	for _, c := range a.credentials {
+		// Compare with all tokens to not leak which one matched.
+		if subtle.ConstantTimeCompare(c.token, []byte(token)) == 1 {
+			found = c.principal
+		}
+	}
+	return found, found != nil
+}
+
+// Handler returns a handler authenticating the requests and passing the
+// authorized ones on to h, with their principal in their context.
+func (a *Authenticator) Handler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.mtx.RLock()
+		enabled := a.enabled
+		p, ok := a.authenticate(r)
+		a.mtx.RUnlock()
+
+		// CORS preflight requests come without credentials.
+		if !enabled || r.Method == http.MethodOptions {
+			h.ServeHTTP(w, r)
+			return
+		}
+		if !ok {
+			level.Debug(a.logger).Log("msg", "Unauthenticated API request", "method", r.Method, "path", r.URL.Path)
+			w.Header().Set("WWW-Authenticate", `Bearer realm="alertmanager"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		perm := RequiredPermission(r)
+		if !p.Role.Allows(perm) {
+			level.Debug(a.logger).Log("msg", "Unauthorized API request", "method", r.Method, "path", r.URL.Path, "client", p.Name, "role", p.Role)
+			http.Error(w, fmt.Sprintf("Role %s is not allowed to %s", p.Role, perm), http.StatusForbidden)
+			return
+		}
+		h.ServeHTTP(w, r.WithContext(NewContext(r.Context(), p)))
+	})
+}
+
+// RequiredPermission returns the permission required by a request to the v2
+// API.
+func RequiredPermission(r *http.Request) Permission {
+	path := strings.TrimPrefix(r.URL.Path, "/api/v2")
+	switch {
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		return PermissionRead
+	case path == "/silences/preview":
+		// Previews do not change anything.
+		return PermissionRead
+	case path == "/alerts" || strings.HasPrefix(path, "/alert/"):
+		return PermissionWriteAlerts
+	case path == "/silences" || path == "/silences/consolidate" || strings.HasPrefix(path, "/silence/"):
+		return PermissionWriteSilences
+	}
+	return PermissionAdmin
+}
@@ -0,0 +1,260 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/pkg/labels"
+)
+
+func TestLoad(t *testing.T) {
+	for _, tc := range []struct {
+		in  string
+		err string
+	}{
+		{
+			in: `
+anonymous_role: read-only
+tokens:
+- name: payments
+  token: secret
+  role: silence-editor
+  matchers: ['team="payments"']
+`,
+		},
+		{
+			in:  "tokens: [{name: a, role: admin}]",
+			err: `one of token and token_file must be set for token "a"`,
+		},
+		{
+			in:  "tokens: [{name: a, token: x, token_file: y, role: admin}]",
+			err: `at most one of token and token_file must be set for token "a"`,
+		},
+		{
+			in:  "tokens: [{name: a, token: x, role: owner}]",
+			err: `token "a": unknown role "owner"`,
+		},
+		{
+			in:  "tokens: [{name: a, token: x, role: admin}, {name: a, token: y, role: admin}]",
+			err: `duplicate token name "a"`,
+		},
+		{
+			in:  "tokens: [{token: x, role: admin}]",
+			err: "missing name in token",
+		},
+		{
+			in:  "anonymous_role: nobody",
+			err: `anonymous_role: unknown role "nobody"`,
+		},
+	} {
+		_, err := Load(tc.in)
+		if tc.err == "" {
+			require.NoError(t, err)
+			continue
+		}
+		require.ErrorContains(t, err, tc.err)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "token"), []byte("from-file\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "auth.yml"), []byte(`
+tokens:
+- name: a
+  token_file: token
+  role: admin
+`), 0o600))
+
+	cfg, err := LoadFile(filepath.Join(dir, "auth.yml"))
+	require.NoError(t, err)
+	require.Equal(t, config.Secret("from-file"), cfg.Tokens[0].Token)
+}
+
+func TestRoleAllows(t *testing.T) {
+	for role, allowed := range map[Role][]Permission{
+		RoleReadOnly:      {PermissionRead},
+		RoleAlertWriter:   {PermissionRead, PermissionWriteAlerts},
+		RoleSilenceEditor: {PermissionRead, PermissionWriteSilences},
+		RoleAdmin:         {PermissionRead, PermissionWriteAlerts, PermissionWriteSilences, PermissionAdmin},
+	} {
+		for _, p := range []Permission{PermissionRead, PermissionWriteAlerts, PermissionWriteSilences, PermissionAdmin} {
+			require.Equal(t, contains(allowed, p), role.Allows(p), "role %s, permission %s", role, p)
+		}
+	}
+}
+
+func TestConfigRestrictsAdmin(t *testing.T) {
+	for _, tc := range []struct {
+		in  string
+		err string
+	}{
+		{
+			in: `
+tokens:
+- {name: ops, token: secret, role: admin}
+`,
+		},
+		{
+			in: `
+anonymous_role: read-only
+tokens:
+- {name: ops, token: secret, role: admin}
+`,
+		},
+		{
+			in: `
+tokens:
+- {name: ops, token: secret, role: silence-editor}
+`,
+			err: "no token has the admin role",
+		},
+		{
+			in: `
+anonymous_role: admin
+tokens:
+- {name: ops, token: secret, role: admin}
+`,
+			err: "the anonymous role must not be admin",
+		},
+	} {
+		cfg, err := Load(tc.in)
+		require.NoError(t, err)
+		err = cfg.RestrictsAdmin()
+		if tc.err == "" {
+			require.NoError(t, err)
+			continue
+		}
+		require.EqualError(t, err, tc.err)
+	}
+}
+
+func contains(ps []Permission, p Permission) bool {
+	for _, q := range ps {
+		if q == p {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRequiredPermission(t *testing.T) {
+	for _, tc := range []struct {
+		method, path string
+		perm         Permission
+	}{
+		{"GET", "/api/v2/silences", PermissionRead},
+		{"GET", "/api/v2/alerts/stream", PermissionRead},
+		{"POST", "/api/v2/silences/preview", PermissionRead},
+		{"POST", "/api/v2/alerts", PermissionWriteAlerts},
+		{"DELETE", "/api/v2/alert/abc/acknowledgement", PermissionWriteAlerts},
+		{"POST", "/api/v2/silences", PermissionWriteSilences},
+		{"DELETE", "/api/v2/silence/abc", PermissionWriteSilences},
+		{"POST", "/api/v2/silence/abc/approve", PermissionWriteSilences},
+		{"POST", "/api/v2/silences/consolidate", PermissionWriteSilences},
+		{"GET", "/api/v2/config", PermissionRead},
+		{"PUT", "/api/v2/config", PermissionAdmin},
+		{"POST", "/api/v2/config/versions/1/rollback", PermissionAdmin},
+		{"POST", "/api/v2/other", PermissionAdmin},
+	} {
+		r := httptest.NewRequest(tc.method, tc.path, nil)
+		require.Equal(t, tc.perm, RequiredPermission(r), "%s %s", tc.method, tc.path)
+	}
+}
+
+func TestPrincipal(t *testing.T) {
+	m, err := labels.NewMatcher(labels.MatchEqual, "team", "payments")
+	require.NoError(t, err)
+	p := &Principal{Name: "payments", Role: RoleSilenceEditor, Matchers: labels.Matchers{m}}
+
+	require.True(t, p.Matches(model.LabelSet{"team": "payments", "alertname": "a"}))
+	require.False(t, p.Matches(model.LabelSet{"team": "billing"}))
+
+	other, err := labels.NewMatcher(labels.MatchEqual, "alertname", "a")
+	require.NoError(t, err)
+	regex, err := labels.NewMatcher(labels.MatchRegexp, "team", "payments")
+	require.NoError(t, err)
+	require.True(t, p.MaySilence(labels.Matchers{other, m}))
+	require.False(t, p.MaySilence(labels.Matchers{other}))
+	require.False(t, p.MaySilence(labels.Matchers{regex}))
+
+	// Without authentication, everything is allowed.
+	var none *Principal
+	require.True(t, none.Matches(model.LabelSet{}))
+	require.True(t, none.MaySilence(nil))
+}
+
+func TestAuthenticatorHandler(t *testing.T) {
+	var got *Principal
+	h := NewAuthenticator(nil)
+	handler := h.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = FromContext(r.Context())
+	}))
+
+	do := func(method, path string, header ...string) int {
+		got = nil
+		r := httptest.NewRequest(method, path, nil)
+		for i := 0; i < len(header); i += 2 {
+			r.Header.Set(header[i], header[i+1])
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	// Requests are let through until the authenticator is configured.
+	require.Equal(t, http.StatusOK, do("POST", "/api/v2/alerts"))
+	require.Nil(t, got)
+
+	cfg, err := Load(`
+tokens:
+- name: writer
+  token: writer-token
+  role: alert-writer
+- name: admin
+  token: admin-token
+  role: admin
+`)
+	require.NoError(t, err)
+	h.Update(cfg)
+
+	require.Equal(t, http.StatusUnauthorized, do("GET", "/api/v2/alerts"))
+	require.Equal(t, http.StatusUnauthorized, do("GET", "/api/v2/alerts", "Authorization", "Bearer wrong"))
+	require.Equal(t, http.StatusUnauthorized, do("GET", "/api/v2/alerts", "Authorization", "Basic d3JpdGVyLXRva2Vu"))
+	require.Equal(t, http.StatusOK, do("OPTIONS", "/api/v2/alerts"))
+
+	require.Equal(t, http.StatusOK, do("POST", "/api/v2/alerts", "Authorization", "Bearer writer-token"))
+	require.Equal(t, "writer", got.Name)
+	require.Equal(t, http.StatusForbidden, do("POST", "/api/v2/silences", "Authorization", "Bearer writer-token"))
+	require.Equal(t, http.StatusOK, do("POST", "/api/v2/silences", "X-API-Key", "admin-token"))
+	require.Equal(t, "admin", got.Name)
+
+	cfg.AnonymousRole = RoleReadOnly
+	h.Update(cfg)
+	require.Equal(t, http.StatusOK, do("GET", "/api/v2/alerts"))
+	require.Equal(t, &Principal{Role: RoleReadOnly}, got)
+	require.Equal(t, http.StatusForbidden, do("POST", "/api/v2/alerts"))
+
+	h.Update(nil)
+	require.Equal(t, http.StatusOK, do("POST", "/api/v2/alerts"))
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/go-kit/log/level"
 	"github.com/go-openapi/analysis"
 	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/prometheus/client_golang/prometheus"
@@ -33,6 +34,9 @@ import (
 	"github.com/prometheus/common/version"
 	"github.com/rs/cors"
 
+	"github.com/prometheus/alertmanager/ack"
+	"github.com/prometheus/alertmanager/alerthistory"
+	"github.com/prometheus/alertmanager/api/auth"
 	"github.com/prometheus/alertmanager/api/metrics"
 	open_api_models "github.com/prometheus/alertmanager/api/v2/models"
 	"github.com/prometheus/alertmanager/api/v2/restapi"
@@ -50,6 +54,7 @@ import (
 	"github.com/prometheus/alertmanager/provider"
 	"github.com/prometheus/alertmanager/silence"
 	"github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/store"
 	"github.com/prometheus/alertmanager/types"
 )
 
@@ -57,6 +62,10 @@ import (
 type API struct {
 	peer           cluster.ClusterPeer
 	silences       *silence.Silences
+	acks           *ack.Acknowledgements
+	history        *alerthistory.History
+	configs        *config.Coordinator
+	configWrites   bool
 	alerts         provider.Alerts
 	alertGroups    groupsFn
 	getAlertStatus getAlertStatusFn
@@ -70,6 +79,8 @@ type API struct {
 	route              *dispatch.Route
 	setAlertStatus     setAlertStatusFn
 
+	stream *alertStream
+
 	logger log.Logger
 	m      *metrics.Alerts
 
@@ -79,7 +90,7 @@ type API struct {
 type (
 	groupsFn         func(func(*dispatch.Route) bool, func(*types.Alert, time.Time) bool) (dispatch.AlertGroups, map[prometheus_model.Fingerprint][]string)
 	getAlertStatusFn func(prometheus_model.Fingerprint) types.AlertStatus
-	setAlertStatusFn func(prometheus_model.LabelSet)
+	setAlertStatusFn func(*types.Alert)
 )
 
 // NewAPI returns a new Alertmanager API v2
@@ -88,6 +99,10 @@ func NewAPI(
 	gf groupsFn,
 	sf getAlertStatusFn,
 	silences *silence.Silences,
+	acks *ack.Acknowledgements,
+	history *alerthistory.History,
+	configs *config.Coordinator,
+	configWrites bool,
 	peer cluster.ClusterPeer,
 	l log.Logger,
 	r prometheus.Registerer,
@@ -98,10 +113,15 @@ func NewAPI(
 		alertGroups:    gf,
 		peer:           peer,
 		silences:       silences,
+		acks:           acks,
+		history:        history,
+		configs:        configs,
+		configWrites:   configWrites,
 		logger:         l,
 		m:              metrics.NewAlerts(r),
 		uptime:         time.Now(),
 	}
+	api.stream = newAlertStream(&api)
 
 	// Load embedded swagger file.
 	swaggerSpec, swaggerSpecAnalysis, err := getSwaggerSpec()
@@ -111,6 +131,9 @@ func NewAPI(
 
 	// Create new service API.
 	openAPI := operations.NewAlertmanagerAPI(swaggerSpec)
+	// Errors of the alert stream are reported as plain text, the events are
+	// written by the stream itself.
+	openAPI.TextEventStreamProducer = runtime.TextProducer()
 
 	// Skip the  redoc middleware, only serving the OpenAPI specification and
 	// the API itself via RoutesHandler. See:
@@ -123,13 +146,26 @@ func NewAPI(
 
 	openAPI.AlertGetAlertsHandler = alert_ops.GetAlertsHandlerFunc(api.getAlertsHandler)
 	openAPI.AlertPostAlertsHandler = alert_ops.PostAlertsHandlerFunc(api.postAlertsHandler)
+	openAPI.AlertStreamAlertsHandler = alert_ops.StreamAlertsHandlerFunc(api.streamAlertsHandler)
+	openAPI.AlertAcknowledgeAlertHandler = alert_ops.AcknowledgeAlertHandlerFunc(api.acknowledgeAlertHandler)
+	openAPI.AlertUnacknowledgeAlertHandler = alert_ops.UnacknowledgeAlertHandlerFunc(api.unacknowledgeAlertHandler)
+	openAPI.AlertGetAlertHistoryHandler = alert_ops.GetAlertHistoryHandlerFunc(api.getAlertHistoryHandler)
 	openAPI.AlertgroupGetAlertGroupsHandler = alertgroup_ops.GetAlertGroupsHandlerFunc(api.getAlertGroupsHandler)
 	openAPI.GeneralGetStatusHandler = general_ops.GetStatusHandlerFunc(api.getStatusHandler)
+	openAPI.GeneralGetConfigHandler = general_ops.GetConfigHandlerFunc(api.getConfigHandler)
+	openAPI.GeneralPutConfigHandler = general_ops.PutConfigHandlerFunc(api.putConfigHandler)
+	openAPI.GeneralRollbackConfigHandler = general_ops.RollbackConfigHandlerFunc(api.rollbackConfigHandler)
 	openAPI.ReceiverGetReceiversHandler = receiver_ops.GetReceiversHandlerFunc(api.getReceiversHandler)
 	openAPI.SilenceDeleteSilenceHandler = silence_ops.DeleteSilenceHandlerFunc(api.deleteSilenceHandler)
 	openAPI.SilenceGetSilenceHandler = silence_ops.GetSilenceHandlerFunc(api.getSilenceHandler)
+	openAPI.SilenceGetSilenceHistoryHandler = silence_ops.GetSilenceHistoryHandlerFunc(api.getSilenceHistoryHandler)
 	openAPI.SilenceGetSilencesHandler = silence_ops.GetSilencesHandlerFunc(api.getSilencesHandler)
 	openAPI.SilencePostSilencesHandler = silence_ops.PostSilencesHandlerFunc(api.postSilencesHandler)
+	openAPI.SilencePreviewSilenceHandler = silence_ops.PreviewSilenceHandlerFunc(api.previewSilenceHandler)
+	openAPI.SilenceGetSilenceOverlapsHandler = silence_ops.GetSilenceOverlapsHandlerFunc(api.getSilenceOverlapsHandler)
+	openAPI.SilenceConsolidateSilencesHandler = silence_ops.ConsolidateSilencesHandlerFunc(api.consolidateSilencesHandler)
+	openAPI.SilenceApproveSilenceHandler = silence_ops.ApproveSilenceHandlerFunc(api.approveSilenceHandler)
+	openAPI.SilenceRejectSilenceHandler = silence_ops.RejectSilenceHandlerFunc(api.rejectSilenceHandler)
 
 	handleCORS := cors.Default().Handler
 	api.Handler = handleCORS(setResponseHeaders(openAPI.Serve(nil)))
@@ -220,6 +256,84 @@ func (api *API) getStatusHandler(params general_ops.GetStatusParams) middleware.
 	return general_ops.NewGetStatusOK().WithPayload(&resp)
 }
 
+func (api *API) getConfigHandler(params general_ops.GetConfigParams) middleware.Responder {
+	resp := &open_api_models.ConfigVersions{Versions: []*open_api_models.ConfigVersion{}}
+	if api.configs == nil {
+		return general_ops.NewGetConfigOK().WithPayload(resp)
+	}
+
+	for _, v := range api.configs.Versions() {
+		resp.Versions = append(resp.Versions, ConfigVersionToOpenAPI(v))
+	}
+	if n := len(resp.Versions); n > 0 {
+		resp.Current = resp.Versions[n-1]
+	}
+	return general_ops.NewGetConfigOK().WithPayload(resp)
+}
+
+var errConfigWritesDisabled = errors.New("changing the configuration through the API is disabled")
+
+// Applying a configuration doesn't hold api.mtx, as the subscribers of the
+// coordinator update the API.
+func (api *API) putConfigHandler(params general_ops.PutConfigParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	if api.configs == nil {
+		return general_ops.NewPutConfigInternalServerError().WithPayload("configuration management is not available")
+	}
+	if !api.configWrites {
+		return general_ops.NewPutConfigForbidden().WithPayload(errConfigWritesDisabled.Error())
+	}
+
+	var (
+		v   *config.Version
+		err error
+	)
+	if params.Config.DryRun {
+		v, err = api.configs.Validate(*params.Config.Config)
+	} else {
+		v, err = api.configs.Apply(*params.Config.Config, params.Config.Persist)
+	}
+	if err != nil {
+		var invalid *config.InvalidConfigError
+		if errors.As(err, &invalid) {
+			level.Debug(logger).Log("msg", "Invalid configuration", "err", err)
+			return general_ops.NewPutConfigBadRequest().WithPayload(err.Error())
+		}
+		level.Error(logger).Log("msg", "Failed to apply configuration", "err", err)
+		return general_ops.NewPutConfigInternalServerError().WithPayload(err.Error())
+	}
+
+	return general_ops.NewPutConfigOK().WithPayload(ConfigVersionToOpenAPI(v))
+}
+
+func (api *API) rollbackConfigHandler(params general_ops.RollbackConfigParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	if api.configs == nil {
+		return general_ops.NewRollbackConfigNotFound()
+	}
+	if !api.configWrites {
+		return general_ops.NewRollbackConfigForbidden().WithPayload(errConfigWritesDisabled.Error())
+	}
+
+	v, err := api.configs.Rollback(int(params.VersionID), params.Persist != nil && *params.Persist)
+	if err != nil {
+		var invalid *config.InvalidConfigError
+		switch {
+		case errors.Is(err, config.ErrVersionNotFound):
+			return general_ops.NewRollbackConfigNotFound()
+		case errors.As(err, &invalid):
+			level.Debug(logger).Log("msg", "Invalid configuration", "err", err)
+			return general_ops.NewRollbackConfigBadRequest().WithPayload(err.Error())
+		}
+		level.Error(logger).Log("msg", "Failed to roll back configuration", "err", err, "version", params.VersionID)
+		return general_ops.NewRollbackConfigInternalServerError().WithPayload(err.Error())
+	}
+
+	return general_ops.NewRollbackConfigOK().WithPayload(ConfigVersionToOpenAPI(v))
+}
+
 func (api *API) getReceiversHandler(params receiver_ops.GetReceiversParams) middleware.Responder {
 	api.mtx.RLock()
 	defer api.mtx.RUnlock()
@@ -300,11 +414,28 @@ func (api *API) getAlertsHandler(params alert_ops.GetAlertsParams) middleware.Re
 		level.Error(logger).Log("msg", "Failed to get alerts", "err", err)
 		return alert_ops.NewGetAlertsInternalServerError().WithPayload(err.Error())
 	}
-	sort.Slice(res, func(i, j int) bool {
-		return *res[i].Fingerprint < *res[j].Fingerprint
-	})
 
-	return alert_ops.NewGetAlertsOK().WithPayload(res)
+	sp, err := parseSort(params.Sort, "fingerprint", "startsAt", "severity")
+	if err != nil {
+		return alert_ops.NewGetAlertsBadRequest().WithPayload(err.Error())
+	}
+	res, next, err := paginate(res, func(a *open_api_models.GettableAlert) string {
+		switch sp.key {
+		case "startsAt":
+			return timeKey(time.Time(*a.StartsAt)) + "\x00" + *a.Fingerprint
+		case "severity":
+			return severityKey(a.Labels) + "\x00" + *a.Fingerprint
+		}
+		return *a.Fingerprint
+	}, sp, params.Cursor, params.Limit)
+	if err != nil {
+		return alert_ops.NewGetAlertsBadRequest().WithPayload(err.Error())
+	}
+
+	if len(params.Fields) > 0 {
+		return &projectedResponse{payload: res, fields: params.Fields, nextCursor: next}
+	}
+	return alert_ops.NewGetAlertsOK().WithPayload(res).WithXNextCursor(next)
 }
 
 func (api *API) postAlertsHandler(params alert_ops.PostAlertsParams) middleware.Responder {
@@ -313,6 +444,15 @@ func (api *API) postAlertsHandler(params alert_ops.PostAlertsParams) middleware.
 	alerts := OpenAPIAlertsToAlerts(params.Alerts)
 	now := time.Now()
 
+	p := auth.FromContext(params.HTTPRequest.Context())
+	for _, a := range alerts {
+		if !p.Matches(a.Labels) {
+			msg := fmt.Sprintf("%q may only post alerts matching %s", p.Name, p.Matchers)
+			level.Error(logger).Log("msg", "Failed to create alerts", "err", msg)
+			return alert_ops.NewPostAlertsForbidden().WithPayload(msg)
+		}
+	}
+
 	api.mtx.RLock()
 	resolveTimeout := time.Duration(api.alertmanagerConfig.Global.ResolveTimeout)
 	api.mtx.RUnlock()
@@ -369,6 +509,101 @@ func (api *API) postAlertsHandler(params alert_ops.PostAlertsParams) middleware.
 	return alert_ops.NewPostAlertsOK()
 }
 
+func (api *API) acknowledgeAlertHandler(params alert_ops.AcknowledgeAlertParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	fp, err := prometheus_model.ParseFingerprint(params.Fingerprint)
+	if err != nil {
+		level.Debug(logger).Log("msg", "Failed to parse fingerprint", "err", err)
+		return alert_ops.NewAcknowledgeAlertBadRequest().WithPayload(err.Error())
+	}
+
+	a, err := api.alerts.Get(fp)
+	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) || errors.Is(err, store.ErrNotFound) {
+			return alert_ops.NewAcknowledgeAlertNotFound()
+		}
+		level.Error(logger).Log("msg", "Failed to get alert", "err", err, "fingerprint", fp)
+		return alert_ops.NewAcknowledgeAlertInternalServerError().WithPayload(err.Error())
+	}
+	if a.Resolved() {
+		return alert_ops.NewAcknowledgeAlertNotFound()
+	}
+
+	by, comment := *params.Acknowledgement.AcknowledgedBy, params.Acknowledgement.Comment
+	if p := auth.FromContext(params.HTTPRequest.Context()); p != nil {
+		if !p.Matches(a.Labels) {
+			return alert_ops.NewAcknowledgeAlertForbidden().WithPayload(
+				fmt.Sprintf("%q may only acknowledge alerts matching %s", p.Name, p.Matchers),
+			)
+		}
+		if p.Name != "" {
+			by = p.Name
+		}
+	}
+	if err := api.acks.Acknowledge(fp, a.StartsAt, by, comment); err != nil {
+		level.Error(logger).Log("msg", "Failed to acknowledge alert", "err", err, "fingerprint", fp)
+		return alert_ops.NewAcknowledgeAlertInternalServerError().WithPayload(err.Error())
+	}
+	level.Info(logger).Log("msg", "Alert acknowledged", "fingerprint", fp, "by", by)
+	return alert_ops.NewAcknowledgeAlertOK()
+}
+
+func (api *API) unacknowledgeAlertHandler(params alert_ops.UnacknowledgeAlertParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	fp, err := prometheus_model.ParseFingerprint(params.Fingerprint)
+	if err != nil {
+		return alert_ops.NewUnacknowledgeAlertNotFound()
+	}
+
+	if p := auth.FromContext(params.HTTPRequest.Context()); p != nil && len(p.Matchers) > 0 {
+		a, err := api.alerts.Get(fp)
+		if err != nil {
+			return alert_ops.NewUnacknowledgeAlertNotFound()
+		}
+		if !p.Matches(a.Labels) {
+			return alert_ops.NewUnacknowledgeAlertForbidden().WithPayload(
+				fmt.Sprintf("%q may only acknowledge alerts matching %s", p.Name, p.Matchers),
+			)
+		}
+	}
+
+	if err := api.acks.Withdraw(fp); err != nil {
+		if errors.Is(err, ack.ErrNotFound) {
+			return alert_ops.NewUnacknowledgeAlertNotFound()
+		}
+		level.Error(logger).Log("msg", "Failed to withdraw acknowledgement", "err", err, "fingerprint", fp)
+		return alert_ops.NewUnacknowledgeAlertInternalServerError().WithPayload(err.Error())
+	}
+	level.Info(logger).Log("msg", "Acknowledgement withdrawn", "fingerprint", fp)
+	return alert_ops.NewUnacknowledgeAlertOK()
+}
+
+func (api *API) getAlertHistoryHandler(params alert_ops.GetAlertHistoryParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	fp, err := prometheus_model.ParseFingerprint(params.Fingerprint)
+	if err != nil {
+		level.Debug(logger).Log("msg", "Failed to parse fingerprint", "err", err)
+		return alert_ops.NewGetAlertHistoryBadRequest().WithPayload(err.Error())
+	}
+	if api.history == nil {
+		return alert_ops.NewGetAlertHistoryNotFound()
+	}
+
+	h, err := api.history.Get(fp)
+	if err != nil {
+		if errors.Is(err, alerthistory.ErrNotFound) {
+			return alert_ops.NewGetAlertHistoryNotFound()
+		}
+		level.Error(logger).Log("msg", "Failed to get alert history", "err", err, "fingerprint", fp)
+		return alert_ops.NewGetAlertHistoryInternalServerError().WithPayload(err.Error())
+	}
+
+	return alert_ops.NewGetAlertHistoryOK().WithPayload(AlertHistoryToOpenAPI(h))
+}
+
 func (api *API) getAlertGroupsHandler(params alertgroup_ops.GetAlertGroupsParams) middleware.Responder {
 	logger := api.requestLogger(params.HTTPRequest)
 
@@ -423,7 +658,42 @@ func (api *API) getAlertGroupsHandler(params alertgroup_ops.GetAlertGroupsParams
 		res = append(res, ag)
 	}
 
-	return alertgroup_ops.NewGetAlertGroupsOK().WithPayload(res)
+	sp, err := parseSort(params.Sort, "labels", "startsAt", "severity")
+	if err != nil {
+		return alertgroup_ops.NewGetAlertGroupsBadRequest().WithPayload(err.Error())
+	}
+	res, next, err := paginate(res, func(ag *open_api_models.AlertGroup) string {
+		key := APILabelSetToModelLabelSet(ag.Labels).String() + "\x00" + *ag.Receiver.Name
+		switch sp.key {
+		case "startsAt":
+			// Groups start with their earliest alert.
+			var startsAt time.Time
+			for _, a := range ag.Alerts {
+				if t := time.Time(*a.StartsAt); startsAt.IsZero() || t.Before(startsAt) {
+					startsAt = t
+				}
+			}
+			return timeKey(startsAt) + "\x00" + key
+		case "severity":
+			// Groups are as severe as their most severe alert.
+			var sev string
+			for i, a := range ag.Alerts {
+				if k := severityKey(a.Labels); i == 0 || k < sev {
+					sev = k
+				}
+			}
+			return sev + "\x00" + key
+		}
+		return key
+	}, sp, params.Cursor, params.Limit)
+	if err != nil {
+		return alertgroup_ops.NewGetAlertGroupsBadRequest().WithPayload(err.Error())
+	}
+
+	if len(params.Fields) > 0 {
+		return &projectedResponse{payload: res, fields: params.Fields, nextCursor: next}
+	}
+	return alertgroup_ops.NewGetAlertGroupsOK().WithPayload(res).WithXNextCursor(next)
 }
 
 func (api *API) alertFilter(matchers []*labels.Matcher, silenced, inhibited, active bool) func(a *types.Alert, now time.Time) bool {
@@ -433,7 +703,7 @@ func (api *API) alertFilter(matchers []*labels.Matcher, silenced, inhibited, act
 		}
 
 		// Set alert's current status based on its label set.
-		api.setAlertStatus(a.Labels)
+		api.setAlertStatus(a)
 
 		// Get alert's current status after seeing if it is suppressed.
 		status := api.getAlertStatus(a.Fingerprint())
@@ -529,24 +799,47 @@ func (api *API) getSilencesHandler(params silence_ops.GetSilencesParams) middlew
 			level.Error(logger).Log("msg", "Failed to unmarshal silence from proto", "err", err)
 			return silence_ops.NewGetSilencesInternalServerError().WithPayload(err.Error())
 		}
+		api.setNextActiveWindow(&silence, ps)
 		sils = append(sils, &silence)
 	}
 
-	SortSilences(sils)
+	sp, err := parseSort(params.Sort, "", "startsAt", "endsAt", "id")
+	if err != nil {
+		return silence_ops.NewGetSilencesBadRequest().WithPayload(err.Error())
+	}
+	sils, next, err := paginate(sils, func(s *open_api_models.GettableSilence) string {
+		switch sp.key {
+		case "startsAt":
+			return timeKey(time.Time(*s.StartsAt)) + "\x00" + *s.ID
+		case "endsAt":
+			return timeKey(time.Time(*s.EndsAt)) + "\x00" + *s.ID
+		case "id":
+			return *s.ID
+		}
+		return silenceKey(s)
+	}, sp, params.Cursor, params.Limit)
+	if err != nil {
+		return silence_ops.NewGetSilencesBadRequest().WithPayload(err.Error())
+	}
 
-	return silence_ops.NewGetSilencesOK().WithPayload(sils)
+	if len(params.Fields) > 0 {
+		return &projectedResponse{payload: sils, fields: params.Fields, nextCursor: next}
+	}
+	return silence_ops.NewGetSilencesOK().WithPayload(sils).WithXNextCursor(next)
 }
 
 var silenceStateOrder = map[types.SilenceState]int{
-	types.SilenceStateActive:  1,
-	types.SilenceStatePending: 2,
-	types.SilenceStateExpired: 3,
+	types.SilenceStateActive:          1,
+	types.SilenceStatePendingApproval: 2,
+	types.SilenceStatePending:         3,
+	types.SilenceStateExpired:         4,
 }
 
-// SortSilences sorts first according to the state "active, pending, expired"
-// then by end time or start time depending on the state.
+// SortSilences sorts first according to the state "active, pending approval,
+// pending, expired" then by end time or start time depending on the state.
 // active silences should show the next to expire first
-// pending silences are ordered based on which one starts next
+// pending silences and silences pending approval are ordered based on which
+// one starts next
 // expired are ordered based on which one expired most recently
 func SortSilences(sils open_api_models.GettableSilences) {
 	sort.Slice(sils, func(i, j int) bool {
@@ -560,7 +853,7 @@ func SortSilences(sils open_api_models.GettableSilences) {
 			endsAt1 := time.Time(*sils[i].Silence.EndsAt)
 			endsAt2 := time.Time(*sils[j].Silence.EndsAt)
 			return endsAt1.Before(endsAt2)
-		case types.SilenceStatePending:
+		case types.SilenceStatePending, types.SilenceStatePendingApproval:
 			startsAt1 := time.Time(*sils[i].Silence.StartsAt)
 			startsAt2 := time.Time(*sils[j].Silence.StartsAt)
 			return startsAt1.Before(startsAt2)
@@ -573,6 +866,21 @@ func SortSilences(sils open_api_models.GettableSilences) {
 	})
 }
 
+// silenceKey returns the key sorting silences in the order of SortSilences.
+func silenceKey(s *open_api_models.GettableSilence) string {
+	state := types.SilenceState(*s.Status.State)
+	var t string
+	switch state {
+	case types.SilenceStateActive:
+		t = timeKey(time.Time(*s.EndsAt))
+	case types.SilenceStatePending, types.SilenceStatePendingApproval:
+		t = timeKey(time.Time(*s.StartsAt))
+	case types.SilenceStateExpired:
+		t = reverseTimeKey(time.Time(*s.EndsAt))
+	}
+	return fmt.Sprintf("%d\x00%s\x00%s", silenceStateOrder[state], t, *s.ID)
+}
+
 // CheckSilenceMatchesFilterLabels returns true if
 // a given silence matches a list of matchers.
 // A silence matches a filter (list of matchers) if
@@ -619,14 +927,64 @@ func (api *API) getSilenceHandler(params silence_ops.GetSilenceParams) middlewar
 		level.Error(logger).Log("msg", "Failed to convert unmarshal from proto", "err", err)
 		return silence_ops.NewGetSilenceInternalServerError().WithPayload(err.Error())
 	}
+	api.setNextActiveWindow(&sil, sils[0])
 
 	return silence_ops.NewGetSilenceOK().WithPayload(&sil)
 }
 
+// setNextActiveWindow adds the next window of time during which a recurring
+// silence mutes alerts to its status.
+func (api *API) setNextActiveWindow(sil *open_api_models.GettableSilence, ps *silencepb.Silence) {
+	if len(ps.TimeIntervals) == 0 {
+		return
+	}
+	start, end, err := api.silences.NextActiveWindow(ps)
+	if err != nil {
+		if !errors.Is(err, silence.ErrNoActiveWindow) {
+			level.Warn(api.logger).Log("msg", "Failed to compute next active window of silence", "silence", ps.Id, "err", err)
+		}
+		return
+	}
+	startsAt, endsAt := strfmt.DateTime(start), strfmt.DateTime(end)
+	sil.Status.NextActiveWindow = &open_api_models.SilenceStatusNextActiveWindow{
+		StartsAt: &startsAt,
+		EndsAt:   &endsAt,
+	}
+}
+
+func (api *API) getSilenceHistoryHandler(params silence_ops.GetSilenceHistoryParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	revs, err := api.silences.History(params.SilenceID.String())
+	if err != nil {
+		if errors.Is(err, silence.ErrNotFound) {
+			return silence_ops.NewGetSilenceHistoryNotFound()
+		}
+		level.Error(logger).Log("msg", "Failed to get silence history", "err", err, "id", params.SilenceID.String())
+		return silence_ops.NewGetSilenceHistoryInternalServerError().WithPayload(err.Error())
+	}
+
+	history := make(open_api_models.SilenceHistory, 0, len(revs))
+	for _, rev := range revs {
+		r, err := SilenceRevisionFromProto(rev)
+		if err != nil {
+			level.Error(logger).Log("msg", "Failed to convert unmarshal from proto", "err", err)
+			return silence_ops.NewGetSilenceHistoryInternalServerError().WithPayload(err.Error())
+		}
+		history = append(history, &r)
+	}
+
+	return silence_ops.NewGetSilenceHistoryOK().WithPayload(history)
+}
+
 func (api *API) deleteSilenceHandler(params silence_ops.DeleteSilenceParams) middleware.Responder {
 	logger := api.requestLogger(params.HTTPRequest)
 
 	sid := params.SilenceID.String()
+	if msg, ok := api.mayEditSilence(auth.FromContext(params.HTTPRequest.Context()), sid); !ok {
+		level.Error(logger).Log("msg", "Failed to expire silence", "err", msg, "id", sid)
+		return silence_ops.NewDeleteSilenceForbidden().WithPayload(msg)
+	}
 	if err := api.silences.Expire(sid); err != nil {
 		level.Error(logger).Log("msg", "Failed to expire silence", "err", err)
 		if errors.Is(err, silence.ErrNotFound) {
@@ -660,6 +1018,29 @@ func (api *API) postSilencesHandler(params silence_ops.PostSilencesParams) middl
 		return silence_ops.NewPostSilencesBadRequest().WithPayload(msg)
 	}
 
+	p := auth.FromContext(params.HTTPRequest.Context())
+	msg, ok := mayEditMatchers(p, silenceMatchers(sil))
+	if ok && sil.Id != "" {
+		msg, ok = api.mayEditSilence(p, sil.Id)
+	}
+	if !ok {
+		level.Error(logger).Log("msg", "Failed to create silence", "err", msg)
+		return silence_ops.NewPostSilencesForbidden().WithPayload(msg)
+	}
+	if p != nil && p.Name != "" {
+		sil.CreatedBy = p.Name
+	}
+
+	if err := api.checkSilencePolicy(sil); err != nil {
+		level.Error(logger).Log("msg", "Failed to create silence", "err", err)
+		return silence_ops.NewPostSilencesBadRequest().WithPayload(err.Error())
+	}
+
+	if len(api.silenceApprovalRules(sil)) > 0 {
+		// Any change to the silence must be approved again.
+		sil.Approval = &silencepb.Approval{State: silencepb.Approval_PENDING}
+	}
+
 	sid, err := api.silences.Set(sil)
 	if err != nil {
 		level.Error(logger).Log("msg", "Failed to create silence", "err", err)
@@ -674,6 +1055,367 @@ func (api *API) postSilencesHandler(params silence_ops.PostSilencesParams) middl
 	})
 }
 
+func (api *API) previewSilenceHandler(params silence_ops.PreviewSilenceParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	sil, err := PostableSilenceToProto(params.Silence)
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to marshal silence to proto", "err", err)
+		return silence_ops.NewPreviewSilenceBadRequest().WithPayload(
+			fmt.Sprintf("failed to convert API silence to internal silence: %v", err.Error()),
+		)
+	}
+	if !sil.StartsAt.Before(sil.EndsAt) {
+		return silence_ops.NewPreviewSilenceBadRequest().WithPayload("start time must be before end time")
+	}
+	if err := api.checkSilencePolicy(sil); err != nil {
+		return silence_ops.NewPreviewSilenceBadRequest().WithPayload(err.Error())
+	}
+
+	// When updating a silence, the alerts it currently mutes and the updated
+	// silence does not would be un-muted.
+	var prevMatchers labels.Matchers
+	if sil.Id != "" {
+		prev, err := api.silences.QueryOne(silence.QIDs(sil.Id))
+		if err != nil {
+			level.Error(logger).Log("msg", "Failed to get silence by id", "err", err, "id", sil.Id)
+			if errors.Is(err, silence.ErrNotFound) {
+				return silence_ops.NewPreviewSilenceNotFound().WithPayload(err.Error())
+			}
+			return silence_ops.NewPreviewSilenceInternalServerError().WithPayload(err.Error())
+		}
+		if ps, err := GettableSilenceFromProto(prev); err == nil && *ps.Status.State == string(types.SilenceStateActive) {
+			prevMatchers = silenceMatchers(prev)
+		}
+	}
+	matchers := silenceMatchers(sil)
+
+	var (
+		res = &open_api_models.SilencePreview{
+			Alerts:        open_api_models.GettableAlerts{},
+			UnmutedAlerts: open_api_models.GettableAlerts{},
+			Receivers:     []*open_api_models.Receiver{},
+			Groups:        open_api_models.AlertGroups{},
+		}
+		receivers = map[string]struct{}{}
+	)
+
+	alerts := api.alerts.GetPending()
+	defer alerts.Close()
+
+	api.mtx.RLock()
+	for a := range alerts.Next() {
+		if err = alerts.Err(); err != nil {
+			break
+		}
+		if a.Resolved() {
+			continue
+		}
+		muted := matchers.Matches(a.Labels)
+		unmuted := !muted && len(prevMatchers) > 0 && prevMatchers.Matches(a.Labels)
+		if !muted && !unmuted {
+			continue
+		}
+
+		routes := api.route.Match(a.Labels)
+		rs := make([]string, 0, len(routes))
+		for _, r := range routes {
+			rs = append(rs, r.RouteOpts.Receiver)
+		}
+		api.setAlertStatus(a)
+		alert := AlertToOpenAPIAlert(a, api.getAlertStatus(a.Fingerprint()), rs)
+
+		if unmuted {
+			res.UnmutedAlerts = append(res.UnmutedAlerts, alert)
+			continue
+		}
+		res.Alerts = append(res.Alerts, alert)
+		for _, r := range rs {
+			receivers[r] = struct{}{}
+		}
+	}
+	api.mtx.RUnlock()
+
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to get alerts", "err", err)
+		return silence_ops.NewPreviewSilenceInternalServerError().WithPayload(err.Error())
+	}
+
+	alertGroups, allReceivers := api.alertGroups(
+		func(*dispatch.Route) bool { return true },
+		func(a *types.Alert, now time.Time) bool {
+			return !a.ResolvedAt(now) && matchers.Matches(a.Labels)
+		},
+	)
+	for _, alertGroup := range alertGroups {
+		ag := &open_api_models.AlertGroup{
+			Receiver: &open_api_models.Receiver{Name: &alertGroup.Receiver},
+			Labels:   ModelLabelSetToAPILabelSet(alertGroup.Labels),
+			Alerts:   make([]*open_api_models.GettableAlert, 0, len(alertGroup.Alerts)),
+		}
+		for _, alert := range alertGroup.Alerts {
+			fp := alert.Fingerprint()
+			ag.Alerts = append(ag.Alerts, AlertToOpenAPIAlert(alert, api.getAlertStatus(fp), allReceivers[fp]))
+		}
+		res.Groups = append(res.Groups, ag)
+	}
+
+	names := make([]string, 0, len(receivers))
+	for r := range receivers {
+		names = append(names, r)
+	}
+	sort.Strings(names)
+	for i := range names {
+		res.Receivers = append(res.Receivers, &open_api_models.Receiver{Name: &names[i]})
+	}
+	for _, as := range []open_api_models.GettableAlerts{res.Alerts, res.UnmutedAlerts} {
+		sort.Slice(as, func(i, j int) bool {
+			return *as[i].Fingerprint < *as[j].Fingerprint
+		})
+	}
+
+	return silence_ops.NewPreviewSilenceOK().WithPayload(res)
+}
+
+func (api *API) getSilenceOverlapsHandler(params silence_ops.GetSilenceOverlapsParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	overlaps, err := api.silences.Overlaps()
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to find overlapping silences", "err", err)
+		return silence_ops.NewGetSilenceOverlapsInternalServerError().WithPayload(err.Error())
+	}
+
+	res := make(open_api_models.SilenceOverlaps, 0, len(overlaps))
+	for _, o := range overlaps {
+		kind := string(o.Kind)
+		res = append(res, &open_api_models.SilenceOverlap{
+			Kind:           &kind,
+			SilenceID:      &o.Silence.Id,
+			OtherSilenceID: &o.Other.Id,
+		})
+	}
+
+	return silence_ops.NewGetSilenceOverlapsOK().WithPayload(res)
+}
+
+func (api *API) consolidateSilencesHandler(params silence_ops.ConsolidateSilencesParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	ids := params.Consolidation.SilenceIDs
+	p := auth.FromContext(params.HTTPRequest.Context())
+	for _, id := range ids {
+		if msg, ok := api.mayEditSilence(p, id); !ok {
+			level.Error(logger).Log("msg", "Failed to consolidate silences", "err", msg, "id", id)
+			return silence_ops.NewConsolidateSilencesForbidden().WithPayload(msg)
+		}
+	}
+	sil, err := api.silences.Consolidated(ids)
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to consolidate silences", "err", err)
+		if errors.Is(err, silence.ErrNotFound) {
+			return silence_ops.NewConsolidateSilencesNotFound().WithPayload(err.Error())
+		}
+		return silence_ops.NewConsolidateSilencesBadRequest().WithPayload(err.Error())
+	}
+	sil.CreatedBy = *params.Consolidation.CreatedBy
+	if p != nil && p.Name != "" {
+		sil.CreatedBy = p.Name
+	}
+
+	if err := api.checkSilencePolicy(sil); err != nil {
+		level.Error(logger).Log("msg", "Failed to consolidate silences", "err", err)
+		return silence_ops.NewConsolidateSilencesBadRequest().WithPayload(err.Error())
+	}
+	// The consolidated silences would have to keep muting the alerts until
+	// the new silence is approved, leaving more silences than before.
+	if len(api.silenceApprovalRules(sil)) > 0 {
+		msg := "the consolidated silence requires approval"
+		level.Error(logger).Log("msg", "Failed to consolidate silences", "err", msg)
+		return silence_ops.NewConsolidateSilencesBadRequest().WithPayload(msg)
+	}
+
+	sid, err := api.silences.Set(sil)
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to create silence", "err", err)
+		return silence_ops.NewConsolidateSilencesBadRequest().WithPayload(err.Error())
+	}
+
+	for _, id := range ids {
+		if err := api.silences.Expire(id); err != nil {
+			level.Error(logger).Log("msg", "Failed to expire consolidated silence", "id", id, "err", err)
+			return silence_ops.NewConsolidateSilencesInternalServerError().WithPayload(err.Error())
+		}
+	}
+
+	return silence_ops.NewConsolidateSilencesOK().WithPayload(&silence_ops.ConsolidateSilencesOKBody{
+		SilenceID: sid,
+	})
+}
+
+func (api *API) approveSilenceHandler(params silence_ops.ApproveSilenceParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	sid := params.SilenceID.String()
+	decidedBy, comment := *params.Decision.DecidedBy, params.Decision.Comment
+	p := auth.FromContext(params.HTTPRequest.Context())
+	if p != nil && p.Name != "" {
+		decidedBy = p.Name
+	}
+	msg, ok := api.mayEditSilence(p, sid)
+	if ok {
+		msg, ok = api.mayDecideOnSilence(sid, decidedBy)
+	}
+	if !ok {
+		level.Error(logger).Log("msg", "Failed to approve silence", "err", msg, "id", sid)
+		return silence_ops.NewApproveSilenceForbidden().WithPayload(msg)
+	}
+
+	if err := api.silences.Approve(sid, decidedBy, comment); err != nil {
+		level.Error(logger).Log("msg", "Failed to approve silence", "err", err, "id", sid)
+		switch {
+		case errors.Is(err, silence.ErrNotFound):
+			return silence_ops.NewApproveSilenceNotFound()
+		case errors.Is(err, silence.ErrSelfApproval):
+			return silence_ops.NewApproveSilenceForbidden().WithPayload(err.Error())
+		case errors.Is(err, silence.ErrNotPendingApproval):
+			return silence_ops.NewApproveSilenceBadRequest().WithPayload(err.Error())
+		}
+		return silence_ops.NewApproveSilenceInternalServerError().WithPayload(err.Error())
+	}
+	level.Info(logger).Log("msg", "Silence approved", "id", sid, "approver", decidedBy)
+	return silence_ops.NewApproveSilenceOK()
+}
+
+func (api *API) rejectSilenceHandler(params silence_ops.RejectSilenceParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	sid := params.SilenceID.String()
+	decidedBy, comment := *params.Decision.DecidedBy, params.Decision.Comment
+	p := auth.FromContext(params.HTTPRequest.Context())
+	if p != nil && p.Name != "" {
+		decidedBy = p.Name
+	}
+	msg, ok := api.mayEditSilence(p, sid)
+	if ok {
+		msg, ok = api.mayDecideOnSilence(sid, decidedBy)
+	}
+	if !ok {
+		level.Error(logger).Log("msg", "Failed to reject silence", "err", msg, "id", sid)
+		return silence_ops.NewRejectSilenceForbidden().WithPayload(msg)
+	}
+
+	if err := api.silences.Reject(sid, decidedBy, comment); err != nil {
+		level.Error(logger).Log("msg", "Failed to reject silence", "err", err, "id", sid)
+		switch {
+		case errors.Is(err, silence.ErrNotFound):
+			return silence_ops.NewRejectSilenceNotFound()
+		case errors.Is(err, silence.ErrNotPendingApproval):
+			return silence_ops.NewRejectSilenceBadRequest().WithPayload(err.Error())
+		}
+		return silence_ops.NewRejectSilenceInternalServerError().WithPayload(err.Error())
+	}
+	level.Info(logger).Log("msg", "Silence rejected", "id", sid, "approver", decidedBy)
+	return silence_ops.NewRejectSilenceOK()
+}
+
+// mayEditSilence checks that the client may edit the silence with the given
+// ID. Unknown silences are left for the caller to report.
+func (api *API) mayEditSilence(p *auth.Principal, sid string) (string, bool) {
+	if p == nil || len(p.Matchers) == 0 {
+		return "", true
+	}
+	sil, err := api.silences.QueryOne(silence.QIDs(sid))
+	if err != nil {
+		return "", true
+	}
+	return mayEditMatchers(p, silenceMatchers(sil))
+}
+
+// mayEditMatchers checks that the client may edit silences with the given
+// matchers.
+func mayEditMatchers(p *auth.Principal, matchers labels.Matchers) (string, bool) {
+	if p.MaySilence(matchers) {
+		return "", true
+	}
+	return fmt.Sprintf("%q may only edit silences with the matchers %s", p.Name, p.Matchers), false
+}
+
+// mayDecideOnSilence checks that the approver is allowed by all the silence
+// approval rules applying to the silence with the given ID. Unknown silences
+// are left for the caller to report.
+func (api *API) mayDecideOnSilence(sid, approver string) (string, bool) {
+	sil, err := api.silences.QueryOne(silence.QIDs(sid))
+	if err != nil {
+		return "", true
+	}
+	for _, r := range api.silenceApprovalRules(sil) {
+		if !r.MayApprove(approver) {
+			return fmt.Sprintf("%q is not allowed to approve or reject the silence", approver), false
+		}
+	}
+	return "", true
+}
+
+// checkSilencePolicy returns an error if the silence violates the configured
+// silence policy.
+func (api *API) checkSilencePolicy(sil *silencepb.Silence) error {
+	api.mtx.RLock()
+	var policy *config.SilencePolicy
+	if api.alertmanagerConfig != nil {
+		policy = api.alertmanagerConfig.SilencePolicy
+	}
+	api.mtx.RUnlock()
+
+	if policy == nil {
+		return nil
+	}
+	if err := policy.Check(silenceMatchers(sil), sil.StartsAt, sil.EndsAt, sil.Comment, time.Now()); err != nil {
+		return err
+	}
+	if policy.MaxActivePerCreator == 0 {
+		return nil
+	}
+
+	sils, _, err := api.silences.Query(
+		silence.QState(types.SilenceStateActive, types.SilenceStatePending, types.SilenceStatePendingApproval),
+	)
+	if err != nil {
+		return err
+	}
+	count := 0
+	for _, s := range sils {
+		// The silence being updated does not count towards the quota.
+		if s.CreatedBy == sil.CreatedBy && s.Id != sil.Id {
+			count++
+		}
+	}
+	if count >= policy.MaxActivePerCreator {
+		return fmt.Errorf("%q already has %d silences that have not expired, the maximum allowed by the silence policy",
+			sil.CreatedBy, count)
+	}
+	return nil
+}
+
+// silenceApprovalRules returns the configured silence approval rules that
+// apply to the silence.
+func (api *API) silenceApprovalRules(sil *silencepb.Silence) []config.SilenceApprovalRule {
+	api.mtx.RLock()
+	defer api.mtx.RUnlock()
+
+	if api.alertmanagerConfig == nil || len(api.alertmanagerConfig.SilenceApprovalRules) == 0 {
+		return nil
+	}
+	ms := silenceMatchers(sil)
+	var rules []config.SilenceApprovalRule
+	for _, r := range api.alertmanagerConfig.SilenceApprovalRules {
+		if r.AppliesTo(ms) {
+			rules = append(rules, r)
+		}
+	}
+	return rules
+}
+
 func parseFilter(filter []string) ([]*labels.Matcher, error) {
 	matchers := make([]*labels.Matcher, 0, len(filter))
 	for _, matcherString := range filter {
@@ -15,25 +15,36 @@ package v2
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/require"
 
+	"github.com/prometheus/alertmanager/ack"
+	"github.com/prometheus/alertmanager/alerthistory"
+	"github.com/prometheus/alertmanager/api/auth"
 	open_api_models "github.com/prometheus/alertmanager/api/v2/models"
+	alert_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/alert"
 	general_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/general"
 	receiver_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/receiver"
 	silence_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/silence"
 	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/dispatch"
 	"github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/prometheus/alertmanager/provider/mem"
 	"github.com/prometheus/alertmanager/silence"
 	"github.com/prometheus/alertmanager/silence/silencepb"
 	"github.com/prometheus/alertmanager/types"
@@ -299,6 +310,501 @@ func TestPostSilencesHandler(t *testing.T) {
 	})
 }
 
+func TestSilenceTimeIntervals(t *testing.T) {
+	now := time.Now()
+	sil, _ := createSilence(t, "", "silenceCreator", now.Add(-time.Hour), now.Add(30*24*time.Hour))
+	start, end := "09:00", "17:00"
+	sil.TimeIntervals = []*open_api_models.TimeInterval{
+		{
+			Times:    []*open_api_models.TimeIntervalTimesItems0{{StartTime: &start, EndTime: &end}},
+			Weekdays: []string{"Monday:Friday"},
+			Months:   []string{"january:december"},
+			Location: "UTC",
+		},
+	}
+
+	ps, err := PostableSilenceToProto(&sil)
+	require.NoError(t, err)
+	require.Equal(t, []*silencepb.TimeInterval{
+		{
+			Times:    []*silencepb.TimeInterval_TimeRange{{StartMinute: 540, EndMinute: 1020}},
+			Weekdays: []*silencepb.TimeInterval_InclusiveRange{{Begin: 1, End: 5}},
+			Months:   []*silencepb.TimeInterval_InclusiveRange{{Begin: 1, End: 12}},
+			Location: "UTC",
+		},
+	}, ps.TimeIntervals)
+
+	invalid := sil
+	invalid.TimeIntervals = []*open_api_models.TimeInterval{{Weekdays: []string{"funday"}}}
+	_, err = PostableSilenceToProto(&invalid)
+	require.EqualError(t, err, "time interval 0: funday is not a valid weekday")
+
+	silences := newSilences(t)
+	_, err = silences.Set(ps)
+	require.NoError(t, err)
+
+	api := API{
+		uptime:   time.Now(),
+		silences: silences,
+		logger:   log.NewNopLogger(),
+	}
+	r, err := http.NewRequest("GET", "/api/v2/silences", nil)
+	require.NoError(t, err)
+	responder := api.getSilencesHandler(silence_ops.GetSilencesParams{HTTPRequest: r})
+	getSils := responder.(*silence_ops.GetSilencesOK).Payload
+	require.Len(t, getSils, 1)
+
+	require.Equal(t, []*open_api_models.TimeInterval{
+		{
+			Times:    []*open_api_models.TimeIntervalTimesItems0{{StartTime: &start, EndTime: &end}},
+			Weekdays: []string{"monday:friday"},
+			Months:   []string{"1:12"},
+			Location: "UTC",
+		},
+	}, getSils[0].TimeIntervals)
+
+	window := getSils[0].Status.NextActiveWindow
+	require.NotNil(t, window)
+	// The window ends at the end of a working day and lasts at most 8 hours.
+	windowStart, windowEnd := time.Time(*window.StartsAt).UTC(), time.Time(*window.EndsAt).UTC()
+	require.Equal(t, 17, windowEnd.Hour())
+	require.Equal(t, 0, windowEnd.Minute())
+	require.NotContains(t, []time.Weekday{time.Saturday, time.Sunday}, windowEnd.Weekday())
+	require.True(t, windowStart.Before(windowEnd))
+	require.LessOrEqual(t, windowEnd.Sub(windowStart), 8*time.Hour)
+}
+
+func TestSilenceApprovalHandlers(t *testing.T) {
+	now := time.Now()
+	cfg, err := config.Load(`
+route:
+  receiver: team-X
+receivers:
+- name: team-X
+silence_approval_rules:
+- matchers: ['a="b"']
+  approvers: [bob, carol]
+`)
+	require.NoError(t, err)
+
+	api := API{
+		uptime:             time.Now(),
+		silences:           newSilences(t),
+		logger:             log.NewNopLogger(),
+		alertmanagerConfig: cfg,
+	}
+	r, err := http.NewRequest("POST", "/api/v2/silences", nil)
+	require.NoError(t, err)
+
+	post := func() string {
+		sil, _ := createSilence(t, "", "alice", now.Add(-time.Minute), now.Add(time.Hour))
+		responder := api.postSilencesHandler(silence_ops.PostSilencesParams{HTTPRequest: r, Silence: &sil})
+		return responder.(*silence_ops.PostSilencesOK).Payload.SilenceID
+	}
+	state := func(sid string) string {
+		responder := api.getSilenceHandler(silence_ops.GetSilenceParams{HTTPRequest: r, SilenceID: strfmt.UUID(sid)})
+		return *responder.(*silence_ops.GetSilenceOK).Payload.Status.State
+	}
+	decision := func(by string) *open_api_models.SilenceApprovalDecision {
+		return &open_api_models.SilenceApprovalDecision{DecidedBy: &by, Comment: "ok"}
+	}
+	approve := func(sid, by string) middleware.Responder {
+		return api.approveSilenceHandler(silence_ops.ApproveSilenceParams{
+			HTTPRequest: r,
+			SilenceID:   strfmt.UUID(sid),
+			Decision:    decision(by),
+		})
+	}
+
+	sid := post()
+	require.Equal(t, "pending_approval", state(sid))
+
+	require.IsType(t, &silence_ops.ApproveSilenceNotFound{}, approve("unknown", "bob"))
+	require.IsType(t, &silence_ops.ApproveSilenceForbidden{}, approve(sid, "alice"))
+	require.IsType(t, &silence_ops.ApproveSilenceForbidden{}, approve(sid, "mallory"))
+	require.IsType(t, &silence_ops.ApproveSilenceOK{}, approve(sid, "bob"))
+	require.IsType(t, &silence_ops.ApproveSilenceBadRequest{}, approve(sid, "carol"))
+	require.Equal(t, "active", state(sid))
+
+	sid = post()
+	responder := api.rejectSilenceHandler(silence_ops.RejectSilenceParams{
+		HTTPRequest: r,
+		SilenceID:   strfmt.UUID(sid),
+		Decision:    decision("carol"),
+	})
+	require.IsType(t, &silence_ops.RejectSilenceOK{}, responder)
+	require.Equal(t, "expired", state(sid))
+}
+
+func TestGetSilenceHistoryHandler(t *testing.T) {
+	now := time.Now()
+	silences, err := silence.New(silence.Options{Retention: time.Hour})
+	require.NoError(t, err)
+
+	api := API{
+		uptime:   time.Now(),
+		silences: silences,
+		logger:   log.NewNopLogger(),
+	}
+	r, err := http.NewRequest("GET", "/api/v2/silence/${id}/history", nil)
+	require.NoError(t, err)
+
+	sil, _ := createSilence(t, "", "alice", now, now.Add(time.Hour))
+	responder := api.postSilencesHandler(silence_ops.PostSilencesParams{HTTPRequest: r, Silence: &sil})
+	sid := responder.(*silence_ops.PostSilencesOK).Payload.SilenceID
+
+	sil, _ = createSilence(t, sid, "bob", now, now.Add(2*time.Hour))
+	responder = api.postSilencesHandler(silence_ops.PostSilencesParams{HTTPRequest: r, Silence: &sil})
+	require.Equal(t, sid, responder.(*silence_ops.PostSilencesOK).Payload.SilenceID)
+
+	responder = api.getSilenceHistoryHandler(silence_ops.GetSilenceHistoryParams{
+		HTTPRequest: r,
+		SilenceID:   strfmt.UUID(sid),
+	})
+	history := responder.(*silence_ops.GetSilenceHistoryOK).Payload
+	require.Len(t, history, 2)
+	for i, author := range []string{"alice", "bob"} {
+		require.Equal(t, sid, *history[i].ID)
+		require.Equal(t, author, *history[i].CreatedBy)
+	}
+	require.True(t, time.Time(*history[1].EndsAt).After(time.Time(*history[0].EndsAt)))
+
+	responder = api.getSilenceHistoryHandler(silence_ops.GetSilenceHistoryParams{
+		HTTPRequest: r,
+		SilenceID:   strfmt.UUID("unknown"),
+	})
+	require.IsType(t, &silence_ops.GetSilenceHistoryNotFound{}, responder)
+}
+
+func TestPostSilencesHandlerPolicy(t *testing.T) {
+	now := time.Now()
+	cfg, err := config.Load(`
+route:
+  receiver: team-X
+receivers:
+- name: team-X
+silence_policy:
+  max_duration: 1d
+  required_label_names: [a]
+  max_active_per_creator: 2
+`)
+	require.NoError(t, err)
+
+	api := API{
+		uptime:             time.Now(),
+		silences:           newSilences(t),
+		logger:             log.NewNopLogger(),
+		alertmanagerConfig: cfg,
+	}
+	r, err := http.NewRequest("POST", "/api/v2/silences", nil)
+	require.NoError(t, err)
+
+	post := func(id, creator string, end time.Time) middleware.Responder {
+		sil, _ := createSilence(t, id, creator, now, end)
+		return api.postSilencesHandler(silence_ops.PostSilencesParams{HTTPRequest: r, Silence: &sil})
+	}
+
+	responder := post("", "alice", now.Add(48*time.Hour))
+	require.IsType(t, &silence_ops.PostSilencesBadRequest{}, responder)
+	require.Equal(t, "silence duration of 2d exceeds the maximum of 1d allowed by the silence policy",
+		responder.(*silence_ops.PostSilencesBadRequest).Payload)
+
+	responder = post("", "alice", now.Add(time.Hour))
+	sid := responder.(*silence_ops.PostSilencesOK).Payload.SilenceID
+	require.IsType(t, &silence_ops.PostSilencesOK{}, post("", "alice", now.Add(time.Hour)))
+
+	responder = post("", "alice", now.Add(time.Hour))
+	require.IsType(t, &silence_ops.PostSilencesBadRequest{}, responder)
+	require.Equal(t, `"alice" already has 2 silences that have not expired, the maximum allowed by the silence policy`,
+		responder.(*silence_ops.PostSilencesBadRequest).Payload)
+
+	// Updating a silence or creating one for someone else is fine.
+	require.IsType(t, &silence_ops.PostSilencesOK{}, post(sid, "alice", now.Add(2*time.Hour)))
+	require.IsType(t, &silence_ops.PostSilencesOK{}, post("", "bob", now.Add(time.Hour)))
+}
+
+func TestPreviewSilenceHandler(t *testing.T) {
+	now := time.Now()
+	cfg, err := config.Load(`
+route:
+  receiver: team-X
+  routes:
+  - matchers: ['svc="x"']
+    receiver: team-Y
+receivers:
+- name: team-X
+- name: team-Y
+`)
+	require.NoError(t, err)
+
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, nil, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	defer alerts.Close()
+	newAlert := func(ls model.LabelSet, endsAt time.Time) *types.Alert {
+		return &types.Alert{Alert: model.Alert{Labels: ls, StartsAt: now.Add(-time.Hour), EndsAt: endsAt}, UpdatedAt: now}
+	}
+	require.NoError(t, alerts.Put(
+		newAlert(model.LabelSet{"a": "b", "svc": "x"}, now.Add(time.Hour)),
+		newAlert(model.LabelSet{"a": "b", "svc": "y"}, now.Add(-time.Minute)),
+		newAlert(model.LabelSet{"a": "c"}, now.Add(time.Hour)),
+	))
+
+	route := dispatch.NewRoute(cfg.Route, nil)
+	api := API{
+		uptime:             time.Now(),
+		silences:           newSilences(t),
+		alerts:             alerts,
+		getAlertStatus:     marker.Status,
+		setAlertStatus:     func(*types.Alert) {},
+		logger:             log.NewNopLogger(),
+		alertmanagerConfig: cfg,
+		route:              route,
+		alertGroups: func(_ func(*dispatch.Route) bool, af func(*types.Alert, time.Time) bool) (dispatch.AlertGroups, map[model.Fingerprint][]string) {
+			group := &dispatch.AlertGroup{Receiver: "team-Y", Labels: model.LabelSet{"svc": "x"}}
+			it := alerts.GetPending()
+			defer it.Close()
+			for a := range it.Next() {
+				if af(a, time.Now()) {
+					group.Alerts = append(group.Alerts, a)
+				}
+			}
+			return dispatch.AlertGroups{group}, map[model.Fingerprint][]string{}
+		},
+	}
+	r, err := http.NewRequest("POST", "/api/v2/silences/preview", nil)
+	require.NoError(t, err)
+
+	// An existing silence muting the alert a="c".
+	sid, err := api.silences.Set(&silencepb.Silence{
+		Matchers: []*silencepb.Matcher{{Name: "a", Pattern: "c"}},
+		StartsAt: now,
+		EndsAt:   now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	preview := func(id string) middleware.Responder {
+		sil, _ := createSilence(t, id, "alice", now, now.Add(time.Hour))
+		return api.previewSilenceHandler(silence_ops.PreviewSilenceParams{HTTPRequest: r, Silence: &sil})
+	}
+
+	res := preview("").(*silence_ops.PreviewSilenceOK).Payload
+	require.Len(t, res.Alerts, 1)
+	require.Equal(t, open_api_models.LabelSet{"a": "b", "svc": "x"}, res.Alerts[0].Labels)
+	require.Empty(t, res.UnmutedAlerts)
+	require.Len(t, res.Receivers, 1)
+	require.Equal(t, "team-Y", *res.Receivers[0].Name)
+	require.Len(t, res.Groups, 1)
+	require.Len(t, res.Groups[0].Alerts, 1)
+
+	// Updating the existing silence un-mutes its alerts.
+	res = preview(sid).(*silence_ops.PreviewSilenceOK).Payload
+	require.Len(t, res.Alerts, 1)
+	require.Len(t, res.UnmutedAlerts, 1)
+	require.Equal(t, open_api_models.LabelSet{"a": "c"}, res.UnmutedAlerts[0].Labels)
+
+	require.IsType(t, &silence_ops.PreviewSilenceNotFound{}, preview("unknown"))
+
+	// Nothing is saved.
+	sils, _, err := api.silences.Query()
+	require.NoError(t, err)
+	require.Len(t, sils, 1)
+}
+
+func TestSilenceOverlapsHandlers(t *testing.T) {
+	now := time.Now()
+	api := API{
+		uptime:   time.Now(),
+		silences: newSilences(t),
+		logger:   log.NewNopLogger(),
+	}
+	r, err := http.NewRequest("GET", "/api/v2/silences/overlaps", nil)
+	require.NoError(t, err)
+
+	var ids []string
+	for i := 0; i < 2; i++ {
+		sil, _ := createSilence(t, "", "alice", now, now.Add(time.Duration(i+1)*time.Hour))
+		responder := api.postSilencesHandler(silence_ops.PostSilencesParams{HTTPRequest: r, Silence: &sil})
+		ids = append(ids, responder.(*silence_ops.PostSilencesOK).Payload.SilenceID)
+	}
+
+	responder := api.getSilenceOverlapsHandler(silence_ops.GetSilenceOverlapsParams{HTTPRequest: r})
+	overlaps := responder.(*silence_ops.GetSilenceOverlapsOK).Payload
+	require.Len(t, overlaps, 1)
+	require.Equal(t, "duplicate", *overlaps[0].Kind)
+	require.ElementsMatch(t, ids, []string{*overlaps[0].SilenceID, *overlaps[0].OtherSilenceID})
+
+	consolidate := func(ids ...string) middleware.Responder {
+		by := "bob"
+		return api.consolidateSilencesHandler(silence_ops.ConsolidateSilencesParams{
+			HTTPRequest: r,
+			Consolidation: &open_api_models.SilenceConsolidation{
+				SilenceIDs: ids,
+				CreatedBy:  &by,
+			},
+		})
+	}
+	require.IsType(t, &silence_ops.ConsolidateSilencesNotFound{}, consolidate(ids[0], "unknown"))
+
+	// Silences whose replacement requires approval are left alone.
+	cfg, err := config.Load(`
+route:
+  receiver: team-X
+receivers:
+- name: team-X
+silence_approval_rules:
+- matchers: ['a="b"']
+  approvers: [carol]
+`)
+	require.NoError(t, err)
+	api.alertmanagerConfig = cfg
+	responder = consolidate(ids...)
+	require.Equal(t, "the consolidated silence requires approval", responder.(*silence_ops.ConsolidateSilencesBadRequest).Payload)
+	for _, id := range ids {
+		responder = api.getSilenceHandler(silence_ops.GetSilenceParams{HTTPRequest: r, SilenceID: strfmt.UUID(id)})
+		require.Equal(t, "active", *responder.(*silence_ops.GetSilenceOK).Payload.Status.State)
+	}
+	api.alertmanagerConfig = nil
+
+	responder = consolidate(ids...)
+	sid := responder.(*silence_ops.ConsolidateSilencesOK).Payload.SilenceID
+
+	responder = api.getSilenceHandler(silence_ops.GetSilenceParams{HTTPRequest: r, SilenceID: strfmt.UUID(sid)})
+	sil := responder.(*silence_ops.GetSilenceOK).Payload
+	require.Equal(t, "bob", *sil.CreatedBy)
+	require.Equal(t, "test", *sil.Comment)
+	require.Equal(t, "active", *sil.Status.State)
+	require.True(t, time.Time(*sil.EndsAt).After(now.Add(90*time.Minute)))
+
+	for _, id := range ids {
+		responder = api.getSilenceHandler(silence_ops.GetSilenceParams{HTTPRequest: r, SilenceID: strfmt.UUID(id)})
+		require.Equal(t, "expired", *responder.(*silence_ops.GetSilenceOK).Payload.Status.State)
+	}
+
+	responder = api.getSilenceOverlapsHandler(silence_ops.GetSilenceOverlapsParams{HTTPRequest: r})
+	require.Empty(t, responder.(*silence_ops.GetSilenceOverlapsOK).Payload)
+}
+
+func TestAcknowledgeAlertHandlers(t *testing.T) {
+	now := time.Now()
+	cfg, err := config.Load(`
+route:
+  receiver: team-X
+receivers:
+- name: team-X
+`)
+	require.NoError(t, err)
+
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, nil, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	defer alerts.Close()
+	firing := &types.Alert{Alert: model.Alert{Labels: model.LabelSet{"a": "b"}, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)}, UpdatedAt: now}
+	resolved := &types.Alert{Alert: model.Alert{Labels: model.LabelSet{"a": "c"}, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(-time.Minute)}, UpdatedAt: now}
+	require.NoError(t, alerts.Put(firing, resolved))
+
+	acks, err := ack.New(ack.Options{Retention: time.Hour})
+	require.NoError(t, err)
+	acknowledger := ack.NewAcknowledger(acks, marker)
+
+	api := API{
+		uptime:         time.Now(),
+		acks:           acks,
+		alerts:         alerts,
+		getAlertStatus: marker.Status,
+		setAlertStatus: func(a *types.Alert) {
+			marker.SetActiveOrSilenced(a.Fingerprint(), 0, nil, nil)
+			acknowledger.Acknowledged(a)
+		},
+		logger:             log.NewNopLogger(),
+		alertmanagerConfig: cfg,
+		route:              dispatch.NewRoute(cfg.Route, nil),
+	}
+	r, err := http.NewRequest("POST", "/api/v2/alert/acknowledgement", nil)
+	require.NoError(t, err)
+
+	acknowledge := func(fp string) middleware.Responder {
+		by := "alice"
+		return api.acknowledgeAlertHandler(alert_ops.AcknowledgeAlertParams{
+			HTTPRequest:     r,
+			Fingerprint:     fp,
+			Acknowledgement: &open_api_models.PostableAcknowledgement{AcknowledgedBy: &by, Comment: "looking into it"},
+		})
+	}
+	unacknowledge := func(fp string) middleware.Responder {
+		return api.unacknowledgeAlertHandler(alert_ops.UnacknowledgeAlertParams{HTTPRequest: r, Fingerprint: fp})
+	}
+	getAlert := func() *open_api_models.GettableAlert {
+		yes := true
+		res := api.getAlertsHandler(alert_ops.GetAlertsParams{
+			HTTPRequest: r,
+			Active:      &yes,
+			Silenced:    &yes,
+			Inhibited:   &yes,
+		}).(*alert_ops.GetAlertsOK).Payload
+		require.Len(t, res, 1)
+		return res[0]
+	}
+
+	fp := firing.Fingerprint().String()
+	require.Nil(t, getAlert().Status.Acknowledgement)
+
+	require.IsType(t, &alert_ops.AcknowledgeAlertOK{}, acknowledge(fp))
+	status := getAlert().Status
+	require.Equal(t, types.AlertStateActive, types.AlertState(*status.State))
+	require.NotNil(t, status.Acknowledgement)
+	require.Equal(t, "alice", *status.Acknowledgement.AcknowledgedBy)
+	require.Equal(t, "looking into it", status.Acknowledgement.Comment)
+
+	require.IsType(t, &alert_ops.AcknowledgeAlertNotFound{}, acknowledge(resolved.Fingerprint().String()))
+	require.IsType(t, &alert_ops.AcknowledgeAlertNotFound{}, acknowledge(model.Fingerprint(1).String()))
+	require.IsType(t, &alert_ops.AcknowledgeAlertBadRequest{}, acknowledge("invalid"))
+
+	require.IsType(t, &alert_ops.UnacknowledgeAlertOK{}, unacknowledge(fp))
+	require.Nil(t, getAlert().Status.Acknowledgement)
+	require.IsType(t, &alert_ops.UnacknowledgeAlertNotFound{}, unacknowledge(fp))
+}
+
+func TestGetAlertHistoryHandler(t *testing.T) {
+	now := time.Now()
+	history, err := alerthistory.New(alerthistory.Options{Retention: time.Hour})
+	require.NoError(t, err)
+	a := &types.Alert{Alert: model.Alert{Labels: model.LabelSet{"a": "b"}, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(-time.Minute)}}
+	history.Firing(a)
+	history.Notified([]*types.Alert{a}, "team-X", "webhook", now.Add(-30*time.Minute))
+	history.Resolved(a)
+
+	api := API{
+		uptime:  time.Now(),
+		history: history,
+		logger:  log.NewNopLogger(),
+	}
+	r, err := http.NewRequest("GET", "/api/v2/alerts/history", nil)
+	require.NoError(t, err)
+	get := func(fp string) middleware.Responder {
+		return api.getAlertHistoryHandler(alert_ops.GetAlertHistoryParams{HTTPRequest: r, Fingerprint: fp})
+	}
+
+	res := get(a.Fingerprint().String())
+	require.IsType(t, &alert_ops.GetAlertHistoryOK{}, res)
+	h := res.(*alert_ops.GetAlertHistoryOK).Payload
+	require.Equal(t, a.Fingerprint().String(), *h.Fingerprint)
+	require.Equal(t, open_api_models.LabelSet{"a": "b"}, h.Labels)
+	require.Len(t, h.Events, 3)
+	require.Equal(t, "firing", *h.Events[0].Type)
+	require.Equal(t, "notified", *h.Events[1].Type)
+	require.Equal(t, "team-X", h.Events[1].Receiver)
+	require.Equal(t, "webhook", h.Events[1].Integration)
+	require.Equal(t, "resolved", *h.Events[2].Type)
+	require.True(t, time.Time(*h.Events[2].Timestamp).Equal(a.EndsAt))
+
+	require.IsType(t, &alert_ops.GetAlertHistoryNotFound{}, get(model.Fingerprint(1).String()))
+	require.IsType(t, &alert_ops.GetAlertHistoryBadRequest{}, get("invalid"))
+
+	api.history = nil
+	require.IsType(t, &alert_ops.GetAlertHistoryNotFound{}, get(a.Fingerprint().String()))
+}
+
 func TestCheckSilenceMatchesFilterLabels(t *testing.T) {
 	type test struct {
 		silenceMatchers []*silencepb.Matcher
@@ -470,6 +976,68 @@ func TestMatchFilterLabels(t *testing.T) {
 	}
 }
 
+func TestConfigHandlers(t *testing.T) {
+	f := filepath.Join(t.TempDir(), "alertmanager.yml")
+	require.NoError(t, os.WriteFile(f, []byte("route: {receiver: a}\nreceivers: [{name: a}]\n"), 0o600))
+	configs := config.NewCoordinator(f, prometheus.NewRegistry(), log.NewNopLogger())
+	api := API{
+		uptime:  time.Now(),
+		configs: configs,
+		logger:  log.NewNopLogger(),
+	}
+	configs.Subscribe(func(cfg *config.Config) error {
+		api.Update(cfg, func(*types.Alert) {})
+		return nil
+	})
+	require.NoError(t, configs.Reload())
+
+	r, err := http.NewRequest("PUT", "/api/v2/config", nil)
+	require.NoError(t, err)
+	put := func(cfg string, dryRun bool) middleware.Responder {
+		return api.putConfigHandler(general_ops.PutConfigParams{
+			HTTPRequest: r,
+			Config:      &open_api_models.PostableConfig{Config: &cfg, DryRun: dryRun},
+		})
+	}
+	receiver := func() string {
+		api.mtx.RLock()
+		defer api.mtx.RUnlock()
+		return api.alertmanagerConfig.Route.Receiver
+	}
+	newConfig := "route: {receiver: b}\nreceivers: [{name: b}]\n"
+	rollback := func(id int64) middleware.Responder {
+		return api.rollbackConfigHandler(general_ops.RollbackConfigParams{HTTPRequest: r, VersionID: id})
+	}
+
+	// Changing the configuration must be enabled explicitly.
+	require.IsType(t, &general_ops.PutConfigForbidden{}, put(newConfig, false))
+	require.IsType(t, &general_ops.RollbackConfigForbidden{}, rollback(1))
+	require.Equal(t, "a", receiver())
+	api.configWrites = true
+
+	require.IsType(t, &general_ops.PutConfigBadRequest{}, put("route: {receiver: unknown}", false))
+	res := put(newConfig, true)
+	require.IsType(t, &general_ops.PutConfigOK{}, res)
+	require.Equal(t, int64(0), *res.(*general_ops.PutConfigOK).Payload.ID)
+	require.Equal(t, "a", receiver())
+
+	res = put(newConfig, false)
+	require.IsType(t, &general_ops.PutConfigOK{}, res)
+	require.Equal(t, int64(2), *res.(*general_ops.PutConfigOK).Payload.ID)
+	require.Equal(t, "b", receiver())
+
+	versions := api.getConfigHandler(general_ops.GetConfigParams{HTTPRequest: r}).(*general_ops.GetConfigOK).Payload
+	require.Len(t, versions.Versions, 2)
+	require.Equal(t, int64(2), *versions.Current.ID)
+	require.Equal(t, config.VersionSourceFile, *versions.Versions[0].Source)
+
+	require.IsType(t, &general_ops.RollbackConfigNotFound{}, rollback(5))
+	res = rollback(1)
+	require.IsType(t, &general_ops.RollbackConfigOK{}, res)
+	require.Equal(t, config.VersionSourceRollback, *res.(*general_ops.RollbackConfigOK).Payload.Source)
+	require.Equal(t, "a", receiver())
+}
+
 func TestGetReceiversHandler(t *testing.T) {
 	in := `
 route:
@@ -510,3 +1078,52 @@ receivers:
 		require.Equal(t, tc.body, string(body))
 	}
 }
+
+func TestSilenceHandlersAuth(t *testing.T) {
+	now := time.Now()
+	silences := newSilences(t)
+	api := API{
+		uptime:   time.Now(),
+		silences: silences,
+		logger:   log.NewNopLogger(),
+	}
+
+	other, err := silences.Set(&silencepb.Silence{
+		Matchers: []*silencepb.Matcher{{Type: silencepb.Matcher_EQUAL, Name: "a", Pattern: "c"}},
+		StartsAt: now,
+		EndsAt:   now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	// The client may only edit silences with the matcher a="b".
+	r, err := http.NewRequest("POST", "/api/v2/silences", nil)
+	require.NoError(t, err)
+	r = r.WithContext(auth.NewContext(r.Context(), &auth.Principal{
+		Name:     "team-b",
+		Role:     auth.RoleSilenceEditor,
+		Matchers: labels.Matchers{createLabelMatcher(t, "a", "b", labels.MatchEqual)},
+	}))
+
+	sil, _ := createSilence(t, "", "someone-else", now, now.Add(time.Hour))
+	responder := api.postSilencesHandler(silence_ops.PostSilencesParams{HTTPRequest: r, Silence: &sil})
+	sid := responder.(*silence_ops.PostSilencesOK).Payload.SilenceID
+	created, err := silences.QueryOne(silence.QIDs(sid))
+	require.NoError(t, err)
+	require.Equal(t, "team-b", created.CreatedBy)
+
+	// Updating another silence to the matcher a="b" is forbidden too.
+	sil, _ = createSilence(t, other, "team-b", now, now.Add(time.Hour))
+	responder = api.postSilencesHandler(silence_ops.PostSilencesParams{HTTPRequest: r, Silence: &sil})
+	require.IsType(t, &silence_ops.PostSilencesForbidden{}, responder)
+
+	sil, _ = createSilence(t, "", "team-b", now, now.Add(time.Hour))
+	value := "c"
+	sil.Matchers[0].Value = &value
+	responder = api.postSilencesHandler(silence_ops.PostSilencesParams{HTTPRequest: r, Silence: &sil})
+	require.IsType(t, &silence_ops.PostSilencesForbidden{}, responder)
+
+	responder = api.deleteSilenceHandler(silence_ops.DeleteSilenceParams{HTTPRequest: r, SilenceID: strfmt.UUID(other)})
+	require.IsType(t, &silence_ops.DeleteSilenceForbidden{}, responder)
+	responder = api.deleteSilenceHandler(silence_ops.DeleteSilenceParams{HTTPRequest: r, SilenceID: strfmt.UUID(sid)})
+	require.IsType(t, &silence_ops.DeleteSilenceOK{}, responder)
+}
@@ -0,0 +1,189 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// NewAcknowledgeAlertParams creates a new AcknowledgeAlertParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAcknowledgeAlertParams() *AcknowledgeAlertParams {
+	return &AcknowledgeAlertParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAcknowledgeAlertParamsWithTimeout creates a new AcknowledgeAlertParams object
+// with the ability to set a timeout on a request.
+func NewAcknowledgeAlertParamsWithTimeout(timeout time.Duration) *AcknowledgeAlertParams {
+	return &AcknowledgeAlertParams{
+		timeout: timeout,
+	}
+}
+
+// NewAcknowledgeAlertParamsWithContext creates a new AcknowledgeAlertParams object
+// with the ability to set a context for a request.
+func NewAcknowledgeAlertParamsWithContext(ctx context.Context) *AcknowledgeAlertParams {
+	return &AcknowledgeAlertParams{
+		Context: ctx,
+	}
+}
+
+// NewAcknowledgeAlertParamsWithHTTPClient creates a new AcknowledgeAlertParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAcknowledgeAlertParamsWithHTTPClient(client *http.Client) *AcknowledgeAlertParams {
+	return &AcknowledgeAlertParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AcknowledgeAlertParams contains all the parameters to send to the API endpoint
+
+	for the acknowledge alert operation.
+
+	Typically these are written to a http.Request.
+*/
+type AcknowledgeAlertParams struct {
+
+	/* Acknowledgement.
+
+	   The acknowledgement
+	*/
+	Acknowledgement *models.PostableAcknowledgement
+
+	/* Fingerprint.
+
+	   Fingerprint of the alert
+	*/
+	Fingerprint string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the acknowledge alert params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AcknowledgeAlertParams) WithDefaults() *AcknowledgeAlertParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the acknowledge alert params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AcknowledgeAlertParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the acknowledge alert params
+func (o *AcknowledgeAlertParams) WithTimeout(timeout time.Duration) *AcknowledgeAlertParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the acknowledge alert params
+func (o *AcknowledgeAlertParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the acknowledge alert params
+func (o *AcknowledgeAlertParams) WithContext(ctx context.Context) *AcknowledgeAlertParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the acknowledge alert params
+func (o *AcknowledgeAlertParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the acknowledge alert params
+func (o *AcknowledgeAlertParams) WithHTTPClient(client *http.Client) *AcknowledgeAlertParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the acknowledge alert params
+func (o *AcknowledgeAlertParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAcknowledgement adds the acknowledgement to the acknowledge alert params
+func (o *AcknowledgeAlertParams) WithAcknowledgement(acknowledgement *models.PostableAcknowledgement) *AcknowledgeAlertParams {
+	o.SetAcknowledgement(acknowledgement)
+	return o
+}
+
+// SetAcknowledgement adds the acknowledgement to the acknowledge alert params
+func (o *AcknowledgeAlertParams) SetAcknowledgement(acknowledgement *models.PostableAcknowledgement) {
+	o.Acknowledgement = acknowledgement
+}
+
+// WithFingerprint adds the fingerprint to the acknowledge alert params
+func (o *AcknowledgeAlertParams) WithFingerprint(fingerprint string) *AcknowledgeAlertParams {
+	o.SetFingerprint(fingerprint)
+	return o
+}
+
+// SetFingerprint adds the fingerprint to the acknowledge alert params
+func (o *AcknowledgeAlertParams) SetFingerprint(fingerprint string) {
+	o.Fingerprint = fingerprint
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AcknowledgeAlertParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Acknowledgement != nil {
+		if err := r.SetBodyParam(o.Acknowledgement); err != nil {
+			return err
+		}
+	}
+
+	// path param fingerprint
+	if err := r.SetPathParam("fingerprint", o.Fingerprint); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,381 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// AcknowledgeAlertReader is a Reader for the AcknowledgeAlert structure.
+type AcknowledgeAlertReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AcknowledgeAlertReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAcknowledgeAlertOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewAcknowledgeAlertBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewAcknowledgeAlertForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewAcknowledgeAlertNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAcknowledgeAlertInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /alert/{fingerprint}/acknowledgement] acknowledgeAlert", response, response.Code())
+	}
+}
+
+// NewAcknowledgeAlertOK creates a AcknowledgeAlertOK with default headers values
+func NewAcknowledgeAlertOK() *AcknowledgeAlertOK {
+	return &AcknowledgeAlertOK{}
+}
+
+/*
+AcknowledgeAlertOK describes a response with status code 200, with default header values.
+
+Acknowledge alert response
+*/
+type AcknowledgeAlertOK struct {
+}
+
+// IsSuccess returns true when this acknowledge alert o k response has a 2xx status code
+func (o *AcknowledgeAlertOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this acknowledge alert o k response has a 3xx status code
+func (o *AcknowledgeAlertOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this acknowledge alert o k response has a 4xx status code
+func (o *AcknowledgeAlertOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this acknowledge alert o k response has a 5xx status code
+func (o *AcknowledgeAlertOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this acknowledge alert o k response a status code equal to that given
+func (o *AcknowledgeAlertOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the acknowledge alert o k response
+func (o *AcknowledgeAlertOK) Code() int {
+	return 200
+}
+
+func (o *AcknowledgeAlertOK) Error() string {
+	return fmt.Sprintf("[POST /alert/{fingerprint}/acknowledgement][%d] acknowledgeAlertOK ", 200)
+}
+
+func (o *AcknowledgeAlertOK) String() string {
+	return fmt.Sprintf("[POST /alert/{fingerprint}/acknowledgement][%d] acknowledgeAlertOK ", 200)
+}
+
+func (o *AcknowledgeAlertOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAcknowledgeAlertBadRequest creates a AcknowledgeAlertBadRequest with default headers values
+func NewAcknowledgeAlertBadRequest() *AcknowledgeAlertBadRequest {
+	return &AcknowledgeAlertBadRequest{}
+}
+
+/*
+AcknowledgeAlertBadRequest describes a response with status code 400, with default header values.
+
+Bad request
+*/
+type AcknowledgeAlertBadRequest struct {
+	Payload string
+}
+
+// IsSuccess returns true when this acknowledge alert bad request response has a 2xx status code
+func (o *AcknowledgeAlertBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this acknowledge alert bad request response has a 3xx status code
+func (o *AcknowledgeAlertBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this acknowledge alert bad request response has a 4xx status code
+func (o *AcknowledgeAlertBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this acknowledge alert bad request response has a 5xx status code
+func (o *AcknowledgeAlertBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this acknowledge alert bad request response a status code equal to that given
+func (o *AcknowledgeAlertBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the acknowledge alert bad request response
+func (o *AcknowledgeAlertBadRequest) Code() int {
+	return 400
+}
+
+func (o *AcknowledgeAlertBadRequest) Error() string {
+	return fmt.Sprintf("[POST /alert/{fingerprint}/acknowledgement][%d] acknowledgeAlertBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *AcknowledgeAlertBadRequest) String() string {
+	return fmt.Sprintf("[POST /alert/{fingerprint}/acknowledgement][%d] acknowledgeAlertBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *AcknowledgeAlertBadRequest) GetPayload() string {
+	return o.Payload
+}
+
+func (o *AcknowledgeAlertBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAcknowledgeAlertForbidden creates a AcknowledgeAlertForbidden with default headers values
+func NewAcknowledgeAlertForbidden() *AcknowledgeAlertForbidden {
+	return &AcknowledgeAlertForbidden{}
+}
+
+/*
+AcknowledgeAlertForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type AcknowledgeAlertForbidden struct {
+	Payload string
+}
+
+// IsSuccess returns true when this acknowledge alert forbidden response has a 2xx status code
+func (o *AcknowledgeAlertForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this acknowledge alert forbidden response has a 3xx status code
+func (o *AcknowledgeAlertForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this acknowledge alert forbidden response has a 4xx status code
+func (o *AcknowledgeAlertForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this acknowledge alert forbidden response has a 5xx status code
+func (o *AcknowledgeAlertForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this acknowledge alert forbidden response a status code equal to that given
+func (o *AcknowledgeAlertForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the acknowledge alert forbidden response
+func (o *AcknowledgeAlertForbidden) Code() int {
+	return 403
+}
+
+func (o *AcknowledgeAlertForbidden) Error() string {
+	return fmt.Sprintf("[POST /alert/{fingerprint}/acknowledgement][%d] acknowledgeAlertForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AcknowledgeAlertForbidden) String() string {
+	return fmt.Sprintf("[POST /alert/{fingerprint}/acknowledgement][%d] acknowledgeAlertForbidden  %+v", 403, o.Payload)
+}
+
+func (o *AcknowledgeAlertForbidden) GetPayload() string {
+	return o.Payload
+}
+
+func (o *AcknowledgeAlertForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAcknowledgeAlertNotFound creates a AcknowledgeAlertNotFound with default headers values
+func NewAcknowledgeAlertNotFound() *AcknowledgeAlertNotFound {
+	return &AcknowledgeAlertNotFound{}
+}
+
+/*
+AcknowledgeAlertNotFound describes a response with status code 404, with default header values.
+
+A firing alert with the specified fingerprint was not found
+*/
+type AcknowledgeAlertNotFound struct {
+}
+
+// IsSuccess returns true when this acknowledge alert not found response has a 2xx status code
+func (o *AcknowledgeAlertNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this acknowledge alert not found response has a 3xx status code
+func (o *AcknowledgeAlertNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this acknowledge alert not found response has a 4xx status code
+func (o *AcknowledgeAlertNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this acknowledge alert not found response has a 5xx status code
+func (o *AcknowledgeAlertNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this acknowledge alert not found response a status code equal to that given
+func (o *AcknowledgeAlertNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the acknowledge alert not found response
+func (o *AcknowledgeAlertNotFound) Code() int {
+	return 404
+}
+
+func (o *AcknowledgeAlertNotFound) Error() string {
+	return fmt.Sprintf("[POST /alert/{fingerprint}/acknowledgement][%d] acknowledgeAlertNotFound ", 404)
+}
+
+func (o *AcknowledgeAlertNotFound) String() string {
+	return fmt.Sprintf("[POST /alert/{fingerprint}/acknowledgement][%d] acknowledgeAlertNotFound ", 404)
+}
+
+func (o *AcknowledgeAlertNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAcknowledgeAlertInternalServerError creates a AcknowledgeAlertInternalServerError with default headers values
+func NewAcknowledgeAlertInternalServerError() *AcknowledgeAlertInternalServerError {
+	return &AcknowledgeAlertInternalServerError{}
+}
+
+/*
+AcknowledgeAlertInternalServerError describes a response with status code 500, with default header values.
+
+Internal server error
+*/
+type AcknowledgeAlertInternalServerError struct {
+	Payload string
+}
+
+// IsSuccess returns true when this acknowledge alert internal server error response has a 2xx status code
+func (o *AcknowledgeAlertInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this acknowledge alert internal server error response has a 3xx status code
+func (o *AcknowledgeAlertInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this acknowledge alert internal server error response has a 4xx status code
+func (o *AcknowledgeAlertInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this acknowledge alert internal server error response has a 5xx status code
+func (o *AcknowledgeAlertInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this acknowledge alert internal server error response a status code equal to that given
+func (o *AcknowledgeAlertInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the acknowledge alert internal server error response
+func (o *AcknowledgeAlertInternalServerError) Code() int {
+	return 500
+}
+
+func (o *AcknowledgeAlertInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /alert/{fingerprint}/acknowledgement][%d] acknowledgeAlertInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AcknowledgeAlertInternalServerError) String() string {
+	return fmt.Sprintf("[POST /alert/{fingerprint}/acknowledgement][%d] acknowledgeAlertInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *AcknowledgeAlertInternalServerError) GetPayload() string {
+	return o.Payload
+}
+
+func (o *AcknowledgeAlertInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -44,13 +44,97 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	AcknowledgeAlert(params *AcknowledgeAlertParams, opts ...ClientOption) (*AcknowledgeAlertOK, error)
+
+	GetAlertHistory(params *GetAlertHistoryParams, opts ...ClientOption) (*GetAlertHistoryOK, error)
+
 	GetAlerts(params *GetAlertsParams, opts ...ClientOption) (*GetAlertsOK, error)
 
 	PostAlerts(params *PostAlertsParams, opts ...ClientOption) (*PostAlertsOK, error)
 
+	StreamAlerts(params *StreamAlertsParams, opts ...ClientOption) (*StreamAlertsOK, error)
+
+	UnacknowledgeAlert(params *UnacknowledgeAlertParams, opts ...ClientOption) (*UnacknowledgeAlertOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+AcknowledgeAlert Acknowledge a firing alert
+*/
+func (a *Client) AcknowledgeAlert(params *AcknowledgeAlertParams, opts ...ClientOption) (*AcknowledgeAlertOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAcknowledgeAlertParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "acknowledgeAlert",
+		Method:             "POST",
+		PathPattern:        "/alert/{fingerprint}/acknowledgement",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AcknowledgeAlertReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AcknowledgeAlertOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for acknowledgeAlert: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetAlertHistory Get the state transitions of an alert, oldest first
+*/
+func (a *Client) GetAlertHistory(params *GetAlertHistoryParams, opts ...ClientOption) (*GetAlertHistoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAlertHistoryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getAlertHistory",
+		Method:             "GET",
+		PathPattern:        "/alerts/{fingerprint}/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAlertHistoryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetAlertHistoryOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getAlertHistory: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetAlerts Get a list of alerts
 */
@@ -127,6 +211,87 @@ func (a *Client) PostAlerts(params *PostAlertsParams, opts ...ClientOption) (*Po
 	panic(msg)
 }
 
+/*
+	StreamAlerts Stream changes to alerts as Server-Sent Events. Each event has one of
+
+the types created, updated, resolved, silenced, unsilenced, inhibited
+and uninhibited, and carries the alert as JSON. The stream starts with
+a created event for every firing alert, unless it resumes from the
+event ID given in the Last-Event-ID header.
+*/
+func (a *Client) StreamAlerts(params *StreamAlertsParams, opts ...ClientOption) (*StreamAlertsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewStreamAlertsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "streamAlerts",
+		Method:             "GET",
+		PathPattern:        "/alerts/stream",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &StreamAlertsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*StreamAlertsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for streamAlerts: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+UnacknowledgeAlert Withdraw the acknowledgement of an alert
+*/
+func (a *Client) UnacknowledgeAlert(params *UnacknowledgeAlertParams, opts ...ClientOption) (*UnacknowledgeAlertOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUnacknowledgeAlertParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "unacknowledgeAlert",
+		Method:             "DELETE",
+		PathPattern:        "/alert/{fingerprint}/acknowledgement",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UnacknowledgeAlertReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UnacknowledgeAlertOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for unacknowledgeAlert: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
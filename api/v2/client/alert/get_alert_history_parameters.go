@@ -0,0 +1,165 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetAlertHistoryParams creates a new GetAlertHistoryParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetAlertHistoryParams() *GetAlertHistoryParams {
+	return &GetAlertHistoryParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetAlertHistoryParamsWithTimeout creates a new GetAlertHistoryParams object
+// with the ability to set a timeout on a request.
+func NewGetAlertHistoryParamsWithTimeout(timeout time.Duration) *GetAlertHistoryParams {
+	return &GetAlertHistoryParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetAlertHistoryParamsWithContext creates a new GetAlertHistoryParams object
+// with the ability to set a context for a request.
+func NewGetAlertHistoryParamsWithContext(ctx context.Context) *GetAlertHistoryParams {
+	return &GetAlertHistoryParams{
+		Context: ctx,
+	}
+}
+
+// NewGetAlertHistoryParamsWithHTTPClient creates a new GetAlertHistoryParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetAlertHistoryParamsWithHTTPClient(client *http.Client) *GetAlertHistoryParams {
+	return &GetAlertHistoryParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetAlertHistoryParams contains all the parameters to send to the API endpoint
+
+	for the get alert history operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetAlertHistoryParams struct {
+
+	/* Fingerprint.
+
+	   Fingerprint of the alert
+	*/
+	Fingerprint string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get alert history params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAlertHistoryParams) WithDefaults() *GetAlertHistoryParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get alert history params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAlertHistoryParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get alert history params
+func (o *GetAlertHistoryParams) WithTimeout(timeout time.Duration) *GetAlertHistoryParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get alert history params
+func (o *GetAlertHistoryParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get alert history params
+func (o *GetAlertHistoryParams) WithContext(ctx context.Context) *GetAlertHistoryParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get alert history params
+func (o *GetAlertHistoryParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get alert history params
+func (o *GetAlertHistoryParams) WithHTTPClient(client *http.Client) *GetAlertHistoryParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get alert history params
+func (o *GetAlertHistoryParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFingerprint adds the fingerprint to the get alert history params
+func (o *GetAlertHistoryParams) WithFingerprint(fingerprint string) *GetAlertHistoryParams {
+	o.SetFingerprint(fingerprint)
+	return o
+}
+
+// SetFingerprint adds the fingerprint to the get alert history params
+func (o *GetAlertHistoryParams) SetFingerprint(fingerprint string) {
+	o.Fingerprint = fingerprint
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetAlertHistoryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param fingerprint
+	if err := r.SetPathParam("fingerprint", o.Fingerprint); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,323 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// GetAlertHistoryReader is a Reader for the GetAlertHistory structure.
+type GetAlertHistoryReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetAlertHistoryReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetAlertHistoryOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetAlertHistoryBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetAlertHistoryNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetAlertHistoryInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /alerts/{fingerprint}/history] getAlertHistory", response, response.Code())
+	}
+}
+
+// NewGetAlertHistoryOK creates a GetAlertHistoryOK with default headers values
+func NewGetAlertHistoryOK() *GetAlertHistoryOK {
+	return &GetAlertHistoryOK{}
+}
+
+/*
+GetAlertHistoryOK describes a response with status code 200, with default header values.
+
+Get alert history response
+*/
+type GetAlertHistoryOK struct {
+	Payload *models.AlertHistory
+}
+
+// IsSuccess returns true when this get alert history o k response has a 2xx status code
+func (o *GetAlertHistoryOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get alert history o k response has a 3xx status code
+func (o *GetAlertHistoryOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get alert history o k response has a 4xx status code
+func (o *GetAlertHistoryOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get alert history o k response has a 5xx status code
+func (o *GetAlertHistoryOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get alert history o k response a status code equal to that given
+func (o *GetAlertHistoryOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get alert history o k response
+func (o *GetAlertHistoryOK) Code() int {
+	return 200
+}
+
+func (o *GetAlertHistoryOK) Error() string {
+	return fmt.Sprintf("[GET /alerts/{fingerprint}/history][%d] getAlertHistoryOK  %+v", 200, o.Payload)
+}
+
+func (o *GetAlertHistoryOK) String() string {
+	return fmt.Sprintf("[GET /alerts/{fingerprint}/history][%d] getAlertHistoryOK  %+v", 200, o.Payload)
+}
+
+func (o *GetAlertHistoryOK) GetPayload() *models.AlertHistory {
+	return o.Payload
+}
+
+func (o *GetAlertHistoryOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.AlertHistory)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAlertHistoryBadRequest creates a GetAlertHistoryBadRequest with default headers values
+func NewGetAlertHistoryBadRequest() *GetAlertHistoryBadRequest {
+	return &GetAlertHistoryBadRequest{}
+}
+
+/*
+GetAlertHistoryBadRequest describes a response with status code 400, with default header values.
+
+Bad request
+*/
+type GetAlertHistoryBadRequest struct {
+	Payload string
+}
+
+// IsSuccess returns true when this get alert history bad request response has a 2xx status code
+func (o *GetAlertHistoryBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get alert history bad request response has a 3xx status code
+func (o *GetAlertHistoryBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get alert history bad request response has a 4xx status code
+func (o *GetAlertHistoryBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get alert history bad request response has a 5xx status code
+func (o *GetAlertHistoryBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get alert history bad request response a status code equal to that given
+func (o *GetAlertHistoryBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the get alert history bad request response
+func (o *GetAlertHistoryBadRequest) Code() int {
+	return 400
+}
+
+func (o *GetAlertHistoryBadRequest) Error() string {
+	return fmt.Sprintf("[GET /alerts/{fingerprint}/history][%d] getAlertHistoryBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetAlertHistoryBadRequest) String() string {
+	return fmt.Sprintf("[GET /alerts/{fingerprint}/history][%d] getAlertHistoryBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetAlertHistoryBadRequest) GetPayload() string {
+	return o.Payload
+}
+
+func (o *GetAlertHistoryBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAlertHistoryNotFound creates a GetAlertHistoryNotFound with default headers values
+func NewGetAlertHistoryNotFound() *GetAlertHistoryNotFound {
+	return &GetAlertHistoryNotFound{}
+}
+
+/*
+GetAlertHistoryNotFound describes a response with status code 404, with default header values.
+
+No history of the alert with the specified fingerprint was found
+*/
+type GetAlertHistoryNotFound struct {
+}
+
+// IsSuccess returns true when this get alert history not found response has a 2xx status code
+func (o *GetAlertHistoryNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get alert history not found response has a 3xx status code
+func (o *GetAlertHistoryNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get alert history not found response has a 4xx status code
+func (o *GetAlertHistoryNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get alert history not found response has a 5xx status code
+func (o *GetAlertHistoryNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get alert history not found response a status code equal to that given
+func (o *GetAlertHistoryNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the get alert history not found response
+func (o *GetAlertHistoryNotFound) Code() int {
+	return 404
+}
+
+func (o *GetAlertHistoryNotFound) Error() string {
+	return fmt.Sprintf("[GET /alerts/{fingerprint}/history][%d] getAlertHistoryNotFound ", 404)
+}
+
+func (o *GetAlertHistoryNotFound) String() string {
+	return fmt.Sprintf("[GET /alerts/{fingerprint}/history][%d] getAlertHistoryNotFound ", 404)
+}
+
+func (o *GetAlertHistoryNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetAlertHistoryInternalServerError creates a GetAlertHistoryInternalServerError with default headers values
+func NewGetAlertHistoryInternalServerError() *GetAlertHistoryInternalServerError {
+	return &GetAlertHistoryInternalServerError{}
+}
+
+/*
+GetAlertHistoryInternalServerError describes a response with status code 500, with default header values.
+
+Internal server error
+*/
+type GetAlertHistoryInternalServerError struct {
+	Payload string
+}
+
+// IsSuccess returns true when this get alert history internal server error response has a 2xx status code
+func (o *GetAlertHistoryInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get alert history internal server error response has a 3xx status code
+func (o *GetAlertHistoryInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get alert history internal server error response has a 4xx status code
+func (o *GetAlertHistoryInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get alert history internal server error response has a 5xx status code
+func (o *GetAlertHistoryInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get alert history internal server error response a status code equal to that given
+func (o *GetAlertHistoryInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the get alert history internal server error response
+func (o *GetAlertHistoryInternalServerError) Code() int {
+	return 500
+}
+
+func (o *GetAlertHistoryInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /alerts/{fingerprint}/history][%d] getAlertHistoryInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetAlertHistoryInternalServerError) String() string {
+	return fmt.Sprintf("[GET /alerts/{fingerprint}/history][%d] getAlertHistoryInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetAlertHistoryInternalServerError) GetPayload() string {
+	return o.Payload
+}
+
+func (o *GetAlertHistoryInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -84,6 +84,20 @@ type GetAlertsParams struct {
 	*/
 	Active *bool
 
+	/* Cursor.
+
+	   The cursor returned in the X-Next-Cursor header of the previous page
+	*/
+	Cursor *string
+
+	/* Fields.
+
+	     The fields to return for each item, as dot-separated paths such as
+	labels or status.state. All fields are returned by default.
+
+	*/
+	Fields []string
+
 	/* Filter.
 
 	   A list of matchers to filter alerts by
@@ -98,6 +112,12 @@ type GetAlertsParams struct {
 	*/
 	Inhibited *bool
 
+	/* Limit.
+
+	   The maximum number of items to return
+	*/
+	Limit *int64
+
 	/* Receiver.
 
 	   A regex matching receivers to filter alerts by
@@ -112,6 +132,15 @@ type GetAlertsParams struct {
 	*/
 	Silenced *bool
 
+	/* Sort.
+
+	     The key to sort alerts by, one of fingerprint, startsAt and severity,
+	prefixed with - to sort in descending order. Alerts are sorted by
+	fingerprint by default.
+
+	*/
+	Sort *string
+
 	/* Unprocessed.
 
 	   Show unprocessed alerts
@@ -204,6 +233,28 @@ func (o *GetAlertsParams) SetActive(active *bool) {
 	o.Active = active
 }
 
+// WithCursor adds the cursor to the get alerts params
+func (o *GetAlertsParams) WithCursor(cursor *string) *GetAlertsParams {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the get alerts params
+func (o *GetAlertsParams) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
+// WithFields adds the fields to the get alerts params
+func (o *GetAlertsParams) WithFields(fields []string) *GetAlertsParams {
+	o.SetFields(fields)
+	return o
+}
+
+// SetFields adds the fields to the get alerts params
+func (o *GetAlertsParams) SetFields(fields []string) {
+	o.Fields = fields
+}
+
 // WithFilter adds the filter to the get alerts params
 func (o *GetAlertsParams) WithFilter(filter []string) *GetAlertsParams {
 	o.SetFilter(filter)
@@ -226,6 +277,17 @@ func (o *GetAlertsParams) SetInhibited(inhibited *bool) {
 	o.Inhibited = inhibited
 }
 
+// WithLimit adds the limit to the get alerts params
+func (o *GetAlertsParams) WithLimit(limit *int64) *GetAlertsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the get alerts params
+func (o *GetAlertsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
 // WithReceiver adds the receiver to the get alerts params
 func (o *GetAlertsParams) WithReceiver(receiver *string) *GetAlertsParams {
 	o.SetReceiver(receiver)
@@ -248,6 +310,17 @@ func (o *GetAlertsParams) SetSilenced(silenced *bool) {
 	o.Silenced = silenced
 }
 
+// WithSort adds the sort to the get alerts params
+func (o *GetAlertsParams) WithSort(sort *string) *GetAlertsParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the get alerts params
+func (o *GetAlertsParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
 // WithUnprocessed adds the unprocessed to the get alerts params
 func (o *GetAlertsParams) WithUnprocessed(unprocessed *bool) *GetAlertsParams {
 	o.SetUnprocessed(unprocessed)
@@ -284,6 +357,34 @@ func (o *GetAlertsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 		}
 	}
 
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Fields != nil {
+
+		// binding items for fields
+		joinedFields := o.bindParamFields(reg)
+
+		// query array param fields
+		if err := r.SetQueryParam("fields", joinedFields...); err != nil {
+			return err
+		}
+	}
+
 	if o.Filter != nil {
 
 		// binding items for filter
@@ -312,6 +413,23 @@ func (o *GetAlertsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 		}
 	}
 
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Receiver != nil {
 
 		// query param receiver
@@ -346,6 +464,23 @@ func (o *GetAlertsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 		}
 	}
 
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Unprocessed != nil {
 
 		// query param unprocessed
@@ -369,6 +504,23 @@ func (o *GetAlertsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 	return nil
 }
 
+// bindParamGetAlerts binds the parameter fields
+func (o *GetAlertsParams) bindParamFields(formats strfmt.Registry) []string {
+	fieldsIR := o.Fields
+
+	var fieldsIC []string
+	for _, fieldsIIR := range fieldsIR { // explode []string
+
+		fieldsIIV := fieldsIIR // string as string
+		fieldsIC = append(fieldsIC, fieldsIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	fieldsIS := swag.JoinByFormat(fieldsIC, "multi")
+
+	return fieldsIS
+}
+
 // bindParamGetAlerts binds the parameter filter
 func (o *GetAlertsParams) bindParamFilter(formats strfmt.Registry) []string {
 	filterIR := o.Filter
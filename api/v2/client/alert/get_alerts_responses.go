@@ -71,6 +71,11 @@ GetAlertsOK describes a response with status code 200, with default header value
 Get alerts response
 */
 type GetAlertsOK struct {
+
+	/* The cursor of the next page, if there are more items
+	 */
+	XNextCursor string
+
 	Payload models.GettableAlerts
 }
 
@@ -118,6 +123,13 @@ func (o *GetAlertsOK) GetPayload() models.GettableAlerts {
 
 func (o *GetAlertsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Next-Cursor
+	hdrXNextCursor := response.GetHeader("X-Next-Cursor")
+
+	if hdrXNextCursor != "" {
+		o.XNextCursor = hdrXNextCursor
+	}
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
@@ -47,6 +47,12 @@ func (o *PostAlertsReader) ReadResponse(response runtime.ClientResponse, consume
 			return nil, err
 		}
 		return nil, result
+	case 403:
+		result := NewPostAlertsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewPostAlertsInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -180,6 +186,72 @@ func (o *PostAlertsBadRequest) readResponse(response runtime.ClientResponse, con
 	return nil
 }
 
+// NewPostAlertsForbidden creates a PostAlertsForbidden with default headers values
+func NewPostAlertsForbidden() *PostAlertsForbidden {
+	return &PostAlertsForbidden{}
+}
+
+/*
+PostAlertsForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type PostAlertsForbidden struct {
+	Payload string
+}
+
+// IsSuccess returns true when this post alerts forbidden response has a 2xx status code
+func (o *PostAlertsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post alerts forbidden response has a 3xx status code
+func (o *PostAlertsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post alerts forbidden response has a 4xx status code
+func (o *PostAlertsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post alerts forbidden response has a 5xx status code
+func (o *PostAlertsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post alerts forbidden response a status code equal to that given
+func (o *PostAlertsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the post alerts forbidden response
+func (o *PostAlertsForbidden) Code() int {
+	return 403
+}
+
+func (o *PostAlertsForbidden) Error() string {
+	return fmt.Sprintf("[POST /alerts][%d] postAlertsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *PostAlertsForbidden) String() string {
+	return fmt.Sprintf("[POST /alerts][%d] postAlertsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *PostAlertsForbidden) GetPayload() string {
+	return o.Payload
+}
+
+func (o *PostAlertsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewPostAlertsInternalServerError creates a PostAlertsInternalServerError with default headers values
 func NewPostAlertsInternalServerError() *PostAlertsInternalServerError {
 	return &PostAlertsInternalServerError{}
@@ -0,0 +1,248 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewStreamAlertsParams creates a new StreamAlertsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewStreamAlertsParams() *StreamAlertsParams {
+	return &StreamAlertsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewStreamAlertsParamsWithTimeout creates a new StreamAlertsParams object
+// with the ability to set a timeout on a request.
+func NewStreamAlertsParamsWithTimeout(timeout time.Duration) *StreamAlertsParams {
+	return &StreamAlertsParams{
+		timeout: timeout,
+	}
+}
+
+// NewStreamAlertsParamsWithContext creates a new StreamAlertsParams object
+// with the ability to set a context for a request.
+func NewStreamAlertsParamsWithContext(ctx context.Context) *StreamAlertsParams {
+	return &StreamAlertsParams{
+		Context: ctx,
+	}
+}
+
+// NewStreamAlertsParamsWithHTTPClient creates a new StreamAlertsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewStreamAlertsParamsWithHTTPClient(client *http.Client) *StreamAlertsParams {
+	return &StreamAlertsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+StreamAlertsParams contains all the parameters to send to the API endpoint
+
+	for the stream alerts operation.
+
+	Typically these are written to a http.Request.
+*/
+type StreamAlertsParams struct {
+
+	/* LastEventID.
+
+	   The ID of the last event received, to resume a stream from
+	*/
+	LastEventID *string
+
+	/* Filter.
+
+	   A list of matchers to filter alerts by
+	*/
+	Filter []string
+
+	/* Receiver.
+
+	   A regex matching receivers to filter alerts by
+	*/
+	Receiver *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the stream alerts params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *StreamAlertsParams) WithDefaults() *StreamAlertsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the stream alerts params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *StreamAlertsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the stream alerts params
+func (o *StreamAlertsParams) WithTimeout(timeout time.Duration) *StreamAlertsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the stream alerts params
+func (o *StreamAlertsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the stream alerts params
+func (o *StreamAlertsParams) WithContext(ctx context.Context) *StreamAlertsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the stream alerts params
+func (o *StreamAlertsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the stream alerts params
+func (o *StreamAlertsParams) WithHTTPClient(client *http.Client) *StreamAlertsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the stream alerts params
+func (o *StreamAlertsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLastEventID adds the lastEventID to the stream alerts params
+func (o *StreamAlertsParams) WithLastEventID(lastEventID *string) *StreamAlertsParams {
+	o.SetLastEventID(lastEventID)
+	return o
+}
+
+// SetLastEventID adds the lastEventId to the stream alerts params
+func (o *StreamAlertsParams) SetLastEventID(lastEventID *string) {
+	o.LastEventID = lastEventID
+}
+
+// WithFilter adds the filter to the stream alerts params
+func (o *StreamAlertsParams) WithFilter(filter []string) *StreamAlertsParams {
+	o.SetFilter(filter)
+	return o
+}
+
+// SetFilter adds the filter to the stream alerts params
+func (o *StreamAlertsParams) SetFilter(filter []string) {
+	o.Filter = filter
+}
+
+// WithReceiver adds the receiver to the stream alerts params
+func (o *StreamAlertsParams) WithReceiver(receiver *string) *StreamAlertsParams {
+	o.SetReceiver(receiver)
+	return o
+}
+
+// SetReceiver adds the receiver to the stream alerts params
+func (o *StreamAlertsParams) SetReceiver(receiver *string) {
+	o.Receiver = receiver
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *StreamAlertsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.LastEventID != nil {
+
+		// header param Last-Event-ID
+		if err := r.SetHeaderParam("Last-Event-ID", *o.LastEventID); err != nil {
+			return err
+		}
+	}
+
+	if o.Filter != nil {
+
+		// binding items for filter
+		joinedFilter := o.bindParamFilter(reg)
+
+		// query array param filter
+		if err := r.SetQueryParam("filter", joinedFilter...); err != nil {
+			return err
+		}
+	}
+
+	if o.Receiver != nil {
+
+		// query param receiver
+		var qrReceiver string
+
+		if o.Receiver != nil {
+			qrReceiver = *o.Receiver
+		}
+		qReceiver := qrReceiver
+		if qReceiver != "" {
+
+			if err := r.SetQueryParam("receiver", qReceiver); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindParamStreamAlerts binds the parameter filter
+func (o *StreamAlertsParams) bindParamFilter(formats strfmt.Registry) []string {
+	filterIR := o.Filter
+
+	var filterIC []string
+	for _, filterIIR := range filterIR { // explode []string
+
+		filterIIV := filterIIR // string as string
+		filterIC = append(filterIC, filterIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	filterIS := swag.JoinByFormat(filterIC, "multi")
+
+	return filterIS
+}
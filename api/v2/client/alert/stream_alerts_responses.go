@@ -0,0 +1,257 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// StreamAlertsReader is a Reader for the StreamAlerts structure.
+type StreamAlertsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *StreamAlertsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewStreamAlertsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewStreamAlertsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewStreamAlertsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /alerts/stream] streamAlerts", response, response.Code())
+	}
+}
+
+// NewStreamAlertsOK creates a StreamAlertsOK with default headers values
+func NewStreamAlertsOK() *StreamAlertsOK {
+	return &StreamAlertsOK{}
+}
+
+/*
+StreamAlertsOK describes a response with status code 200, with default header values.
+
+Stream of alert events
+*/
+type StreamAlertsOK struct {
+	Payload string
+}
+
+// IsSuccess returns true when this stream alerts o k response has a 2xx status code
+func (o *StreamAlertsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this stream alerts o k response has a 3xx status code
+func (o *StreamAlertsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this stream alerts o k response has a 4xx status code
+func (o *StreamAlertsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this stream alerts o k response has a 5xx status code
+func (o *StreamAlertsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this stream alerts o k response a status code equal to that given
+func (o *StreamAlertsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the stream alerts o k response
+func (o *StreamAlertsOK) Code() int {
+	return 200
+}
+
+func (o *StreamAlertsOK) Error() string {
+	return fmt.Sprintf("[GET /alerts/stream][%d] streamAlertsOK  %+v", 200, o.Payload)
+}
+
+func (o *StreamAlertsOK) String() string {
+	return fmt.Sprintf("[GET /alerts/stream][%d] streamAlertsOK  %+v", 200, o.Payload)
+}
+
+func (o *StreamAlertsOK) GetPayload() string {
+	return o.Payload
+}
+
+func (o *StreamAlertsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStreamAlertsBadRequest creates a StreamAlertsBadRequest with default headers values
+func NewStreamAlertsBadRequest() *StreamAlertsBadRequest {
+	return &StreamAlertsBadRequest{}
+}
+
+/*
+StreamAlertsBadRequest describes a response with status code 400, with default header values.
+
+Bad request
+*/
+type StreamAlertsBadRequest struct {
+	Payload string
+}
+
+// IsSuccess returns true when this stream alerts bad request response has a 2xx status code
+func (o *StreamAlertsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this stream alerts bad request response has a 3xx status code
+func (o *StreamAlertsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this stream alerts bad request response has a 4xx status code
+func (o *StreamAlertsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this stream alerts bad request response has a 5xx status code
+func (o *StreamAlertsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this stream alerts bad request response a status code equal to that given
+func (o *StreamAlertsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the stream alerts bad request response
+func (o *StreamAlertsBadRequest) Code() int {
+	return 400
+}
+
+func (o *StreamAlertsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /alerts/stream][%d] streamAlertsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *StreamAlertsBadRequest) String() string {
+	return fmt.Sprintf("[GET /alerts/stream][%d] streamAlertsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *StreamAlertsBadRequest) GetPayload() string {
+	return o.Payload
+}
+
+func (o *StreamAlertsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStreamAlertsInternalServerError creates a StreamAlertsInternalServerError with default headers values
+func NewStreamAlertsInternalServerError() *StreamAlertsInternalServerError {
+	return &StreamAlertsInternalServerError{}
+}
+
+/*
+StreamAlertsInternalServerError describes a response with status code 500, with default header values.
+
+Internal server error
+*/
+type StreamAlertsInternalServerError struct {
+	Payload string
+}
+
+// IsSuccess returns true when this stream alerts internal server error response has a 2xx status code
+func (o *StreamAlertsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this stream alerts internal server error response has a 3xx status code
+func (o *StreamAlertsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this stream alerts internal server error response has a 4xx status code
+func (o *StreamAlertsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this stream alerts internal server error response has a 5xx status code
+func (o *StreamAlertsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this stream alerts internal server error response a status code equal to that given
+func (o *StreamAlertsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the stream alerts internal server error response
+func (o *StreamAlertsInternalServerError) Code() int {
+	return 500
+}
+
+func (o *StreamAlertsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /alerts/stream][%d] streamAlertsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *StreamAlertsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /alerts/stream][%d] streamAlertsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *StreamAlertsInternalServerError) GetPayload() string {
+	return o.Payload
+}
+
+func (o *StreamAlertsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
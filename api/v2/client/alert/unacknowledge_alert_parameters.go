@@ -0,0 +1,165 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewUnacknowledgeAlertParams creates a new UnacknowledgeAlertParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewUnacknowledgeAlertParams() *UnacknowledgeAlertParams {
+	return &UnacknowledgeAlertParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUnacknowledgeAlertParamsWithTimeout creates a new UnacknowledgeAlertParams object
+// with the ability to set a timeout on a request.
+func NewUnacknowledgeAlertParamsWithTimeout(timeout time.Duration) *UnacknowledgeAlertParams {
+	return &UnacknowledgeAlertParams{
+		timeout: timeout,
+	}
+}
+
+// NewUnacknowledgeAlertParamsWithContext creates a new UnacknowledgeAlertParams object
+// with the ability to set a context for a request.
+func NewUnacknowledgeAlertParamsWithContext(ctx context.Context) *UnacknowledgeAlertParams {
+	return &UnacknowledgeAlertParams{
+		Context: ctx,
+	}
+}
+
+// NewUnacknowledgeAlertParamsWithHTTPClient creates a new UnacknowledgeAlertParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewUnacknowledgeAlertParamsWithHTTPClient(client *http.Client) *UnacknowledgeAlertParams {
+	return &UnacknowledgeAlertParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UnacknowledgeAlertParams contains all the parameters to send to the API endpoint
+
+	for the unacknowledge alert operation.
+
+	Typically these are written to a http.Request.
+*/
+type UnacknowledgeAlertParams struct {
+
+	/* Fingerprint.
+
+	   Fingerprint of the alert
+	*/
+	Fingerprint string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the unacknowledge alert params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UnacknowledgeAlertParams) WithDefaults() *UnacknowledgeAlertParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the unacknowledge alert params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UnacknowledgeAlertParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the unacknowledge alert params
+func (o *UnacknowledgeAlertParams) WithTimeout(timeout time.Duration) *UnacknowledgeAlertParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the unacknowledge alert params
+func (o *UnacknowledgeAlertParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the unacknowledge alert params
+func (o *UnacknowledgeAlertParams) WithContext(ctx context.Context) *UnacknowledgeAlertParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the unacknowledge alert params
+func (o *UnacknowledgeAlertParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the unacknowledge alert params
+func (o *UnacknowledgeAlertParams) WithHTTPClient(client *http.Client) *UnacknowledgeAlertParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the unacknowledge alert params
+func (o *UnacknowledgeAlertParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFingerprint adds the fingerprint to the unacknowledge alert params
+func (o *UnacknowledgeAlertParams) WithFingerprint(fingerprint string) *UnacknowledgeAlertParams {
+	o.SetFingerprint(fingerprint)
+	return o
+}
+
+// SetFingerprint adds the fingerprint to the unacknowledge alert params
+func (o *UnacknowledgeAlertParams) SetFingerprint(fingerprint string) {
+	o.Fingerprint = fingerprint
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UnacknowledgeAlertParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param fingerprint
+	if err := r.SetPathParam("fingerprint", o.Fingerprint); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
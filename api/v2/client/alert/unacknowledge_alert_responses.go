@@ -0,0 +1,309 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// UnacknowledgeAlertReader is a Reader for the UnacknowledgeAlert structure.
+type UnacknowledgeAlertReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UnacknowledgeAlertReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUnacknowledgeAlertOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 403:
+		result := NewUnacknowledgeAlertForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewUnacknowledgeAlertNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewUnacknowledgeAlertInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[DELETE /alert/{fingerprint}/acknowledgement] unacknowledgeAlert", response, response.Code())
+	}
+}
+
+// NewUnacknowledgeAlertOK creates a UnacknowledgeAlertOK with default headers values
+func NewUnacknowledgeAlertOK() *UnacknowledgeAlertOK {
+	return &UnacknowledgeAlertOK{}
+}
+
+/*
+UnacknowledgeAlertOK describes a response with status code 200, with default header values.
+
+Unacknowledge alert response
+*/
+type UnacknowledgeAlertOK struct {
+}
+
+// IsSuccess returns true when this unacknowledge alert o k response has a 2xx status code
+func (o *UnacknowledgeAlertOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this unacknowledge alert o k response has a 3xx status code
+func (o *UnacknowledgeAlertOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this unacknowledge alert o k response has a 4xx status code
+func (o *UnacknowledgeAlertOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this unacknowledge alert o k response has a 5xx status code
+func (o *UnacknowledgeAlertOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this unacknowledge alert o k response a status code equal to that given
+func (o *UnacknowledgeAlertOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the unacknowledge alert o k response
+func (o *UnacknowledgeAlertOK) Code() int {
+	return 200
+}
+
+func (o *UnacknowledgeAlertOK) Error() string {
+	return fmt.Sprintf("[DELETE /alert/{fingerprint}/acknowledgement][%d] unacknowledgeAlertOK ", 200)
+}
+
+func (o *UnacknowledgeAlertOK) String() string {
+	return fmt.Sprintf("[DELETE /alert/{fingerprint}/acknowledgement][%d] unacknowledgeAlertOK ", 200)
+}
+
+func (o *UnacknowledgeAlertOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewUnacknowledgeAlertForbidden creates a UnacknowledgeAlertForbidden with default headers values
+func NewUnacknowledgeAlertForbidden() *UnacknowledgeAlertForbidden {
+	return &UnacknowledgeAlertForbidden{}
+}
+
+/*
+UnacknowledgeAlertForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type UnacknowledgeAlertForbidden struct {
+	Payload string
+}
+
+// IsSuccess returns true when this unacknowledge alert forbidden response has a 2xx status code
+func (o *UnacknowledgeAlertForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this unacknowledge alert forbidden response has a 3xx status code
+func (o *UnacknowledgeAlertForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this unacknowledge alert forbidden response has a 4xx status code
+func (o *UnacknowledgeAlertForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this unacknowledge alert forbidden response has a 5xx status code
+func (o *UnacknowledgeAlertForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this unacknowledge alert forbidden response a status code equal to that given
+func (o *UnacknowledgeAlertForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the unacknowledge alert forbidden response
+func (o *UnacknowledgeAlertForbidden) Code() int {
+	return 403
+}
+
+func (o *UnacknowledgeAlertForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /alert/{fingerprint}/acknowledgement][%d] unacknowledgeAlertForbidden  %+v", 403, o.Payload)
+}
+
+func (o *UnacknowledgeAlertForbidden) String() string {
+	return fmt.Sprintf("[DELETE /alert/{fingerprint}/acknowledgement][%d] unacknowledgeAlertForbidden  %+v", 403, o.Payload)
+}
+
+func (o *UnacknowledgeAlertForbidden) GetPayload() string {
+	return o.Payload
+}
+
+func (o *UnacknowledgeAlertForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUnacknowledgeAlertNotFound creates a UnacknowledgeAlertNotFound with default headers values
+func NewUnacknowledgeAlertNotFound() *UnacknowledgeAlertNotFound {
+	return &UnacknowledgeAlertNotFound{}
+}
+
+/*
+UnacknowledgeAlertNotFound describes a response with status code 404, with default header values.
+
+An acknowledgement of the alert with the specified fingerprint was not found
+*/
+type UnacknowledgeAlertNotFound struct {
+}
+
+// IsSuccess returns true when this unacknowledge alert not found response has a 2xx status code
+func (o *UnacknowledgeAlertNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this unacknowledge alert not found response has a 3xx status code
+func (o *UnacknowledgeAlertNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this unacknowledge alert not found response has a 4xx status code
+func (o *UnacknowledgeAlertNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this unacknowledge alert not found response has a 5xx status code
+func (o *UnacknowledgeAlertNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this unacknowledge alert not found response a status code equal to that given
+func (o *UnacknowledgeAlertNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the unacknowledge alert not found response
+func (o *UnacknowledgeAlertNotFound) Code() int {
+	return 404
+}
+
+func (o *UnacknowledgeAlertNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /alert/{fingerprint}/acknowledgement][%d] unacknowledgeAlertNotFound ", 404)
+}
+
+func (o *UnacknowledgeAlertNotFound) String() string {
+	return fmt.Sprintf("[DELETE /alert/{fingerprint}/acknowledgement][%d] unacknowledgeAlertNotFound ", 404)
+}
+
+func (o *UnacknowledgeAlertNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewUnacknowledgeAlertInternalServerError creates a UnacknowledgeAlertInternalServerError with default headers values
+func NewUnacknowledgeAlertInternalServerError() *UnacknowledgeAlertInternalServerError {
+	return &UnacknowledgeAlertInternalServerError{}
+}
+
+/*
+UnacknowledgeAlertInternalServerError describes a response with status code 500, with default header values.
+
+Internal server error
+*/
+type UnacknowledgeAlertInternalServerError struct {
+	Payload string
+}
+
+// IsSuccess returns true when this unacknowledge alert internal server error response has a 2xx status code
+func (o *UnacknowledgeAlertInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this unacknowledge alert internal server error response has a 3xx status code
+func (o *UnacknowledgeAlertInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this unacknowledge alert internal server error response has a 4xx status code
+func (o *UnacknowledgeAlertInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this unacknowledge alert internal server error response has a 5xx status code
+func (o *UnacknowledgeAlertInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this unacknowledge alert internal server error response a status code equal to that given
+func (o *UnacknowledgeAlertInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the unacknowledge alert internal server error response
+func (o *UnacknowledgeAlertInternalServerError) Code() int {
+	return 500
+}
+
+func (o *UnacknowledgeAlertInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /alert/{fingerprint}/acknowledgement][%d] unacknowledgeAlertInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *UnacknowledgeAlertInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /alert/{fingerprint}/acknowledgement][%d] unacknowledgeAlertInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *UnacknowledgeAlertInternalServerError) GetPayload() string {
+	return o.Payload
+}
+
+func (o *UnacknowledgeAlertInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -84,6 +84,20 @@ type GetAlertGroupsParams struct {
 	*/
 	Active *bool
 
+	/* Cursor.
+
+	   The cursor returned in the X-Next-Cursor header of the previous page
+	*/
+	Cursor *string
+
+	/* Fields.
+
+	     The fields to return for each item, as dot-separated paths such as
+	labels or status.state. All fields are returned by default.
+
+	*/
+	Fields []string
+
 	/* Filter.
 
 	   A list of matchers to filter alerts by
@@ -98,6 +112,12 @@ type GetAlertGroupsParams struct {
 	*/
 	Inhibited *bool
 
+	/* Limit.
+
+	   The maximum number of items to return
+	*/
+	Limit *int64
+
 	/* Receiver.
 
 	   A regex matching receivers to filter alerts by
@@ -112,6 +132,16 @@ type GetAlertGroupsParams struct {
 	*/
 	Silenced *bool
 
+	/* Sort.
+
+	     The key to sort alert groups by, one of labels, startsAt and severity,
+	prefixed with - to sort in descending order. startsAt is the start of
+	the earliest alert of a group and severity its most severe alert.
+	Alert groups are sorted by labels and receiver by default.
+
+	*/
+	Sort *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -193,6 +223,28 @@ func (o *GetAlertGroupsParams) SetActive(active *bool) {
 	o.Active = active
 }
 
+// WithCursor adds the cursor to the get alert groups params
+func (o *GetAlertGroupsParams) WithCursor(cursor *string) *GetAlertGroupsParams {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the get alert groups params
+func (o *GetAlertGroupsParams) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
+// WithFields adds the fields to the get alert groups params
+func (o *GetAlertGroupsParams) WithFields(fields []string) *GetAlertGroupsParams {
+	o.SetFields(fields)
+	return o
+}
+
+// SetFields adds the fields to the get alert groups params
+func (o *GetAlertGroupsParams) SetFields(fields []string) {
+	o.Fields = fields
+}
+
 // WithFilter adds the filter to the get alert groups params
 func (o *GetAlertGroupsParams) WithFilter(filter []string) *GetAlertGroupsParams {
 	o.SetFilter(filter)
@@ -215,6 +267,17 @@ func (o *GetAlertGroupsParams) SetInhibited(inhibited *bool) {
 	o.Inhibited = inhibited
 }
 
+// WithLimit adds the limit to the get alert groups params
+func (o *GetAlertGroupsParams) WithLimit(limit *int64) *GetAlertGroupsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the get alert groups params
+func (o *GetAlertGroupsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
 // WithReceiver adds the receiver to the get alert groups params
 func (o *GetAlertGroupsParams) WithReceiver(receiver *string) *GetAlertGroupsParams {
 	o.SetReceiver(receiver)
@@ -237,6 +300,17 @@ func (o *GetAlertGroupsParams) SetSilenced(silenced *bool) {
 	o.Silenced = silenced
 }
 
+// WithSort adds the sort to the get alert groups params
+func (o *GetAlertGroupsParams) WithSort(sort *string) *GetAlertGroupsParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the get alert groups params
+func (o *GetAlertGroupsParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetAlertGroupsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -262,6 +336,34 @@ func (o *GetAlertGroupsParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 		}
 	}
 
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Fields != nil {
+
+		// binding items for fields
+		joinedFields := o.bindParamFields(reg)
+
+		// query array param fields
+		if err := r.SetQueryParam("fields", joinedFields...); err != nil {
+			return err
+		}
+	}
+
 	if o.Filter != nil {
 
 		// binding items for filter
@@ -290,6 +392,23 @@ func (o *GetAlertGroupsParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 		}
 	}
 
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Receiver != nil {
 
 		// query param receiver
@@ -324,12 +443,46 @@ func (o *GetAlertGroupsParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 		}
 	}
 
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindParamGetAlertGroups binds the parameter fields
+func (o *GetAlertGroupsParams) bindParamFields(formats strfmt.Registry) []string {
+	fieldsIR := o.Fields
+
+	var fieldsIC []string
+	for _, fieldsIIR := range fieldsIR { // explode []string
+
+		fieldsIIV := fieldsIIR // string as string
+		fieldsIC = append(fieldsIC, fieldsIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	fieldsIS := swag.JoinByFormat(fieldsIC, "multi")
+
+	return fieldsIS
+}
+
 // bindParamGetAlertGroups binds the parameter filter
 func (o *GetAlertGroupsParams) bindParamFilter(formats strfmt.Registry) []string {
 	filterIR := o.Filter
@@ -44,11 +44,55 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	GetConfig(params *GetConfigParams, opts ...ClientOption) (*GetConfigOK, error)
+
 	GetStatus(params *GetStatusParams, opts ...ClientOption) (*GetStatusOK, error)
 
+	PutConfig(params *PutConfigParams, opts ...ClientOption) (*PutConfigOK, error)
+
+	RollbackConfig(params *RollbackConfigParams, opts ...ClientOption) (*RollbackConfigOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+GetConfig Get the running configuration and the versions kept for rollbacks
+*/
+func (a *Client) GetConfig(params *GetConfigParams, opts ...ClientOption) (*GetConfigOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetConfigParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getConfig",
+		Method:             "GET",
+		PathPattern:        "/config",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetConfigReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetConfigOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getConfig: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetStatus Get current status of an Alertmanager instance and its cluster
 */
@@ -87,6 +131,82 @@ func (a *Client) GetStatus(params *GetStatusParams, opts ...ClientOption) (*GetS
 	panic(msg)
 }
 
+/*
+PutConfig Validate and apply a new configuration
+*/
+func (a *Client) PutConfig(params *PutConfigParams, opts ...ClientOption) (*PutConfigOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutConfigParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "putConfig",
+		Method:             "PUT",
+		PathPattern:        "/config",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutConfigReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutConfigOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for putConfig: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+RollbackConfig Apply again the configuration of a previous version
+*/
+func (a *Client) RollbackConfig(params *RollbackConfigParams, opts ...ClientOption) (*RollbackConfigOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRollbackConfigParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "rollbackConfig",
+		Method:             "POST",
+		PathPattern:        "/config/versions/{versionID}/rollback",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RollbackConfigReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RollbackConfigOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for rollbackConfig: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
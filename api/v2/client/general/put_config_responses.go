@@ -0,0 +1,333 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package general
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// PutConfigReader is a Reader for the PutConfig structure.
+type PutConfigReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PutConfigReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPutConfigOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPutConfigBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewPutConfigForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewPutConfigInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PUT /config] putConfig", response, response.Code())
+	}
+}
+
+// NewPutConfigOK creates a PutConfigOK with default headers values
+func NewPutConfigOK() *PutConfigOK {
+	return &PutConfigOK{}
+}
+
+/*
+PutConfigOK describes a response with status code 200, with default header values.
+
+The configuration was applied, or is valid for a dry run
+*/
+type PutConfigOK struct {
+	Payload *models.ConfigVersion
+}
+
+// IsSuccess returns true when this put config o k response has a 2xx status code
+func (o *PutConfigOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this put config o k response has a 3xx status code
+func (o *PutConfigOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this put config o k response has a 4xx status code
+func (o *PutConfigOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this put config o k response has a 5xx status code
+func (o *PutConfigOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this put config o k response a status code equal to that given
+func (o *PutConfigOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the put config o k response
+func (o *PutConfigOK) Code() int {
+	return 200
+}
+
+func (o *PutConfigOK) Error() string {
+	return fmt.Sprintf("[PUT /config][%d] putConfigOK  %+v", 200, o.Payload)
+}
+
+func (o *PutConfigOK) String() string {
+	return fmt.Sprintf("[PUT /config][%d] putConfigOK  %+v", 200, o.Payload)
+}
+
+func (o *PutConfigOK) GetPayload() *models.ConfigVersion {
+	return o.Payload
+}
+
+func (o *PutConfigOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ConfigVersion)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutConfigBadRequest creates a PutConfigBadRequest with default headers values
+func NewPutConfigBadRequest() *PutConfigBadRequest {
+	return &PutConfigBadRequest{}
+}
+
+/*
+PutConfigBadRequest describes a response with status code 400, with default header values.
+
+Bad request
+*/
+type PutConfigBadRequest struct {
+	Payload string
+}
+
+// IsSuccess returns true when this put config bad request response has a 2xx status code
+func (o *PutConfigBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this put config bad request response has a 3xx status code
+func (o *PutConfigBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this put config bad request response has a 4xx status code
+func (o *PutConfigBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this put config bad request response has a 5xx status code
+func (o *PutConfigBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this put config bad request response a status code equal to that given
+func (o *PutConfigBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the put config bad request response
+func (o *PutConfigBadRequest) Code() int {
+	return 400
+}
+
+func (o *PutConfigBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /config][%d] putConfigBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PutConfigBadRequest) String() string {
+	return fmt.Sprintf("[PUT /config][%d] putConfigBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PutConfigBadRequest) GetPayload() string {
+	return o.Payload
+}
+
+func (o *PutConfigBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutConfigForbidden creates a PutConfigForbidden with default headers values
+func NewPutConfigForbidden() *PutConfigForbidden {
+	return &PutConfigForbidden{}
+}
+
+/*
+PutConfigForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type PutConfigForbidden struct {
+	Payload string
+}
+
+// IsSuccess returns true when this put config forbidden response has a 2xx status code
+func (o *PutConfigForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this put config forbidden response has a 3xx status code
+func (o *PutConfigForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this put config forbidden response has a 4xx status code
+func (o *PutConfigForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this put config forbidden response has a 5xx status code
+func (o *PutConfigForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this put config forbidden response a status code equal to that given
+func (o *PutConfigForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the put config forbidden response
+func (o *PutConfigForbidden) Code() int {
+	return 403
+}
+
+func (o *PutConfigForbidden) Error() string {
+	return fmt.Sprintf("[PUT /config][%d] putConfigForbidden  %+v", 403, o.Payload)
+}
+
+func (o *PutConfigForbidden) String() string {
+	return fmt.Sprintf("[PUT /config][%d] putConfigForbidden  %+v", 403, o.Payload)
+}
+
+func (o *PutConfigForbidden) GetPayload() string {
+	return o.Payload
+}
+
+func (o *PutConfigForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutConfigInternalServerError creates a PutConfigInternalServerError with default headers values
+func NewPutConfigInternalServerError() *PutConfigInternalServerError {
+	return &PutConfigInternalServerError{}
+}
+
+/*
+PutConfigInternalServerError describes a response with status code 500, with default header values.
+
+Internal server error
+*/
+type PutConfigInternalServerError struct {
+	Payload string
+}
+
+// IsSuccess returns true when this put config internal server error response has a 2xx status code
+func (o *PutConfigInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this put config internal server error response has a 3xx status code
+func (o *PutConfigInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this put config internal server error response has a 4xx status code
+func (o *PutConfigInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this put config internal server error response has a 5xx status code
+func (o *PutConfigInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this put config internal server error response a status code equal to that given
+func (o *PutConfigInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the put config internal server error response
+func (o *PutConfigInternalServerError) Code() int {
+	return 500
+}
+
+func (o *PutConfigInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /config][%d] putConfigInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *PutConfigInternalServerError) String() string {
+	return fmt.Sprintf("[PUT /config][%d] putConfigInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *PutConfigInternalServerError) GetPayload() string {
+	return o.Payload
+}
+
+func (o *PutConfigInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package general
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewRollbackConfigParams creates a new RollbackConfigParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRollbackConfigParams() *RollbackConfigParams {
+	return &RollbackConfigParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRollbackConfigParamsWithTimeout creates a new RollbackConfigParams object
+// with the ability to set a timeout on a request.
+func NewRollbackConfigParamsWithTimeout(timeout time.Duration) *RollbackConfigParams {
+	return &RollbackConfigParams{
+		timeout: timeout,
+	}
+}
+
+// NewRollbackConfigParamsWithContext creates a new RollbackConfigParams object
+// with the ability to set a context for a request.
+func NewRollbackConfigParamsWithContext(ctx context.Context) *RollbackConfigParams {
+	return &RollbackConfigParams{
+		Context: ctx,
+	}
+}
+
+// NewRollbackConfigParamsWithHTTPClient creates a new RollbackConfigParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRollbackConfigParamsWithHTTPClient(client *http.Client) *RollbackConfigParams {
+	return &RollbackConfigParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RollbackConfigParams contains all the parameters to send to the API endpoint
+
+	for the rollback config operation.
+
+	Typically these are written to a http.Request.
+*/
+type RollbackConfigParams struct {
+
+	/* Persist.
+
+	   Whether to also write the configuration to the configuration file
+	*/
+	Persist *bool
+
+	/* VersionID.
+
+	   ID of the configuration version to roll back to
+
+	   Format: int64
+	*/
+	VersionID int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the rollback config params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RollbackConfigParams) WithDefaults() *RollbackConfigParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the rollback config params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RollbackConfigParams) SetDefaults() {
+	var (
+		persistDefault = bool(false)
+	)
+
+	val := RollbackConfigParams{
+		Persist: &persistDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the rollback config params
+func (o *RollbackConfigParams) WithTimeout(timeout time.Duration) *RollbackConfigParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the rollback config params
+func (o *RollbackConfigParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the rollback config params
+func (o *RollbackConfigParams) WithContext(ctx context.Context) *RollbackConfigParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the rollback config params
+func (o *RollbackConfigParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the rollback config params
+func (o *RollbackConfigParams) WithHTTPClient(client *http.Client) *RollbackConfigParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the rollback config params
+func (o *RollbackConfigParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithPersist adds the persist to the rollback config params
+func (o *RollbackConfigParams) WithPersist(persist *bool) *RollbackConfigParams {
+	o.SetPersist(persist)
+	return o
+}
+
+// SetPersist adds the persist to the rollback config params
+func (o *RollbackConfigParams) SetPersist(persist *bool) {
+	o.Persist = persist
+}
+
+// WithVersionID adds the versionID to the rollback config params
+func (o *RollbackConfigParams) WithVersionID(versionID int64) *RollbackConfigParams {
+	o.SetVersionID(versionID)
+	return o
+}
+
+// SetVersionID adds the versionId to the rollback config params
+func (o *RollbackConfigParams) SetVersionID(versionID int64) {
+	o.VersionID = versionID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RollbackConfigParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Persist != nil {
+
+		// query param persist
+		var qrPersist bool
+
+		if o.Persist != nil {
+			qrPersist = *o.Persist
+		}
+		qPersist := swag.FormatBool(qrPersist)
+		if qPersist != "" {
+
+			if err := r.SetQueryParam("persist", qPersist); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param versionID
+	if err := r.SetPathParam("versionID", swag.FormatInt64(o.VersionID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,395 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package general
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// RollbackConfigReader is a Reader for the RollbackConfig structure.
+type RollbackConfigReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RollbackConfigReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRollbackConfigOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewRollbackConfigBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewRollbackConfigForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewRollbackConfigNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewRollbackConfigInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /config/versions/{versionID}/rollback] rollbackConfig", response, response.Code())
+	}
+}
+
+// NewRollbackConfigOK creates a RollbackConfigOK with default headers values
+func NewRollbackConfigOK() *RollbackConfigOK {
+	return &RollbackConfigOK{}
+}
+
+/*
+RollbackConfigOK describes a response with status code 200, with default header values.
+
+The configuration was applied
+*/
+type RollbackConfigOK struct {
+	Payload *models.ConfigVersion
+}
+
+// IsSuccess returns true when this rollback config o k response has a 2xx status code
+func (o *RollbackConfigOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this rollback config o k response has a 3xx status code
+func (o *RollbackConfigOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this rollback config o k response has a 4xx status code
+func (o *RollbackConfigOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this rollback config o k response has a 5xx status code
+func (o *RollbackConfigOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this rollback config o k response a status code equal to that given
+func (o *RollbackConfigOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the rollback config o k response
+func (o *RollbackConfigOK) Code() int {
+	return 200
+}
+
+func (o *RollbackConfigOK) Error() string {
+	return fmt.Sprintf("[POST /config/versions/{versionID}/rollback][%d] rollbackConfigOK  %+v", 200, o.Payload)
+}
+
+func (o *RollbackConfigOK) String() string {
+	return fmt.Sprintf("[POST /config/versions/{versionID}/rollback][%d] rollbackConfigOK  %+v", 200, o.Payload)
+}
+
+func (o *RollbackConfigOK) GetPayload() *models.ConfigVersion {
+	return o.Payload
+}
+
+func (o *RollbackConfigOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ConfigVersion)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRollbackConfigBadRequest creates a RollbackConfigBadRequest with default headers values
+func NewRollbackConfigBadRequest() *RollbackConfigBadRequest {
+	return &RollbackConfigBadRequest{}
+}
+
+/*
+RollbackConfigBadRequest describes a response with status code 400, with default header values.
+
+Bad request
+*/
+type RollbackConfigBadRequest struct {
+	Payload string
+}
+
+// IsSuccess returns true when this rollback config bad request response has a 2xx status code
+func (o *RollbackConfigBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this rollback config bad request response has a 3xx status code
+func (o *RollbackConfigBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this rollback config bad request response has a 4xx status code
+func (o *RollbackConfigBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this rollback config bad request response has a 5xx status code
+func (o *RollbackConfigBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this rollback config bad request response a status code equal to that given
+func (o *RollbackConfigBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the rollback config bad request response
+func (o *RollbackConfigBadRequest) Code() int {
+	return 400
+}
+
+func (o *RollbackConfigBadRequest) Error() string {
+	return fmt.Sprintf("[POST /config/versions/{versionID}/rollback][%d] rollbackConfigBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RollbackConfigBadRequest) String() string {
+	return fmt.Sprintf("[POST /config/versions/{versionID}/rollback][%d] rollbackConfigBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RollbackConfigBadRequest) GetPayload() string {
+	return o.Payload
+}
+
+func (o *RollbackConfigBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRollbackConfigForbidden creates a RollbackConfigForbidden with default headers values
+func NewRollbackConfigForbidden() *RollbackConfigForbidden {
+	return &RollbackConfigForbidden{}
+}
+
+/*
+RollbackConfigForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type RollbackConfigForbidden struct {
+	Payload string
+}
+
+// IsSuccess returns true when this rollback config forbidden response has a 2xx status code
+func (o *RollbackConfigForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this rollback config forbidden response has a 3xx status code
+func (o *RollbackConfigForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this rollback config forbidden response has a 4xx status code
+func (o *RollbackConfigForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this rollback config forbidden response has a 5xx status code
+func (o *RollbackConfigForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this rollback config forbidden response a status code equal to that given
+func (o *RollbackConfigForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the rollback config forbidden response
+func (o *RollbackConfigForbidden) Code() int {
+	return 403
+}
+
+func (o *RollbackConfigForbidden) Error() string {
+	return fmt.Sprintf("[POST /config/versions/{versionID}/rollback][%d] rollbackConfigForbidden  %+v", 403, o.Payload)
+}
+
+func (o *RollbackConfigForbidden) String() string {
+	return fmt.Sprintf("[POST /config/versions/{versionID}/rollback][%d] rollbackConfigForbidden  %+v", 403, o.Payload)
+}
+
+func (o *RollbackConfigForbidden) GetPayload() string {
+	return o.Payload
+}
+
+func (o *RollbackConfigForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRollbackConfigNotFound creates a RollbackConfigNotFound with default headers values
+func NewRollbackConfigNotFound() *RollbackConfigNotFound {
+	return &RollbackConfigNotFound{}
+}
+
+/*
+RollbackConfigNotFound describes a response with status code 404, with default header values.
+
+The configuration version was not found
+*/
+type RollbackConfigNotFound struct {
+}
+
+// IsSuccess returns true when this rollback config not found response has a 2xx status code
+func (o *RollbackConfigNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this rollback config not found response has a 3xx status code
+func (o *RollbackConfigNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this rollback config not found response has a 4xx status code
+func (o *RollbackConfigNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this rollback config not found response has a 5xx status code
+func (o *RollbackConfigNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this rollback config not found response a status code equal to that given
+func (o *RollbackConfigNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the rollback config not found response
+func (o *RollbackConfigNotFound) Code() int {
+	return 404
+}
+
+func (o *RollbackConfigNotFound) Error() string {
+	return fmt.Sprintf("[POST /config/versions/{versionID}/rollback][%d] rollbackConfigNotFound ", 404)
+}
+
+func (o *RollbackConfigNotFound) String() string {
+	return fmt.Sprintf("[POST /config/versions/{versionID}/rollback][%d] rollbackConfigNotFound ", 404)
+}
+
+func (o *RollbackConfigNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRollbackConfigInternalServerError creates a RollbackConfigInternalServerError with default headers values
+func NewRollbackConfigInternalServerError() *RollbackConfigInternalServerError {
+	return &RollbackConfigInternalServerError{}
+}
+
+/*
+RollbackConfigInternalServerError describes a response with status code 500, with default header values.
+
+Internal server error
+*/
+type RollbackConfigInternalServerError struct {
+	Payload string
+}
+
+// IsSuccess returns true when this rollback config internal server error response has a 2xx status code
+func (o *RollbackConfigInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this rollback config internal server error response has a 3xx status code
+func (o *RollbackConfigInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this rollback config internal server error response has a 4xx status code
+func (o *RollbackConfigInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this rollback config internal server error response has a 5xx status code
+func (o *RollbackConfigInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this rollback config internal server error response a status code equal to that given
+func (o *RollbackConfigInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the rollback config internal server error response
+func (o *RollbackConfigInternalServerError) Code() int {
+	return 500
+}
+
+func (o *RollbackConfigInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /config/versions/{versionID}/rollback][%d] rollbackConfigInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RollbackConfigInternalServerError) String() string {
+	return fmt.Sprintf("[POST /config/versions/{versionID}/rollback][%d] rollbackConfigInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RollbackConfigInternalServerError) GetPayload() string {
+	return o.Payload
+}
+
+func (o *RollbackConfigInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// NewApproveSilenceParams creates a new ApproveSilenceParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewApproveSilenceParams() *ApproveSilenceParams {
+	return &ApproveSilenceParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewApproveSilenceParamsWithTimeout creates a new ApproveSilenceParams object
+// with the ability to set a timeout on a request.
+func NewApproveSilenceParamsWithTimeout(timeout time.Duration) *ApproveSilenceParams {
+	return &ApproveSilenceParams{
+		timeout: timeout,
+	}
+}
+
+// NewApproveSilenceParamsWithContext creates a new ApproveSilenceParams object
+// with the ability to set a context for a request.
+func NewApproveSilenceParamsWithContext(ctx context.Context) *ApproveSilenceParams {
+	return &ApproveSilenceParams{
+		Context: ctx,
+	}
+}
+
+// NewApproveSilenceParamsWithHTTPClient creates a new ApproveSilenceParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewApproveSilenceParamsWithHTTPClient(client *http.Client) *ApproveSilenceParams {
+	return &ApproveSilenceParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ApproveSilenceParams contains all the parameters to send to the API endpoint
+
+	for the approve silence operation.
+
+	Typically these are written to a http.Request.
+*/
+type ApproveSilenceParams struct {
+
+	/* Decision.
+
+	   The approval decision
+	*/
+	Decision *models.SilenceApprovalDecision
+
+	/* SilenceID.
+
+	   ID of the silence to approve
+
+	   Format: uuid
+	*/
+	SilenceID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the approve silence params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ApproveSilenceParams) WithDefaults() *ApproveSilenceParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the approve silence params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ApproveSilenceParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the approve silence params
+func (o *ApproveSilenceParams) WithTimeout(timeout time.Duration) *ApproveSilenceParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the approve silence params
+func (o *ApproveSilenceParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the approve silence params
+func (o *ApproveSilenceParams) WithContext(ctx context.Context) *ApproveSilenceParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the approve silence params
+func (o *ApproveSilenceParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the approve silence params
+func (o *ApproveSilenceParams) WithHTTPClient(client *http.Client) *ApproveSilenceParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the approve silence params
+func (o *ApproveSilenceParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDecision adds the decision to the approve silence params
+func (o *ApproveSilenceParams) WithDecision(decision *models.SilenceApprovalDecision) *ApproveSilenceParams {
+	o.SetDecision(decision)
+	return o
+}
+
+// SetDecision adds the decision to the approve silence params
+func (o *ApproveSilenceParams) SetDecision(decision *models.SilenceApprovalDecision) {
+	o.Decision = decision
+}
+
+// WithSilenceID adds the silenceID to the approve silence params
+func (o *ApproveSilenceParams) WithSilenceID(silenceID strfmt.UUID) *ApproveSilenceParams {
+	o.SetSilenceID(silenceID)
+	return o
+}
+
+// SetSilenceID adds the silenceId to the approve silence params
+func (o *ApproveSilenceParams) SetSilenceID(silenceID strfmt.UUID) {
+	o.SilenceID = silenceID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ApproveSilenceParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Decision != nil {
+		if err := r.SetBodyParam(o.Decision); err != nil {
+			return err
+		}
+	}
+
+	// path param silenceID
+	if err := r.SetPathParam("silenceID", o.SilenceID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
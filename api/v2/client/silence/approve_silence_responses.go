@@ -0,0 +1,381 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// ApproveSilenceReader is a Reader for the ApproveSilence structure.
+type ApproveSilenceReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ApproveSilenceReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewApproveSilenceOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewApproveSilenceBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewApproveSilenceForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewApproveSilenceNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewApproveSilenceInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /silence/{silenceID}/approve] approveSilence", response, response.Code())
+	}
+}
+
+// NewApproveSilenceOK creates a ApproveSilenceOK with default headers values
+func NewApproveSilenceOK() *ApproveSilenceOK {
+	return &ApproveSilenceOK{}
+}
+
+/*
+ApproveSilenceOK describes a response with status code 200, with default header values.
+
+Approve silence response
+*/
+type ApproveSilenceOK struct {
+}
+
+// IsSuccess returns true when this approve silence o k response has a 2xx status code
+func (o *ApproveSilenceOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this approve silence o k response has a 3xx status code
+func (o *ApproveSilenceOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this approve silence o k response has a 4xx status code
+func (o *ApproveSilenceOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this approve silence o k response has a 5xx status code
+func (o *ApproveSilenceOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this approve silence o k response a status code equal to that given
+func (o *ApproveSilenceOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the approve silence o k response
+func (o *ApproveSilenceOK) Code() int {
+	return 200
+}
+
+func (o *ApproveSilenceOK) Error() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/approve][%d] approveSilenceOK ", 200)
+}
+
+func (o *ApproveSilenceOK) String() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/approve][%d] approveSilenceOK ", 200)
+}
+
+func (o *ApproveSilenceOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewApproveSilenceBadRequest creates a ApproveSilenceBadRequest with default headers values
+func NewApproveSilenceBadRequest() *ApproveSilenceBadRequest {
+	return &ApproveSilenceBadRequest{}
+}
+
+/*
+ApproveSilenceBadRequest describes a response with status code 400, with default header values.
+
+Bad request
+*/
+type ApproveSilenceBadRequest struct {
+	Payload string
+}
+
+// IsSuccess returns true when this approve silence bad request response has a 2xx status code
+func (o *ApproveSilenceBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this approve silence bad request response has a 3xx status code
+func (o *ApproveSilenceBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this approve silence bad request response has a 4xx status code
+func (o *ApproveSilenceBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this approve silence bad request response has a 5xx status code
+func (o *ApproveSilenceBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this approve silence bad request response a status code equal to that given
+func (o *ApproveSilenceBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the approve silence bad request response
+func (o *ApproveSilenceBadRequest) Code() int {
+	return 400
+}
+
+func (o *ApproveSilenceBadRequest) Error() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/approve][%d] approveSilenceBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ApproveSilenceBadRequest) String() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/approve][%d] approveSilenceBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ApproveSilenceBadRequest) GetPayload() string {
+	return o.Payload
+}
+
+func (o *ApproveSilenceBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApproveSilenceForbidden creates a ApproveSilenceForbidden with default headers values
+func NewApproveSilenceForbidden() *ApproveSilenceForbidden {
+	return &ApproveSilenceForbidden{}
+}
+
+/*
+ApproveSilenceForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ApproveSilenceForbidden struct {
+	Payload string
+}
+
+// IsSuccess returns true when this approve silence forbidden response has a 2xx status code
+func (o *ApproveSilenceForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this approve silence forbidden response has a 3xx status code
+func (o *ApproveSilenceForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this approve silence forbidden response has a 4xx status code
+func (o *ApproveSilenceForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this approve silence forbidden response has a 5xx status code
+func (o *ApproveSilenceForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this approve silence forbidden response a status code equal to that given
+func (o *ApproveSilenceForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the approve silence forbidden response
+func (o *ApproveSilenceForbidden) Code() int {
+	return 403
+}
+
+func (o *ApproveSilenceForbidden) Error() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/approve][%d] approveSilenceForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ApproveSilenceForbidden) String() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/approve][%d] approveSilenceForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ApproveSilenceForbidden) GetPayload() string {
+	return o.Payload
+}
+
+func (o *ApproveSilenceForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApproveSilenceNotFound creates a ApproveSilenceNotFound with default headers values
+func NewApproveSilenceNotFound() *ApproveSilenceNotFound {
+	return &ApproveSilenceNotFound{}
+}
+
+/*
+ApproveSilenceNotFound describes a response with status code 404, with default header values.
+
+A silence with the specified ID was not found
+*/
+type ApproveSilenceNotFound struct {
+}
+
+// IsSuccess returns true when this approve silence not found response has a 2xx status code
+func (o *ApproveSilenceNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this approve silence not found response has a 3xx status code
+func (o *ApproveSilenceNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this approve silence not found response has a 4xx status code
+func (o *ApproveSilenceNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this approve silence not found response has a 5xx status code
+func (o *ApproveSilenceNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this approve silence not found response a status code equal to that given
+func (o *ApproveSilenceNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the approve silence not found response
+func (o *ApproveSilenceNotFound) Code() int {
+	return 404
+}
+
+func (o *ApproveSilenceNotFound) Error() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/approve][%d] approveSilenceNotFound ", 404)
+}
+
+func (o *ApproveSilenceNotFound) String() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/approve][%d] approveSilenceNotFound ", 404)
+}
+
+func (o *ApproveSilenceNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewApproveSilenceInternalServerError creates a ApproveSilenceInternalServerError with default headers values
+func NewApproveSilenceInternalServerError() *ApproveSilenceInternalServerError {
+	return &ApproveSilenceInternalServerError{}
+}
+
+/*
+ApproveSilenceInternalServerError describes a response with status code 500, with default header values.
+
+Internal server error
+*/
+type ApproveSilenceInternalServerError struct {
+	Payload string
+}
+
+// IsSuccess returns true when this approve silence internal server error response has a 2xx status code
+func (o *ApproveSilenceInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this approve silence internal server error response has a 3xx status code
+func (o *ApproveSilenceInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this approve silence internal server error response has a 4xx status code
+func (o *ApproveSilenceInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this approve silence internal server error response has a 5xx status code
+func (o *ApproveSilenceInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this approve silence internal server error response a status code equal to that given
+func (o *ApproveSilenceInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the approve silence internal server error response
+func (o *ApproveSilenceInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ApproveSilenceInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/approve][%d] approveSilenceInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ApproveSilenceInternalServerError) String() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/approve][%d] approveSilenceInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ApproveSilenceInternalServerError) GetPayload() string {
+	return o.Payload
+}
+
+func (o *ApproveSilenceInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// NewConsolidateSilencesParams creates a new ConsolidateSilencesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewConsolidateSilencesParams() *ConsolidateSilencesParams {
+	return &ConsolidateSilencesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewConsolidateSilencesParamsWithTimeout creates a new ConsolidateSilencesParams object
+// with the ability to set a timeout on a request.
+func NewConsolidateSilencesParamsWithTimeout(timeout time.Duration) *ConsolidateSilencesParams {
+	return &ConsolidateSilencesParams{
+		timeout: timeout,
+	}
+}
+
+// NewConsolidateSilencesParamsWithContext creates a new ConsolidateSilencesParams object
+// with the ability to set a context for a request.
+func NewConsolidateSilencesParamsWithContext(ctx context.Context) *ConsolidateSilencesParams {
+	return &ConsolidateSilencesParams{
+		Context: ctx,
+	}
+}
+
+// NewConsolidateSilencesParamsWithHTTPClient creates a new ConsolidateSilencesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewConsolidateSilencesParamsWithHTTPClient(client *http.Client) *ConsolidateSilencesParams {
+	return &ConsolidateSilencesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ConsolidateSilencesParams contains all the parameters to send to the API endpoint
+
+	for the consolidate silences operation.
+
+	Typically these are written to a http.Request.
+*/
+type ConsolidateSilencesParams struct {
+
+	/* Consolidation.
+
+	   The silences to consolidate
+	*/
+	Consolidation *models.SilenceConsolidation
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the consolidate silences params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ConsolidateSilencesParams) WithDefaults() *ConsolidateSilencesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the consolidate silences params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ConsolidateSilencesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the consolidate silences params
+func (o *ConsolidateSilencesParams) WithTimeout(timeout time.Duration) *ConsolidateSilencesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the consolidate silences params
+func (o *ConsolidateSilencesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the consolidate silences params
+func (o *ConsolidateSilencesParams) WithContext(ctx context.Context) *ConsolidateSilencesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the consolidate silences params
+func (o *ConsolidateSilencesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the consolidate silences params
+func (o *ConsolidateSilencesParams) WithHTTPClient(client *http.Client) *ConsolidateSilencesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the consolidate silences params
+func (o *ConsolidateSilencesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithConsolidation adds the consolidation to the consolidate silences params
+func (o *ConsolidateSilencesParams) WithConsolidation(consolidation *models.SilenceConsolidation) *ConsolidateSilencesParams {
+	o.SetConsolidation(consolidation)
+	return o
+}
+
+// SetConsolidation adds the consolidation to the consolidate silences params
+func (o *ConsolidateSilencesParams) SetConsolidation(consolidation *models.SilenceConsolidation) {
+	o.Consolidation = consolidation
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ConsolidateSilencesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Consolidation != nil {
+		if err := r.SetBodyParam(o.Consolidation); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
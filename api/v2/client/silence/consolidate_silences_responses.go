@@ -0,0 +1,443 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ConsolidateSilencesReader is a Reader for the ConsolidateSilences structure.
+type ConsolidateSilencesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ConsolidateSilencesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewConsolidateSilencesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewConsolidateSilencesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewConsolidateSilencesForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewConsolidateSilencesNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewConsolidateSilencesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /silences/consolidate] consolidateSilences", response, response.Code())
+	}
+}
+
+// NewConsolidateSilencesOK creates a ConsolidateSilencesOK with default headers values
+func NewConsolidateSilencesOK() *ConsolidateSilencesOK {
+	return &ConsolidateSilencesOK{}
+}
+
+/*
+ConsolidateSilencesOK describes a response with status code 200, with default header values.
+
+Consolidate silences response
+*/
+type ConsolidateSilencesOK struct {
+	Payload *ConsolidateSilencesOKBody
+}
+
+// IsSuccess returns true when this consolidate silences o k response has a 2xx status code
+func (o *ConsolidateSilencesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this consolidate silences o k response has a 3xx status code
+func (o *ConsolidateSilencesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this consolidate silences o k response has a 4xx status code
+func (o *ConsolidateSilencesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this consolidate silences o k response has a 5xx status code
+func (o *ConsolidateSilencesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this consolidate silences o k response a status code equal to that given
+func (o *ConsolidateSilencesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the consolidate silences o k response
+func (o *ConsolidateSilencesOK) Code() int {
+	return 200
+}
+
+func (o *ConsolidateSilencesOK) Error() string {
+	return fmt.Sprintf("[POST /silences/consolidate][%d] consolidateSilencesOK  %+v", 200, o.Payload)
+}
+
+func (o *ConsolidateSilencesOK) String() string {
+	return fmt.Sprintf("[POST /silences/consolidate][%d] consolidateSilencesOK  %+v", 200, o.Payload)
+}
+
+func (o *ConsolidateSilencesOK) GetPayload() *ConsolidateSilencesOKBody {
+	return o.Payload
+}
+
+func (o *ConsolidateSilencesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(ConsolidateSilencesOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewConsolidateSilencesBadRequest creates a ConsolidateSilencesBadRequest with default headers values
+func NewConsolidateSilencesBadRequest() *ConsolidateSilencesBadRequest {
+	return &ConsolidateSilencesBadRequest{}
+}
+
+/*
+ConsolidateSilencesBadRequest describes a response with status code 400, with default header values.
+
+Bad request
+*/
+type ConsolidateSilencesBadRequest struct {
+	Payload string
+}
+
+// IsSuccess returns true when this consolidate silences bad request response has a 2xx status code
+func (o *ConsolidateSilencesBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this consolidate silences bad request response has a 3xx status code
+func (o *ConsolidateSilencesBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this consolidate silences bad request response has a 4xx status code
+func (o *ConsolidateSilencesBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this consolidate silences bad request response has a 5xx status code
+func (o *ConsolidateSilencesBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this consolidate silences bad request response a status code equal to that given
+func (o *ConsolidateSilencesBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the consolidate silences bad request response
+func (o *ConsolidateSilencesBadRequest) Code() int {
+	return 400
+}
+
+func (o *ConsolidateSilencesBadRequest) Error() string {
+	return fmt.Sprintf("[POST /silences/consolidate][%d] consolidateSilencesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ConsolidateSilencesBadRequest) String() string {
+	return fmt.Sprintf("[POST /silences/consolidate][%d] consolidateSilencesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ConsolidateSilencesBadRequest) GetPayload() string {
+	return o.Payload
+}
+
+func (o *ConsolidateSilencesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewConsolidateSilencesForbidden creates a ConsolidateSilencesForbidden with default headers values
+func NewConsolidateSilencesForbidden() *ConsolidateSilencesForbidden {
+	return &ConsolidateSilencesForbidden{}
+}
+
+/*
+ConsolidateSilencesForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ConsolidateSilencesForbidden struct {
+	Payload string
+}
+
+// IsSuccess returns true when this consolidate silences forbidden response has a 2xx status code
+func (o *ConsolidateSilencesForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this consolidate silences forbidden response has a 3xx status code
+func (o *ConsolidateSilencesForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this consolidate silences forbidden response has a 4xx status code
+func (o *ConsolidateSilencesForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this consolidate silences forbidden response has a 5xx status code
+func (o *ConsolidateSilencesForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this consolidate silences forbidden response a status code equal to that given
+func (o *ConsolidateSilencesForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the consolidate silences forbidden response
+func (o *ConsolidateSilencesForbidden) Code() int {
+	return 403
+}
+
+func (o *ConsolidateSilencesForbidden) Error() string {
+	return fmt.Sprintf("[POST /silences/consolidate][%d] consolidateSilencesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ConsolidateSilencesForbidden) String() string {
+	return fmt.Sprintf("[POST /silences/consolidate][%d] consolidateSilencesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ConsolidateSilencesForbidden) GetPayload() string {
+	return o.Payload
+}
+
+func (o *ConsolidateSilencesForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewConsolidateSilencesNotFound creates a ConsolidateSilencesNotFound with default headers values
+func NewConsolidateSilencesNotFound() *ConsolidateSilencesNotFound {
+	return &ConsolidateSilencesNotFound{}
+}
+
+/*
+ConsolidateSilencesNotFound describes a response with status code 404, with default header values.
+
+A silence with one of the specified IDs was not found
+*/
+type ConsolidateSilencesNotFound struct {
+	Payload string
+}
+
+// IsSuccess returns true when this consolidate silences not found response has a 2xx status code
+func (o *ConsolidateSilencesNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this consolidate silences not found response has a 3xx status code
+func (o *ConsolidateSilencesNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this consolidate silences not found response has a 4xx status code
+func (o *ConsolidateSilencesNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this consolidate silences not found response has a 5xx status code
+func (o *ConsolidateSilencesNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this consolidate silences not found response a status code equal to that given
+func (o *ConsolidateSilencesNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the consolidate silences not found response
+func (o *ConsolidateSilencesNotFound) Code() int {
+	return 404
+}
+
+func (o *ConsolidateSilencesNotFound) Error() string {
+	return fmt.Sprintf("[POST /silences/consolidate][%d] consolidateSilencesNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ConsolidateSilencesNotFound) String() string {
+	return fmt.Sprintf("[POST /silences/consolidate][%d] consolidateSilencesNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ConsolidateSilencesNotFound) GetPayload() string {
+	return o.Payload
+}
+
+func (o *ConsolidateSilencesNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewConsolidateSilencesInternalServerError creates a ConsolidateSilencesInternalServerError with default headers values
+func NewConsolidateSilencesInternalServerError() *ConsolidateSilencesInternalServerError {
+	return &ConsolidateSilencesInternalServerError{}
+}
+
+/*
+ConsolidateSilencesInternalServerError describes a response with status code 500, with default header values.
+
+Internal server error
+*/
+type ConsolidateSilencesInternalServerError struct {
+	Payload string
+}
+
+// IsSuccess returns true when this consolidate silences internal server error response has a 2xx status code
+func (o *ConsolidateSilencesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this consolidate silences internal server error response has a 3xx status code
+func (o *ConsolidateSilencesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this consolidate silences internal server error response has a 4xx status code
+func (o *ConsolidateSilencesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this consolidate silences internal server error response has a 5xx status code
+func (o *ConsolidateSilencesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this consolidate silences internal server error response a status code equal to that given
+func (o *ConsolidateSilencesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the consolidate silences internal server error response
+func (o *ConsolidateSilencesInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ConsolidateSilencesInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /silences/consolidate][%d] consolidateSilencesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ConsolidateSilencesInternalServerError) String() string {
+	return fmt.Sprintf("[POST /silences/consolidate][%d] consolidateSilencesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ConsolidateSilencesInternalServerError) GetPayload() string {
+	return o.Payload
+}
+
+func (o *ConsolidateSilencesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+ConsolidateSilencesOKBody consolidate silences o k body
+swagger:model ConsolidateSilencesOKBody
+*/
+type ConsolidateSilencesOKBody struct {
+
+	// silence ID
+	SilenceID string `json:"silenceID,omitempty"`
+}
+
+// Validate validates this consolidate silences o k body
+func (o *ConsolidateSilencesOKBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this consolidate silences o k body based on context it is used
+func (o *ConsolidateSilencesOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ConsolidateSilencesOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ConsolidateSilencesOKBody) UnmarshalBinary(b []byte) error {
+	var res ConsolidateSilencesOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -41,6 +41,12 @@ func (o *DeleteSilenceReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return result, nil
+	case 403:
+		result := NewDeleteSilenceForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewDeleteSilenceNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -114,6 +120,72 @@ func (o *DeleteSilenceOK) readResponse(response runtime.ClientResponse, consumer
 	return nil
 }
 
+// NewDeleteSilenceForbidden creates a DeleteSilenceForbidden with default headers values
+func NewDeleteSilenceForbidden() *DeleteSilenceForbidden {
+	return &DeleteSilenceForbidden{}
+}
+
+/*
+DeleteSilenceForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type DeleteSilenceForbidden struct {
+	Payload string
+}
+
+// IsSuccess returns true when this delete silence forbidden response has a 2xx status code
+func (o *DeleteSilenceForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete silence forbidden response has a 3xx status code
+func (o *DeleteSilenceForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete silence forbidden response has a 4xx status code
+func (o *DeleteSilenceForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete silence forbidden response has a 5xx status code
+func (o *DeleteSilenceForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete silence forbidden response a status code equal to that given
+func (o *DeleteSilenceForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the delete silence forbidden response
+func (o *DeleteSilenceForbidden) Code() int {
+	return 403
+}
+
+func (o *DeleteSilenceForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /silence/{silenceID}][%d] deleteSilenceForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DeleteSilenceForbidden) String() string {
+	return fmt.Sprintf("[DELETE /silence/{silenceID}][%d] deleteSilenceForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DeleteSilenceForbidden) GetPayload() string {
+	return o.Payload
+}
+
+func (o *DeleteSilenceForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewDeleteSilenceNotFound creates a DeleteSilenceNotFound with default headers values
 func NewDeleteSilenceNotFound() *DeleteSilenceNotFound {
 	return &DeleteSilenceNotFound{}
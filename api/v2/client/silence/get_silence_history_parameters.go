@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetSilenceHistoryParams creates a new GetSilenceHistoryParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetSilenceHistoryParams() *GetSilenceHistoryParams {
+	return &GetSilenceHistoryParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetSilenceHistoryParamsWithTimeout creates a new GetSilenceHistoryParams object
+// with the ability to set a timeout on a request.
+func NewGetSilenceHistoryParamsWithTimeout(timeout time.Duration) *GetSilenceHistoryParams {
+	return &GetSilenceHistoryParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetSilenceHistoryParamsWithContext creates a new GetSilenceHistoryParams object
+// with the ability to set a context for a request.
+func NewGetSilenceHistoryParamsWithContext(ctx context.Context) *GetSilenceHistoryParams {
+	return &GetSilenceHistoryParams{
+		Context: ctx,
+	}
+}
+
+// NewGetSilenceHistoryParamsWithHTTPClient creates a new GetSilenceHistoryParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetSilenceHistoryParamsWithHTTPClient(client *http.Client) *GetSilenceHistoryParams {
+	return &GetSilenceHistoryParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetSilenceHistoryParams contains all the parameters to send to the API endpoint
+
+	for the get silence history operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetSilenceHistoryParams struct {
+
+	/* SilenceID.
+
+	   ID of the silence to get the history of
+
+	   Format: uuid
+	*/
+	SilenceID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get silence history params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetSilenceHistoryParams) WithDefaults() *GetSilenceHistoryParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get silence history params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetSilenceHistoryParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get silence history params
+func (o *GetSilenceHistoryParams) WithTimeout(timeout time.Duration) *GetSilenceHistoryParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get silence history params
+func (o *GetSilenceHistoryParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get silence history params
+func (o *GetSilenceHistoryParams) WithContext(ctx context.Context) *GetSilenceHistoryParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get silence history params
+func (o *GetSilenceHistoryParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get silence history params
+func (o *GetSilenceHistoryParams) WithHTTPClient(client *http.Client) *GetSilenceHistoryParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get silence history params
+func (o *GetSilenceHistoryParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithSilenceID adds the silenceID to the get silence history params
+func (o *GetSilenceHistoryParams) WithSilenceID(silenceID strfmt.UUID) *GetSilenceHistoryParams {
+	o.SetSilenceID(silenceID)
+	return o
+}
+
+// SetSilenceID adds the silenceId to the get silence history params
+func (o *GetSilenceHistoryParams) SetSilenceID(silenceID strfmt.UUID) {
+	o.SilenceID = silenceID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetSilenceHistoryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param silenceID
+	if err := r.SetPathParam("silenceID", o.SilenceID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
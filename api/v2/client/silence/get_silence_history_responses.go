@@ -0,0 +1,249 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// GetSilenceHistoryReader is a Reader for the GetSilenceHistory structure.
+type GetSilenceHistoryReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetSilenceHistoryReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetSilenceHistoryOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetSilenceHistoryNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetSilenceHistoryInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /silence/{silenceID}/history] getSilenceHistory", response, response.Code())
+	}
+}
+
+// NewGetSilenceHistoryOK creates a GetSilenceHistoryOK with default headers values
+func NewGetSilenceHistoryOK() *GetSilenceHistoryOK {
+	return &GetSilenceHistoryOK{}
+}
+
+/*
+GetSilenceHistoryOK describes a response with status code 200, with default header values.
+
+Get silence history response
+*/
+type GetSilenceHistoryOK struct {
+	Payload models.SilenceHistory
+}
+
+// IsSuccess returns true when this get silence history o k response has a 2xx status code
+func (o *GetSilenceHistoryOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get silence history o k response has a 3xx status code
+func (o *GetSilenceHistoryOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get silence history o k response has a 4xx status code
+func (o *GetSilenceHistoryOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get silence history o k response has a 5xx status code
+func (o *GetSilenceHistoryOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get silence history o k response a status code equal to that given
+func (o *GetSilenceHistoryOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get silence history o k response
+func (o *GetSilenceHistoryOK) Code() int {
+	return 200
+}
+
+func (o *GetSilenceHistoryOK) Error() string {
+	return fmt.Sprintf("[GET /silence/{silenceID}/history][%d] getSilenceHistoryOK  %+v", 200, o.Payload)
+}
+
+func (o *GetSilenceHistoryOK) String() string {
+	return fmt.Sprintf("[GET /silence/{silenceID}/history][%d] getSilenceHistoryOK  %+v", 200, o.Payload)
+}
+
+func (o *GetSilenceHistoryOK) GetPayload() models.SilenceHistory {
+	return o.Payload
+}
+
+func (o *GetSilenceHistoryOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetSilenceHistoryNotFound creates a GetSilenceHistoryNotFound with default headers values
+func NewGetSilenceHistoryNotFound() *GetSilenceHistoryNotFound {
+	return &GetSilenceHistoryNotFound{}
+}
+
+/*
+GetSilenceHistoryNotFound describes a response with status code 404, with default header values.
+
+A silence with the specified ID was not found
+*/
+type GetSilenceHistoryNotFound struct {
+}
+
+// IsSuccess returns true when this get silence history not found response has a 2xx status code
+func (o *GetSilenceHistoryNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get silence history not found response has a 3xx status code
+func (o *GetSilenceHistoryNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get silence history not found response has a 4xx status code
+func (o *GetSilenceHistoryNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get silence history not found response has a 5xx status code
+func (o *GetSilenceHistoryNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get silence history not found response a status code equal to that given
+func (o *GetSilenceHistoryNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the get silence history not found response
+func (o *GetSilenceHistoryNotFound) Code() int {
+	return 404
+}
+
+func (o *GetSilenceHistoryNotFound) Error() string {
+	return fmt.Sprintf("[GET /silence/{silenceID}/history][%d] getSilenceHistoryNotFound ", 404)
+}
+
+func (o *GetSilenceHistoryNotFound) String() string {
+	return fmt.Sprintf("[GET /silence/{silenceID}/history][%d] getSilenceHistoryNotFound ", 404)
+}
+
+func (o *GetSilenceHistoryNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetSilenceHistoryInternalServerError creates a GetSilenceHistoryInternalServerError with default headers values
+func NewGetSilenceHistoryInternalServerError() *GetSilenceHistoryInternalServerError {
+	return &GetSilenceHistoryInternalServerError{}
+}
+
+/*
+GetSilenceHistoryInternalServerError describes a response with status code 500, with default header values.
+
+Internal server error
+*/
+type GetSilenceHistoryInternalServerError struct {
+	Payload string
+}
+
+// IsSuccess returns true when this get silence history internal server error response has a 2xx status code
+func (o *GetSilenceHistoryInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get silence history internal server error response has a 3xx status code
+func (o *GetSilenceHistoryInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get silence history internal server error response has a 4xx status code
+func (o *GetSilenceHistoryInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get silence history internal server error response has a 5xx status code
+func (o *GetSilenceHistoryInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get silence history internal server error response a status code equal to that given
+func (o *GetSilenceHistoryInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the get silence history internal server error response
+func (o *GetSilenceHistoryInternalServerError) Code() int {
+	return 500
+}
+
+func (o *GetSilenceHistoryInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /silence/{silenceID}/history][%d] getSilenceHistoryInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetSilenceHistoryInternalServerError) String() string {
+	return fmt.Sprintf("[GET /silence/{silenceID}/history][%d] getSilenceHistoryInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetSilenceHistoryInternalServerError) GetPayload() string {
+	return o.Payload
+}
+
+func (o *GetSilenceHistoryInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetSilenceOverlapsParams creates a new GetSilenceOverlapsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetSilenceOverlapsParams() *GetSilenceOverlapsParams {
+	return &GetSilenceOverlapsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetSilenceOverlapsParamsWithTimeout creates a new GetSilenceOverlapsParams object
+// with the ability to set a timeout on a request.
+func NewGetSilenceOverlapsParamsWithTimeout(timeout time.Duration) *GetSilenceOverlapsParams {
+	return &GetSilenceOverlapsParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetSilenceOverlapsParamsWithContext creates a new GetSilenceOverlapsParams object
+// with the ability to set a context for a request.
+func NewGetSilenceOverlapsParamsWithContext(ctx context.Context) *GetSilenceOverlapsParams {
+	return &GetSilenceOverlapsParams{
+		Context: ctx,
+	}
+}
+
+// NewGetSilenceOverlapsParamsWithHTTPClient creates a new GetSilenceOverlapsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetSilenceOverlapsParamsWithHTTPClient(client *http.Client) *GetSilenceOverlapsParams {
+	return &GetSilenceOverlapsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetSilenceOverlapsParams contains all the parameters to send to the API endpoint
+
+	for the get silence overlaps operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetSilenceOverlapsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get silence overlaps params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetSilenceOverlapsParams) WithDefaults() *GetSilenceOverlapsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get silence overlaps params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetSilenceOverlapsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get silence overlaps params
+func (o *GetSilenceOverlapsParams) WithTimeout(timeout time.Duration) *GetSilenceOverlapsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get silence overlaps params
+func (o *GetSilenceOverlapsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get silence overlaps params
+func (o *GetSilenceOverlapsParams) WithContext(ctx context.Context) *GetSilenceOverlapsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get silence overlaps params
+func (o *GetSilenceOverlapsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get silence overlaps params
+func (o *GetSilenceOverlapsParams) WithHTTPClient(client *http.Client) *GetSilenceOverlapsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get silence overlaps params
+func (o *GetSilenceOverlapsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetSilenceOverlapsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
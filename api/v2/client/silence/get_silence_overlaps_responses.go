@@ -0,0 +1,187 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// GetSilenceOverlapsReader is a Reader for the GetSilenceOverlaps structure.
+type GetSilenceOverlapsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetSilenceOverlapsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetSilenceOverlapsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 500:
+		result := NewGetSilenceOverlapsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /silences/overlaps] getSilenceOverlaps", response, response.Code())
+	}
+}
+
+// NewGetSilenceOverlapsOK creates a GetSilenceOverlapsOK with default headers values
+func NewGetSilenceOverlapsOK() *GetSilenceOverlapsOK {
+	return &GetSilenceOverlapsOK{}
+}
+
+/*
+GetSilenceOverlapsOK describes a response with status code 200, with default header values.
+
+Get silence overlaps response
+*/
+type GetSilenceOverlapsOK struct {
+	Payload models.SilenceOverlaps
+}
+
+// IsSuccess returns true when this get silence overlaps o k response has a 2xx status code
+func (o *GetSilenceOverlapsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get silence overlaps o k response has a 3xx status code
+func (o *GetSilenceOverlapsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get silence overlaps o k response has a 4xx status code
+func (o *GetSilenceOverlapsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get silence overlaps o k response has a 5xx status code
+func (o *GetSilenceOverlapsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get silence overlaps o k response a status code equal to that given
+func (o *GetSilenceOverlapsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get silence overlaps o k response
+func (o *GetSilenceOverlapsOK) Code() int {
+	return 200
+}
+
+func (o *GetSilenceOverlapsOK) Error() string {
+	return fmt.Sprintf("[GET /silences/overlaps][%d] getSilenceOverlapsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetSilenceOverlapsOK) String() string {
+	return fmt.Sprintf("[GET /silences/overlaps][%d] getSilenceOverlapsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetSilenceOverlapsOK) GetPayload() models.SilenceOverlaps {
+	return o.Payload
+}
+
+func (o *GetSilenceOverlapsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetSilenceOverlapsInternalServerError creates a GetSilenceOverlapsInternalServerError with default headers values
+func NewGetSilenceOverlapsInternalServerError() *GetSilenceOverlapsInternalServerError {
+	return &GetSilenceOverlapsInternalServerError{}
+}
+
+/*
+GetSilenceOverlapsInternalServerError describes a response with status code 500, with default header values.
+
+Internal server error
+*/
+type GetSilenceOverlapsInternalServerError struct {
+	Payload string
+}
+
+// IsSuccess returns true when this get silence overlaps internal server error response has a 2xx status code
+func (o *GetSilenceOverlapsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get silence overlaps internal server error response has a 3xx status code
+func (o *GetSilenceOverlapsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get silence overlaps internal server error response has a 4xx status code
+func (o *GetSilenceOverlapsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get silence overlaps internal server error response has a 5xx status code
+func (o *GetSilenceOverlapsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get silence overlaps internal server error response a status code equal to that given
+func (o *GetSilenceOverlapsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the get silence overlaps internal server error response
+func (o *GetSilenceOverlapsInternalServerError) Code() int {
+	return 500
+}
+
+func (o *GetSilenceOverlapsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /silences/overlaps][%d] getSilenceOverlapsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetSilenceOverlapsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /silences/overlaps][%d] getSilenceOverlapsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetSilenceOverlapsInternalServerError) GetPayload() string {
+	return o.Payload
+}
+
+func (o *GetSilenceOverlapsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -76,12 +76,41 @@ GetSilencesParams contains all the parameters to send to the API endpoint
 */
 type GetSilencesParams struct {
 
+	/* Cursor.
+
+	   The cursor returned in the X-Next-Cursor header of the previous page
+	*/
+	Cursor *string
+
+	/* Fields.
+
+	     The fields to return for each item, as dot-separated paths such as
+	labels or status.state. All fields are returned by default.
+
+	*/
+	Fields []string
+
 	/* Filter.
 
 	   A list of matchers to filter silences by
 	*/
 	Filter []string
 
+	/* Limit.
+
+	   The maximum number of items to return
+	*/
+	Limit *int64
+
+	/* Sort.
+
+	     The key to sort silences by, one of startsAt, endsAt and id, prefixed
+	with - to sort in descending order. By default silences are sorted by
+	state and by the time they start or end.
+
+	*/
+	Sort *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -135,6 +164,28 @@ func (o *GetSilencesParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithCursor adds the cursor to the get silences params
+func (o *GetSilencesParams) WithCursor(cursor *string) *GetSilencesParams {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the get silences params
+func (o *GetSilencesParams) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
+// WithFields adds the fields to the get silences params
+func (o *GetSilencesParams) WithFields(fields []string) *GetSilencesParams {
+	o.SetFields(fields)
+	return o
+}
+
+// SetFields adds the fields to the get silences params
+func (o *GetSilencesParams) SetFields(fields []string) {
+	o.Fields = fields
+}
+
 // WithFilter adds the filter to the get silences params
 func (o *GetSilencesParams) WithFilter(filter []string) *GetSilencesParams {
 	o.SetFilter(filter)
@@ -146,6 +197,28 @@ func (o *GetSilencesParams) SetFilter(filter []string) {
 	o.Filter = filter
 }
 
+// WithLimit adds the limit to the get silences params
+func (o *GetSilencesParams) WithLimit(limit *int64) *GetSilencesParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the get silences params
+func (o *GetSilencesParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithSort adds the sort to the get silences params
+func (o *GetSilencesParams) WithSort(sort *string) *GetSilencesParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the get silences params
+func (o *GetSilencesParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetSilencesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -154,6 +227,34 @@ func (o *GetSilencesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 	}
 	var res []error
 
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Fields != nil {
+
+		// binding items for fields
+		joinedFields := o.bindParamFields(reg)
+
+		// query array param fields
+		if err := r.SetQueryParam("fields", joinedFields...); err != nil {
+			return err
+		}
+	}
+
 	if o.Filter != nil {
 
 		// binding items for filter
@@ -165,12 +266,63 @@ func (o *GetSilencesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 		}
 	}
 
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindParamGetSilences binds the parameter fields
+func (o *GetSilencesParams) bindParamFields(formats strfmt.Registry) []string {
+	fieldsIR := o.Fields
+
+	var fieldsIC []string
+	for _, fieldsIIR := range fieldsIR { // explode []string
+
+		fieldsIIV := fieldsIIR // string as string
+		fieldsIC = append(fieldsIC, fieldsIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	fieldsIS := swag.JoinByFormat(fieldsIC, "multi")
+
+	return fieldsIS
+}
+
 // bindParamGetSilences binds the parameter filter
 func (o *GetSilencesParams) bindParamFilter(formats strfmt.Registry) []string {
 	filterIR := o.Filter
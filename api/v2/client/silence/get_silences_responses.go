@@ -71,6 +71,11 @@ GetSilencesOK describes a response with status code 200, with default header val
 Get silences response
 */
 type GetSilencesOK struct {
+
+	/* The cursor of the next page, if there are more items
+	 */
+	XNextCursor string
+
 	Payload models.GettableSilences
 }
 
@@ -118,6 +123,13 @@ func (o *GetSilencesOK) GetPayload() models.GettableSilences {
 
 func (o *GetSilencesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Next-Cursor
+	hdrXNextCursor := response.GetHeader("X-Next-Cursor")
+
+	if hdrXNextCursor != "" {
+		o.XNextCursor = hdrXNextCursor
+	}
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
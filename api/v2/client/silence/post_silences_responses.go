@@ -49,6 +49,12 @@ func (o *PostSilencesReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return nil, result
+	case 403:
+		result := NewPostSilencesForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewPostSilencesNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -194,6 +200,72 @@ func (o *PostSilencesBadRequest) readResponse(response runtime.ClientResponse, c
 	return nil
 }
 
+// NewPostSilencesForbidden creates a PostSilencesForbidden with default headers values
+func NewPostSilencesForbidden() *PostSilencesForbidden {
+	return &PostSilencesForbidden{}
+}
+
+/*
+PostSilencesForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type PostSilencesForbidden struct {
+	Payload string
+}
+
+// IsSuccess returns true when this post silences forbidden response has a 2xx status code
+func (o *PostSilencesForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post silences forbidden response has a 3xx status code
+func (o *PostSilencesForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post silences forbidden response has a 4xx status code
+func (o *PostSilencesForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post silences forbidden response has a 5xx status code
+func (o *PostSilencesForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post silences forbidden response a status code equal to that given
+func (o *PostSilencesForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the post silences forbidden response
+func (o *PostSilencesForbidden) Code() int {
+	return 403
+}
+
+func (o *PostSilencesForbidden) Error() string {
+	return fmt.Sprintf("[POST /silences][%d] postSilencesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *PostSilencesForbidden) String() string {
+	return fmt.Sprintf("[POST /silences][%d] postSilencesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *PostSilencesForbidden) GetPayload() string {
+	return o.Payload
+}
+
+func (o *PostSilencesForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewPostSilencesNotFound creates a PostSilencesNotFound with default headers values
 func NewPostSilencesNotFound() *PostSilencesNotFound {
 	return &PostSilencesNotFound{}
@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// NewPreviewSilenceParams creates a new PreviewSilenceParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPreviewSilenceParams() *PreviewSilenceParams {
+	return &PreviewSilenceParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPreviewSilenceParamsWithTimeout creates a new PreviewSilenceParams object
+// with the ability to set a timeout on a request.
+func NewPreviewSilenceParamsWithTimeout(timeout time.Duration) *PreviewSilenceParams {
+	return &PreviewSilenceParams{
+		timeout: timeout,
+	}
+}
+
+// NewPreviewSilenceParamsWithContext creates a new PreviewSilenceParams object
+// with the ability to set a context for a request.
+func NewPreviewSilenceParamsWithContext(ctx context.Context) *PreviewSilenceParams {
+	return &PreviewSilenceParams{
+		Context: ctx,
+	}
+}
+
+// NewPreviewSilenceParamsWithHTTPClient creates a new PreviewSilenceParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPreviewSilenceParamsWithHTTPClient(client *http.Client) *PreviewSilenceParams {
+	return &PreviewSilenceParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PreviewSilenceParams contains all the parameters to send to the API endpoint
+
+	for the preview silence operation.
+
+	Typically these are written to a http.Request.
+*/
+type PreviewSilenceParams struct {
+
+	/* Silence.
+
+	   The silence to preview
+	*/
+	Silence *models.PostableSilence
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the preview silence params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PreviewSilenceParams) WithDefaults() *PreviewSilenceParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the preview silence params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PreviewSilenceParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the preview silence params
+func (o *PreviewSilenceParams) WithTimeout(timeout time.Duration) *PreviewSilenceParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the preview silence params
+func (o *PreviewSilenceParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the preview silence params
+func (o *PreviewSilenceParams) WithContext(ctx context.Context) *PreviewSilenceParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the preview silence params
+func (o *PreviewSilenceParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the preview silence params
+func (o *PreviewSilenceParams) WithHTTPClient(client *http.Client) *PreviewSilenceParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the preview silence params
+func (o *PreviewSilenceParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithSilence adds the silence to the preview silence params
+func (o *PreviewSilenceParams) WithSilence(silence *models.PostableSilence) *PreviewSilenceParams {
+	o.SetSilence(silence)
+	return o
+}
+
+// SetSilence adds the silence to the preview silence params
+func (o *PreviewSilenceParams) SetSilence(silence *models.PostableSilence) {
+	o.Silence = silence
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PreviewSilenceParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Silence != nil {
+		if err := r.SetBodyParam(o.Silence); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,333 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// PreviewSilenceReader is a Reader for the PreviewSilence structure.
+type PreviewSilenceReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PreviewSilenceReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPreviewSilenceOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPreviewSilenceBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPreviewSilenceNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewPreviewSilenceInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /silences/preview] previewSilence", response, response.Code())
+	}
+}
+
+// NewPreviewSilenceOK creates a PreviewSilenceOK with default headers values
+func NewPreviewSilenceOK() *PreviewSilenceOK {
+	return &PreviewSilenceOK{}
+}
+
+/*
+PreviewSilenceOK describes a response with status code 200, with default header values.
+
+Preview silence response
+*/
+type PreviewSilenceOK struct {
+	Payload *models.SilencePreview
+}
+
+// IsSuccess returns true when this preview silence o k response has a 2xx status code
+func (o *PreviewSilenceOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this preview silence o k response has a 3xx status code
+func (o *PreviewSilenceOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this preview silence o k response has a 4xx status code
+func (o *PreviewSilenceOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this preview silence o k response has a 5xx status code
+func (o *PreviewSilenceOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this preview silence o k response a status code equal to that given
+func (o *PreviewSilenceOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the preview silence o k response
+func (o *PreviewSilenceOK) Code() int {
+	return 200
+}
+
+func (o *PreviewSilenceOK) Error() string {
+	return fmt.Sprintf("[POST /silences/preview][%d] previewSilenceOK  %+v", 200, o.Payload)
+}
+
+func (o *PreviewSilenceOK) String() string {
+	return fmt.Sprintf("[POST /silences/preview][%d] previewSilenceOK  %+v", 200, o.Payload)
+}
+
+func (o *PreviewSilenceOK) GetPayload() *models.SilencePreview {
+	return o.Payload
+}
+
+func (o *PreviewSilenceOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.SilencePreview)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPreviewSilenceBadRequest creates a PreviewSilenceBadRequest with default headers values
+func NewPreviewSilenceBadRequest() *PreviewSilenceBadRequest {
+	return &PreviewSilenceBadRequest{}
+}
+
+/*
+PreviewSilenceBadRequest describes a response with status code 400, with default header values.
+
+Bad request
+*/
+type PreviewSilenceBadRequest struct {
+	Payload string
+}
+
+// IsSuccess returns true when this preview silence bad request response has a 2xx status code
+func (o *PreviewSilenceBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this preview silence bad request response has a 3xx status code
+func (o *PreviewSilenceBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this preview silence bad request response has a 4xx status code
+func (o *PreviewSilenceBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this preview silence bad request response has a 5xx status code
+func (o *PreviewSilenceBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this preview silence bad request response a status code equal to that given
+func (o *PreviewSilenceBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the preview silence bad request response
+func (o *PreviewSilenceBadRequest) Code() int {
+	return 400
+}
+
+func (o *PreviewSilenceBadRequest) Error() string {
+	return fmt.Sprintf("[POST /silences/preview][%d] previewSilenceBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PreviewSilenceBadRequest) String() string {
+	return fmt.Sprintf("[POST /silences/preview][%d] previewSilenceBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PreviewSilenceBadRequest) GetPayload() string {
+	return o.Payload
+}
+
+func (o *PreviewSilenceBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPreviewSilenceNotFound creates a PreviewSilenceNotFound with default headers values
+func NewPreviewSilenceNotFound() *PreviewSilenceNotFound {
+	return &PreviewSilenceNotFound{}
+}
+
+/*
+PreviewSilenceNotFound describes a response with status code 404, with default header values.
+
+A silence with the specified ID was not found
+*/
+type PreviewSilenceNotFound struct {
+	Payload string
+}
+
+// IsSuccess returns true when this preview silence not found response has a 2xx status code
+func (o *PreviewSilenceNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this preview silence not found response has a 3xx status code
+func (o *PreviewSilenceNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this preview silence not found response has a 4xx status code
+func (o *PreviewSilenceNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this preview silence not found response has a 5xx status code
+func (o *PreviewSilenceNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this preview silence not found response a status code equal to that given
+func (o *PreviewSilenceNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the preview silence not found response
+func (o *PreviewSilenceNotFound) Code() int {
+	return 404
+}
+
+func (o *PreviewSilenceNotFound) Error() string {
+	return fmt.Sprintf("[POST /silences/preview][%d] previewSilenceNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PreviewSilenceNotFound) String() string {
+	return fmt.Sprintf("[POST /silences/preview][%d] previewSilenceNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PreviewSilenceNotFound) GetPayload() string {
+	return o.Payload
+}
+
+func (o *PreviewSilenceNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPreviewSilenceInternalServerError creates a PreviewSilenceInternalServerError with default headers values
+func NewPreviewSilenceInternalServerError() *PreviewSilenceInternalServerError {
+	return &PreviewSilenceInternalServerError{}
+}
+
+/*
+PreviewSilenceInternalServerError describes a response with status code 500, with default header values.
+
+Internal server error
+*/
+type PreviewSilenceInternalServerError struct {
+	Payload string
+}
+
+// IsSuccess returns true when this preview silence internal server error response has a 2xx status code
+func (o *PreviewSilenceInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this preview silence internal server error response has a 3xx status code
+func (o *PreviewSilenceInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this preview silence internal server error response has a 4xx status code
+func (o *PreviewSilenceInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this preview silence internal server error response has a 5xx status code
+func (o *PreviewSilenceInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this preview silence internal server error response a status code equal to that given
+func (o *PreviewSilenceInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the preview silence internal server error response
+func (o *PreviewSilenceInternalServerError) Code() int {
+	return 500
+}
+
+func (o *PreviewSilenceInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /silences/preview][%d] previewSilenceInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *PreviewSilenceInternalServerError) String() string {
+	return fmt.Sprintf("[POST /silences/preview][%d] previewSilenceInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *PreviewSilenceInternalServerError) GetPayload() string {
+	return o.Payload
+}
+
+func (o *PreviewSilenceInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// NewRejectSilenceParams creates a new RejectSilenceParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRejectSilenceParams() *RejectSilenceParams {
+	return &RejectSilenceParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRejectSilenceParamsWithTimeout creates a new RejectSilenceParams object
+// with the ability to set a timeout on a request.
+func NewRejectSilenceParamsWithTimeout(timeout time.Duration) *RejectSilenceParams {
+	return &RejectSilenceParams{
+		timeout: timeout,
+	}
+}
+
+// NewRejectSilenceParamsWithContext creates a new RejectSilenceParams object
+// with the ability to set a context for a request.
+func NewRejectSilenceParamsWithContext(ctx context.Context) *RejectSilenceParams {
+	return &RejectSilenceParams{
+		Context: ctx,
+	}
+}
+
+// NewRejectSilenceParamsWithHTTPClient creates a new RejectSilenceParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRejectSilenceParamsWithHTTPClient(client *http.Client) *RejectSilenceParams {
+	return &RejectSilenceParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RejectSilenceParams contains all the parameters to send to the API endpoint
+
+	for the reject silence operation.
+
+	Typically these are written to a http.Request.
+*/
+type RejectSilenceParams struct {
+
+	/* Decision.
+
+	   The rejection decision
+	*/
+	Decision *models.SilenceApprovalDecision
+
+	/* SilenceID.
+
+	   ID of the silence to reject
+
+	   Format: uuid
+	*/
+	SilenceID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the reject silence params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RejectSilenceParams) WithDefaults() *RejectSilenceParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the reject silence params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RejectSilenceParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the reject silence params
+func (o *RejectSilenceParams) WithTimeout(timeout time.Duration) *RejectSilenceParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reject silence params
+func (o *RejectSilenceParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reject silence params
+func (o *RejectSilenceParams) WithContext(ctx context.Context) *RejectSilenceParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reject silence params
+func (o *RejectSilenceParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reject silence params
+func (o *RejectSilenceParams) WithHTTPClient(client *http.Client) *RejectSilenceParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reject silence params
+func (o *RejectSilenceParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDecision adds the decision to the reject silence params
+func (o *RejectSilenceParams) WithDecision(decision *models.SilenceApprovalDecision) *RejectSilenceParams {
+	o.SetDecision(decision)
+	return o
+}
+
+// SetDecision adds the decision to the reject silence params
+func (o *RejectSilenceParams) SetDecision(decision *models.SilenceApprovalDecision) {
+	o.Decision = decision
+}
+
+// WithSilenceID adds the silenceID to the reject silence params
+func (o *RejectSilenceParams) WithSilenceID(silenceID strfmt.UUID) *RejectSilenceParams {
+	o.SetSilenceID(silenceID)
+	return o
+}
+
+// SetSilenceID adds the silenceId to the reject silence params
+func (o *RejectSilenceParams) SetSilenceID(silenceID strfmt.UUID) {
+	o.SilenceID = silenceID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RejectSilenceParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Decision != nil {
+		if err := r.SetBodyParam(o.Decision); err != nil {
+			return err
+		}
+	}
+
+	// path param silenceID
+	if err := r.SetPathParam("silenceID", o.SilenceID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
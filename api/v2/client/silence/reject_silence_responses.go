@@ -0,0 +1,381 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// RejectSilenceReader is a Reader for the RejectSilence structure.
+type RejectSilenceReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RejectSilenceReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRejectSilenceOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewRejectSilenceBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewRejectSilenceForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewRejectSilenceNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewRejectSilenceInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /silence/{silenceID}/reject] rejectSilence", response, response.Code())
+	}
+}
+
+// NewRejectSilenceOK creates a RejectSilenceOK with default headers values
+func NewRejectSilenceOK() *RejectSilenceOK {
+	return &RejectSilenceOK{}
+}
+
+/*
+RejectSilenceOK describes a response with status code 200, with default header values.
+
+Reject silence response
+*/
+type RejectSilenceOK struct {
+}
+
+// IsSuccess returns true when this reject silence o k response has a 2xx status code
+func (o *RejectSilenceOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reject silence o k response has a 3xx status code
+func (o *RejectSilenceOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reject silence o k response has a 4xx status code
+func (o *RejectSilenceOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reject silence o k response has a 5xx status code
+func (o *RejectSilenceOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reject silence o k response a status code equal to that given
+func (o *RejectSilenceOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the reject silence o k response
+func (o *RejectSilenceOK) Code() int {
+	return 200
+}
+
+func (o *RejectSilenceOK) Error() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/reject][%d] rejectSilenceOK ", 200)
+}
+
+func (o *RejectSilenceOK) String() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/reject][%d] rejectSilenceOK ", 200)
+}
+
+func (o *RejectSilenceOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRejectSilenceBadRequest creates a RejectSilenceBadRequest with default headers values
+func NewRejectSilenceBadRequest() *RejectSilenceBadRequest {
+	return &RejectSilenceBadRequest{}
+}
+
+/*
+RejectSilenceBadRequest describes a response with status code 400, with default header values.
+
+Bad request
+*/
+type RejectSilenceBadRequest struct {
+	Payload string
+}
+
+// IsSuccess returns true when this reject silence bad request response has a 2xx status code
+func (o *RejectSilenceBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reject silence bad request response has a 3xx status code
+func (o *RejectSilenceBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reject silence bad request response has a 4xx status code
+func (o *RejectSilenceBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reject silence bad request response has a 5xx status code
+func (o *RejectSilenceBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reject silence bad request response a status code equal to that given
+func (o *RejectSilenceBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the reject silence bad request response
+func (o *RejectSilenceBadRequest) Code() int {
+	return 400
+}
+
+func (o *RejectSilenceBadRequest) Error() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/reject][%d] rejectSilenceBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RejectSilenceBadRequest) String() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/reject][%d] rejectSilenceBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RejectSilenceBadRequest) GetPayload() string {
+	return o.Payload
+}
+
+func (o *RejectSilenceBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRejectSilenceForbidden creates a RejectSilenceForbidden with default headers values
+func NewRejectSilenceForbidden() *RejectSilenceForbidden {
+	return &RejectSilenceForbidden{}
+}
+
+/*
+RejectSilenceForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type RejectSilenceForbidden struct {
+	Payload string
+}
+
+// IsSuccess returns true when this reject silence forbidden response has a 2xx status code
+func (o *RejectSilenceForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reject silence forbidden response has a 3xx status code
+func (o *RejectSilenceForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reject silence forbidden response has a 4xx status code
+func (o *RejectSilenceForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reject silence forbidden response has a 5xx status code
+func (o *RejectSilenceForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reject silence forbidden response a status code equal to that given
+func (o *RejectSilenceForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the reject silence forbidden response
+func (o *RejectSilenceForbidden) Code() int {
+	return 403
+}
+
+func (o *RejectSilenceForbidden) Error() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/reject][%d] rejectSilenceForbidden  %+v", 403, o.Payload)
+}
+
+func (o *RejectSilenceForbidden) String() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/reject][%d] rejectSilenceForbidden  %+v", 403, o.Payload)
+}
+
+func (o *RejectSilenceForbidden) GetPayload() string {
+	return o.Payload
+}
+
+func (o *RejectSilenceForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRejectSilenceNotFound creates a RejectSilenceNotFound with default headers values
+func NewRejectSilenceNotFound() *RejectSilenceNotFound {
+	return &RejectSilenceNotFound{}
+}
+
+/*
+RejectSilenceNotFound describes a response with status code 404, with default header values.
+
+A silence with the specified ID was not found
+*/
+type RejectSilenceNotFound struct {
+}
+
+// IsSuccess returns true when this reject silence not found response has a 2xx status code
+func (o *RejectSilenceNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reject silence not found response has a 3xx status code
+func (o *RejectSilenceNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reject silence not found response has a 4xx status code
+func (o *RejectSilenceNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reject silence not found response has a 5xx status code
+func (o *RejectSilenceNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reject silence not found response a status code equal to that given
+func (o *RejectSilenceNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the reject silence not found response
+func (o *RejectSilenceNotFound) Code() int {
+	return 404
+}
+
+func (o *RejectSilenceNotFound) Error() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/reject][%d] rejectSilenceNotFound ", 404)
+}
+
+func (o *RejectSilenceNotFound) String() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/reject][%d] rejectSilenceNotFound ", 404)
+}
+
+func (o *RejectSilenceNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRejectSilenceInternalServerError creates a RejectSilenceInternalServerError with default headers values
+func NewRejectSilenceInternalServerError() *RejectSilenceInternalServerError {
+	return &RejectSilenceInternalServerError{}
+}
+
+/*
+RejectSilenceInternalServerError describes a response with status code 500, with default header values.
+
+Internal server error
+*/
+type RejectSilenceInternalServerError struct {
+	Payload string
+}
+
+// IsSuccess returns true when this reject silence internal server error response has a 2xx status code
+func (o *RejectSilenceInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reject silence internal server error response has a 3xx status code
+func (o *RejectSilenceInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reject silence internal server error response has a 4xx status code
+func (o *RejectSilenceInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reject silence internal server error response has a 5xx status code
+func (o *RejectSilenceInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reject silence internal server error response a status code equal to that given
+func (o *RejectSilenceInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the reject silence internal server error response
+func (o *RejectSilenceInternalServerError) Code() int {
+	return 500
+}
+
+func (o *RejectSilenceInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/reject][%d] rejectSilenceInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RejectSilenceInternalServerError) String() string {
+	return fmt.Sprintf("[POST /silence/{silenceID}/reject][%d] rejectSilenceInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RejectSilenceInternalServerError) GetPayload() string {
+	return o.Payload
+}
+
+func (o *RejectSilenceInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
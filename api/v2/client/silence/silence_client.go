@@ -44,17 +44,105 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	ApproveSilence(params *ApproveSilenceParams, opts ...ClientOption) (*ApproveSilenceOK, error)
+
+	ConsolidateSilences(params *ConsolidateSilencesParams, opts ...ClientOption) (*ConsolidateSilencesOK, error)
+
 	DeleteSilence(params *DeleteSilenceParams, opts ...ClientOption) (*DeleteSilenceOK, error)
 
 	GetSilence(params *GetSilenceParams, opts ...ClientOption) (*GetSilenceOK, error)
 
+	GetSilenceHistory(params *GetSilenceHistoryParams, opts ...ClientOption) (*GetSilenceHistoryOK, error)
+
+	GetSilenceOverlaps(params *GetSilenceOverlapsParams, opts ...ClientOption) (*GetSilenceOverlapsOK, error)
+
 	GetSilences(params *GetSilencesParams, opts ...ClientOption) (*GetSilencesOK, error)
 
 	PostSilences(params *PostSilencesParams, opts ...ClientOption) (*PostSilencesOK, error)
 
+	PreviewSilence(params *PreviewSilenceParams, opts ...ClientOption) (*PreviewSilenceOK, error)
+
+	RejectSilence(params *RejectSilenceParams, opts ...ClientOption) (*RejectSilenceOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+ApproveSilence Approve a silence pending approval
+*/
+func (a *Client) ApproveSilence(params *ApproveSilenceParams, opts ...ClientOption) (*ApproveSilenceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewApproveSilenceParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "approveSilence",
+		Method:             "POST",
+		PathPattern:        "/silence/{silenceID}/approve",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ApproveSilenceReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ApproveSilenceOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for approveSilence: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ConsolidateSilences Replace silences with a single silence muting all of their alerts
+*/
+func (a *Client) ConsolidateSilences(params *ConsolidateSilencesParams, opts ...ClientOption) (*ConsolidateSilencesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewConsolidateSilencesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "consolidateSilences",
+		Method:             "POST",
+		PathPattern:        "/silences/consolidate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ConsolidateSilencesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ConsolidateSilencesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for consolidateSilences: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 DeleteSilence Delete a silence by its ID
 */
@@ -131,6 +219,82 @@ func (a *Client) GetSilence(params *GetSilenceParams, opts ...ClientOption) (*Ge
 	panic(msg)
 }
 
+/*
+GetSilenceHistory Get the revisions of a silence, oldest first. The last revision is the current state of the silence.
+*/
+func (a *Client) GetSilenceHistory(params *GetSilenceHistoryParams, opts ...ClientOption) (*GetSilenceHistoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetSilenceHistoryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getSilenceHistory",
+		Method:             "GET",
+		PathPattern:        "/silence/{silenceID}/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetSilenceHistoryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetSilenceHistoryOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getSilenceHistory: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetSilenceOverlaps Get pairs of overlapping silences that have not expired, one of which mutes all alerts muted by the other
+*/
+func (a *Client) GetSilenceOverlaps(params *GetSilenceOverlapsParams, opts ...ClientOption) (*GetSilenceOverlapsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetSilenceOverlapsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getSilenceOverlaps",
+		Method:             "GET",
+		PathPattern:        "/silences/overlaps",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetSilenceOverlapsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetSilenceOverlapsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getSilenceOverlaps: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetSilences Get a list of silences
 */
@@ -207,6 +371,82 @@ func (a *Client) PostSilences(params *PostSilencesParams, opts ...ClientOption)
 	panic(msg)
 }
 
+/*
+PreviewSilence Preview the alerts a new or updated silence would affect without saving it
+*/
+func (a *Client) PreviewSilence(params *PreviewSilenceParams, opts ...ClientOption) (*PreviewSilenceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPreviewSilenceParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "previewSilence",
+		Method:             "POST",
+		PathPattern:        "/silences/preview",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PreviewSilenceReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PreviewSilenceOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for previewSilence: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+RejectSilence Reject a silence pending approval, expiring it
+*/
+func (a *Client) RejectSilence(params *RejectSilenceParams, opts ...ClientOption) (*RejectSilenceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRejectSilenceParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "rejectSilence",
+		Method:             "POST",
+		PathPattern:        "/silence/{silenceID}/reject",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RejectSilenceReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RejectSilenceOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for rejectSilence: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
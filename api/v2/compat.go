@@ -14,14 +14,21 @@
 package v2
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
 	prometheus_model "github.com/prometheus/common/model"
 
+	"github.com/prometheus/alertmanager/alerthistory/historypb"
 	open_api_models "github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/prometheus/alertmanager/silence"
 	"github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/timeinterval"
 	"github.com/prometheus/alertmanager/types"
 )
 
@@ -30,7 +37,11 @@ func GettableSilenceFromProto(s *silencepb.Silence) (open_api_models.GettableSil
 	start := strfmt.DateTime(s.StartsAt)
 	end := strfmt.DateTime(s.EndsAt)
 	updated := strfmt.DateTime(s.UpdatedAt)
-	state := string(types.CalcSilenceState(s.StartsAt, s.EndsAt))
+	state := types.CalcSilenceState(s.StartsAt, s.EndsAt)
+	if state != types.SilenceStateExpired && s.Approval != nil && s.Approval.State == silencepb.Approval_PENDING {
+		state = types.SilenceStatePendingApproval
+	}
+	stateStr := string(state)
 	sil := open_api_models.GettableSilence{
 		Silence: open_api_models.Silence{
 			StartsAt:  &start,
@@ -41,9 +52,12 @@ func GettableSilenceFromProto(s *silencepb.Silence) (open_api_models.GettableSil
 		ID:        &s.Id,
 		UpdatedAt: &updated,
 		Status: &open_api_models.SilenceStatus{
-			State: &state,
+			State: &stateStr,
 		},
 	}
+	if s.Approval != nil {
+		sil.Approval = silenceApprovalFromProto(s.Approval)
+	}
 
 	for _, m := range s.Matchers {
 		matcher := &open_api_models.Matcher{
@@ -75,9 +89,122 @@ func GettableSilenceFromProto(s *silencepb.Silence) (open_api_models.GettableSil
 		sil.Matchers = append(sil.Matchers, matcher)
 	}
 
+	tis, err := timeIntervalsFromProto(s.TimeIntervals)
+	if err != nil {
+		return sil, fmt.Errorf("invalid time intervals in silence '%v': %w", s.Id, err)
+	}
+	sil.TimeIntervals = tis
+
 	return sil, nil
 }
 
+// SilenceRevisionFromProto converts a revision of a silence to open_api_models.SilenceRevision.
+func SilenceRevisionFromProto(s *silencepb.Silence) (open_api_models.SilenceRevision, error) {
+	sil, err := GettableSilenceFromProto(s)
+	if err != nil {
+		return open_api_models.SilenceRevision{}, err
+	}
+	return open_api_models.SilenceRevision{
+		ID:        sil.ID,
+		UpdatedAt: sil.UpdatedAt,
+		Silence:   sil.Silence,
+	}, nil
+}
+
+// AlertHistoryToOpenAPI converts *historypb.AlertHistory to *open_api_models.AlertHistory.
+func AlertHistoryToOpenAPI(h *historypb.AlertHistory) *open_api_models.AlertHistory {
+	fp := prometheus_model.Fingerprint(h.Fingerprint).String()
+	labels := open_api_models.LabelSet{}
+	for _, l := range h.Labels {
+		labels[l.Name] = l.Value
+	}
+
+	events := make([]*open_api_models.AlertHistoryEvent, 0, len(h.Events))
+	for _, e := range h.Events {
+		typ := strings.ToLower(e.Type.String())
+		ts := strfmt.DateTime(e.Timestamp)
+		events = append(events, &open_api_models.AlertHistoryEvent{
+			Type:        &typ,
+			Timestamp:   &ts,
+			SilencedBy:  e.SilencedBy,
+			InhibitedBy: e.InhibitedBy,
+			Receiver:    e.Receiver,
+			Integration: e.Integration,
+			Resolved:    e.Resolved,
+		})
+	}
+
+	return &open_api_models.AlertHistory{
+		Fingerprint: &fp,
+		Labels:      labels,
+		Events:      events,
+	}
+}
+
+// ConfigVersionToOpenAPI converts *config.Version to *open_api_models.ConfigVersion.
+func ConfigVersionToOpenAPI(v *config.Version) *open_api_models.ConfigVersion {
+	id := int64(v.ID)
+	source := v.Source
+	appliedAt := strfmt.DateTime(v.AppliedAt)
+	cfg := v.Config.String()
+	return &open_api_models.ConfigVersion{
+		ID:        &id,
+		Source:    &source,
+		AppliedAt: &appliedAt,
+		Config:    &cfg,
+		Diff:      v.Diff,
+	}
+}
+
+// silenceApprovalFromProto converts *silencepb.Approval to *open_api_models.SilenceApproval.
+func silenceApprovalFromProto(a *silencepb.Approval) *open_api_models.SilenceApproval {
+	var state string
+	switch a.State {
+	case silencepb.Approval_PENDING:
+		state = open_api_models.SilenceApprovalStatePending
+	case silencepb.Approval_APPROVED:
+		state = open_api_models.SilenceApprovalStateApproved
+	case silencepb.Approval_REJECTED:
+		state = open_api_models.SilenceApprovalStateRejected
+	default:
+		return nil
+	}
+	approval := &open_api_models.SilenceApproval{
+		State:     &state,
+		DecidedBy: a.DecidedBy,
+		Comment:   a.Comment,
+	}
+	if !a.DecidedAt.IsZero() {
+		approval.DecidedAt = strfmt.DateTime(a.DecidedAt)
+	}
+	return approval
+}
+
+// silenceMatchers converts the matchers of a silence to labels.Matchers.
+// Invalid matchers are skipped as they are rejected when the silence is set.
+func silenceMatchers(s *silencepb.Silence) labels.Matchers {
+	ms := make(labels.Matchers, 0, len(s.Matchers))
+	for _, m := range s.Matchers {
+		var t labels.MatchType
+		switch m.Type {
+		case silencepb.Matcher_EQUAL:
+			t = labels.MatchEqual
+		case silencepb.Matcher_NOT_EQUAL:
+			t = labels.MatchNotEqual
+		case silencepb.Matcher_REGEXP:
+			t = labels.MatchRegexp
+		case silencepb.Matcher_NOT_REGEXP:
+			t = labels.MatchNotRegexp
+		}
+		matcher, err := labels.NewMatcher(t, m.Name, m.Pattern)
+		if err != nil {
+			continue
+		}
+		ms = append(ms, matcher)
+	}
+	return ms
+}
+
 // PostableSilenceToProto converts *open_api_models.PostableSilenc to *silencepb.Silence.
 func PostableSilenceToProto(s *open_api_models.PostableSilence) (*silencepb.Silence, error) {
 	sil := &silencepb.Silence{
@@ -113,9 +240,131 @@ func PostableSilenceToProto(s *open_api_models.PostableSilence) (*silencepb.Sile
 		}
 		sil.Matchers = append(sil.Matchers, matcher)
 	}
+
+	tis, err := timeIntervalsToProto(s.TimeIntervals)
+	if err != nil {
+		return nil, err
+	}
+	sil.TimeIntervals = tis
 	return sil, nil
 }
 
+// timeIntervalsToProto parses the time intervals of a silence the same way
+// time intervals are parsed from the configuration file.
+func timeIntervalsToProto(tis []*open_api_models.TimeInterval) ([]*silencepb.TimeInterval, error) {
+	if len(tis) == 0 {
+		return nil, nil
+	}
+	// The range types unmarshal from quoted strings.
+	unmarshal := func(s string, v json.Unmarshaler) error {
+		b, err := json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		return v.UnmarshalJSON(b)
+	}
+
+	res := make([]timeinterval.TimeInterval, 0, len(tis))
+	for i, ti := range tis {
+		if ti == nil {
+			return nil, fmt.Errorf("time interval %d is empty", i)
+		}
+		var t timeinterval.TimeInterval
+		for _, tr := range ti.Times {
+			if tr == nil || tr.StartTime == nil || tr.EndTime == nil {
+				return nil, fmt.Errorf("time interval %d: both start and end times must be provided", i)
+			}
+			b, err := json.Marshal(map[string]string{"start_time": *tr.StartTime, "end_time": *tr.EndTime})
+			if err != nil {
+				return nil, err
+			}
+			var r timeinterval.TimeRange
+			if err := r.UnmarshalJSON(b); err != nil {
+				return nil, fmt.Errorf("time interval %d: %w", i, err)
+			}
+			t.Times = append(t.Times, r)
+		}
+		for _, s := range ti.Weekdays {
+			var r timeinterval.WeekdayRange
+			if err := unmarshal(s, &r); err != nil {
+				return nil, fmt.Errorf("time interval %d: %w", i, err)
+			}
+			t.Weekdays = append(t.Weekdays, r)
+		}
+		for _, s := range ti.DaysOfMonth {
+			var r timeinterval.DayOfMonthRange
+			if err := unmarshal(s, &r); err != nil {
+				return nil, fmt.Errorf("time interval %d: %w", i, err)
+			}
+			t.DaysOfMonth = append(t.DaysOfMonth, r)
+		}
+		for _, s := range ti.Months {
+			var r timeinterval.MonthRange
+			if err := unmarshal(s, &r); err != nil {
+				return nil, fmt.Errorf("time interval %d: %w", i, err)
+			}
+			t.Months = append(t.Months, r)
+		}
+		for _, s := range ti.Years {
+			var r timeinterval.YearRange
+			if err := unmarshal(s, &r); err != nil {
+				return nil, fmt.Errorf("time interval %d: %w", i, err)
+			}
+			t.Years = append(t.Years, r)
+		}
+		if ti.Location != "" {
+			var l timeinterval.Location
+			if err := unmarshal(ti.Location, &l); err != nil {
+				return nil, fmt.Errorf("time interval %d: %w", i, err)
+			}
+			t.Location = &l
+		}
+		res = append(res, t)
+	}
+	return silence.TimeIntervalsToProto(res), nil
+}
+
+// timeIntervalsFromProto renders the time intervals of a silence in the
+// format accepted by timeIntervalsToProto.
+func timeIntervalsFromProto(ptis []*silencepb.TimeInterval) ([]*open_api_models.TimeInterval, error) {
+	if len(ptis) == 0 {
+		return nil, nil
+	}
+	inclusiveRanges := func(rs []*silencepb.TimeInterval_InclusiveRange) []string {
+		var res []string
+		for _, r := range rs {
+			b, _ := timeinterval.InclusiveRange{Begin: int(r.Begin), End: int(r.End)}.MarshalText()
+			res = append(res, string(b))
+		}
+		return res
+	}
+
+	res := make([]*open_api_models.TimeInterval, 0, len(ptis))
+	for _, pti := range ptis {
+		ti := &open_api_models.TimeInterval{
+			DaysOfMonth: inclusiveRanges(pti.DaysOfMonth),
+			Months:      inclusiveRanges(pti.Months),
+			Years:       inclusiveRanges(pti.Years),
+			Location:    pti.Location,
+		}
+		for _, tr := range pti.Times {
+			start := fmt.Sprintf("%02d:%02d", tr.StartMinute/60, tr.StartMinute%60)
+			end := fmt.Sprintf("%02d:%02d", tr.EndMinute/60, tr.EndMinute%60)
+			ti.Times = append(ti.Times, &open_api_models.TimeIntervalTimesItems0{StartTime: &start, EndTime: &end})
+		}
+		for _, r := range pti.Weekdays {
+			wr := timeinterval.WeekdayRange{InclusiveRange: timeinterval.InclusiveRange{Begin: int(r.Begin), End: int(r.End)}}
+			b, err := wr.MarshalText()
+			if err != nil {
+				return nil, err
+			}
+			ti.Weekdays = append(ti.Weekdays, string(b))
+		}
+		res = append(res, ti)
+	}
+	return res, nil
+}
+
 // AlertToOpenAPIAlert converts internal alerts, alert types, and receivers to *open_api_models.GettableAlert.
 func AlertToOpenAPIAlert(alert *types.Alert, status types.AlertStatus, receivers []string) *open_api_models.GettableAlert {
 	startsAt := strfmt.DateTime(alert.StartsAt)
@@ -147,6 +396,15 @@ func AlertToOpenAPIAlert(alert *types.Alert, status types.AlertStatus, receivers
 		},
 	}
 
+	if status.Acknowledgement != nil {
+		acknowledgedAt := strfmt.DateTime(status.Acknowledgement.AcknowledgedAt)
+		aa.Status.Acknowledgement = &open_api_models.Acknowledgement{
+			AcknowledgedBy: &status.Acknowledgement.AcknowledgedBy,
+			Comment:        status.Acknowledgement.Comment,
+			AcknowledgedAt: &acknowledgedAt,
+		}
+	}
+
 	if aa.Status.SilencedBy == nil {
 		aa.Status.SilencedBy = []string{}
 	}
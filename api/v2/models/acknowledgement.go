@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Acknowledgement acknowledgement
+//
+// swagger:model acknowledgement
+type Acknowledgement struct {
+
+	// acknowledged at
+	// Required: true
+	// Format: date-time
+	AcknowledgedAt *strfmt.DateTime `json:"acknowledgedAt"`
+
+	// acknowledged by
+	// Required: true
+	AcknowledgedBy *string `json:"acknowledgedBy"`
+
+	// comment
+	Comment string `json:"comment,omitempty"`
+}
+
+// Validate validates this acknowledgement
+func (m *Acknowledgement) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAcknowledgedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAcknowledgedBy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Acknowledgement) validateAcknowledgedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("acknowledgedAt", "body", m.AcknowledgedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("acknowledgedAt", "body", "date-time", m.AcknowledgedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Acknowledgement) validateAcknowledgedBy(formats strfmt.Registry) error {
+
+	if err := validate.Required("acknowledgedBy", "body", m.AcknowledgedBy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this acknowledgement based on the context it is used
+func (m *Acknowledgement) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Acknowledgement) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Acknowledgement) UnmarshalBinary(b []byte) error {
+	var res Acknowledgement
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AlertHistoryEvent alert history event
+//
+// swagger:model alertHistoryEvent
+type AlertHistoryEvent struct {
+
+	// The alerts inhibiting the alert, for inhibited events
+	InhibitedBy []string `json:"inhibitedBy"`
+
+	// The integration notified, for notified events
+	Integration string `json:"integration,omitempty"`
+
+	// The receiver notified, for notified events
+	Receiver string `json:"receiver,omitempty"`
+
+	// Whether the notification was about the resolution of the alert, for notified events
+	Resolved bool `json:"resolved,omitempty"`
+
+	// The silences muting the alert, for silenced events
+	SilencedBy []string `json:"silencedBy"`
+
+	// timestamp
+	// Required: true
+	// Format: date-time
+	Timestamp *strfmt.DateTime `json:"timestamp"`
+
+	// type
+	// Required: true
+	// Enum: [firing resolved silenced unsilenced inhibited uninhibited notified]
+	Type *string `json:"type"`
+}
+
+// Validate validates this alert history event
+func (m *AlertHistoryEvent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTimestamp(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AlertHistoryEvent) validateTimestamp(formats strfmt.Registry) error {
+
+	if err := validate.Required("timestamp", "body", m.Timestamp); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("timestamp", "body", "date-time", m.Timestamp.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var alertHistoryEventTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["firing","resolved","silenced","unsilenced","inhibited","uninhibited","notified"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		alertHistoryEventTypeTypePropEnum = append(alertHistoryEventTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// AlertHistoryEventTypeFiring captures enum value "firing"
+	AlertHistoryEventTypeFiring string = "firing"
+
+	// AlertHistoryEventTypeResolved captures enum value "resolved"
+	AlertHistoryEventTypeResolved string = "resolved"
+
+	// AlertHistoryEventTypeSilenced captures enum value "silenced"
+	AlertHistoryEventTypeSilenced string = "silenced"
+
+	// AlertHistoryEventTypeUnsilenced captures enum value "unsilenced"
+	AlertHistoryEventTypeUnsilenced string = "unsilenced"
+
+	// AlertHistoryEventTypeInhibited captures enum value "inhibited"
+	AlertHistoryEventTypeInhibited string = "inhibited"
+
+	// AlertHistoryEventTypeUninhibited captures enum value "uninhibited"
+	AlertHistoryEventTypeUninhibited string = "uninhibited"
+
+	// AlertHistoryEventTypeNotified captures enum value "notified"
+	AlertHistoryEventTypeNotified string = "notified"
+)
+
+// prop value enum
+func (m *AlertHistoryEvent) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, alertHistoryEventTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AlertHistoryEvent) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", *m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this alert history event based on context it is used
+func (m *AlertHistoryEvent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AlertHistoryEvent) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AlertHistoryEvent) UnmarshalBinary(b []byte) error {
+	var res AlertHistoryEvent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -34,6 +34,9 @@ import (
 // swagger:model alertStatus
 type AlertStatus struct {
 
+	// acknowledgement
+	Acknowledgement *Acknowledgement `json:"acknowledgement,omitempty"`
+
 	// inhibited by
 	// Required: true
 	InhibitedBy []string `json:"inhibitedBy"`
@@ -52,6 +55,10 @@ type AlertStatus struct {
 func (m *AlertStatus) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAcknowledgement(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateInhibitedBy(formats); err != nil {
 		res = append(res, err)
 	}
@@ -70,6 +77,25 @@ func (m *AlertStatus) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *AlertStatus) validateAcknowledgement(formats strfmt.Registry) error {
+	if swag.IsZero(m.Acknowledgement) { // not required
+		return nil
+	}
+
+	if m.Acknowledgement != nil {
+		if err := m.Acknowledgement.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("acknowledgement")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("acknowledgement")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *AlertStatus) validateInhibitedBy(formats strfmt.Registry) error {
 
 	if err := validate.Required("inhibitedBy", "body", m.InhibitedBy); err != nil {
@@ -134,8 +160,38 @@ func (m *AlertStatus) validateState(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this alert status based on context it is used
+// ContextValidate validate this alert status based on the context it is used
 func (m *AlertStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAcknowledgement(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AlertStatus) contextValidateAcknowledgement(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Acknowledgement != nil {
+
+		if swag.IsZero(m.Acknowledgement) { // not required
+			return nil
+		}
+
+		if err := m.Acknowledgement.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("acknowledgement")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("acknowledgement")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,183 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ConfigVersion config version
+//
+// swagger:model configVersion
+type ConfigVersion struct {
+
+	// applied at
+	// Required: true
+	// Format: date-time
+	AppliedAt *strfmt.DateTime `json:"appliedAt"`
+
+	// The configuration, with secrets hidden
+	// Required: true
+	Config *string `json:"config"`
+
+	// The unified diff of the configuration from the previous version
+	Diff string `json:"diff,omitempty"`
+
+	// id
+	// Required: true
+	ID *int64 `json:"id"`
+
+	// source
+	// Required: true
+	// Enum: [file api rollback]
+	Source *string `json:"source"`
+}
+
+// Validate validates this config version
+func (m *ConfigVersion) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAppliedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSource(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ConfigVersion) validateAppliedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("appliedAt", "body", m.AppliedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("appliedAt", "body", "date-time", m.AppliedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ConfigVersion) validateConfig(formats strfmt.Registry) error {
+
+	if err := validate.Required("config", "body", m.Config); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ConfigVersion) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var configVersionTypeSourcePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["file","api","rollback"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		configVersionTypeSourcePropEnum = append(configVersionTypeSourcePropEnum, v)
+	}
+}
+
+const (
+
+	// ConfigVersionSourceFile captures enum value "file"
+	ConfigVersionSourceFile string = "file"
+
+	// ConfigVersionSourceAPI captures enum value "api"
+	ConfigVersionSourceAPI string = "api"
+
+	// ConfigVersionSourceRollback captures enum value "rollback"
+	ConfigVersionSourceRollback string = "rollback"
+)
+
+// prop value enum
+func (m *ConfigVersion) validateSourceEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, configVersionTypeSourcePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ConfigVersion) validateSource(formats strfmt.Registry) error {
+
+	if err := validate.Required("source", "body", m.Source); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateSourceEnum("source", "body", *m.Source); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this config version based on context it is used
+func (m *ConfigVersion) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ConfigVersion) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ConfigVersion) UnmarshalBinary(b []byte) error {
+	var res ConfigVersion
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,88 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostableAcknowledgement postable acknowledgement
+//
+// swagger:model postableAcknowledgement
+type PostableAcknowledgement struct {
+
+	// acknowledged by
+	// Required: true
+	AcknowledgedBy *string `json:"acknowledgedBy"`
+
+	// comment
+	Comment string `json:"comment,omitempty"`
+}
+
+// Validate validates this postable acknowledgement
+func (m *PostableAcknowledgement) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAcknowledgedBy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostableAcknowledgement) validateAcknowledgedBy(formats strfmt.Registry) error {
+
+	if err := validate.Required("acknowledgedBy", "body", m.AcknowledgedBy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this postable acknowledgement based on context it is used
+func (m *PostableAcknowledgement) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostableAcknowledgement) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostableAcknowledgement) UnmarshalBinary(b []byte) error {
+	var res PostableAcknowledgement
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
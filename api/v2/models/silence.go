@@ -21,6 +21,7 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -33,6 +34,9 @@ import (
 // swagger:model silence
 type Silence struct {
 
+	// approval
+	Approval *SilenceApproval `json:"approval,omitempty"`
+
 	// comment
 	// Required: true
 	Comment *string `json:"comment"`
@@ -54,12 +58,19 @@ type Silence struct {
 	// Required: true
 	// Format: date-time
 	StartsAt *strfmt.DateTime `json:"startsAt"`
+
+	// time intervals
+	TimeIntervals []*TimeInterval `json:"timeIntervals"`
 }
 
 // Validate validates this silence
 func (m *Silence) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateApproval(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateComment(formats); err != nil {
 		res = append(res, err)
 	}
@@ -80,12 +91,35 @@ func (m *Silence) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTimeIntervals(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *Silence) validateApproval(formats strfmt.Registry) error {
+	if swag.IsZero(m.Approval) { // not required
+		return nil
+	}
+
+	if m.Approval != nil {
+		if err := m.Approval.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("approval")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("approval")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Silence) validateComment(formats strfmt.Registry) error {
 
 	if err := validate.Required("comment", "body", m.Comment); err != nil {
@@ -148,20 +182,75 @@ func (m *Silence) validateStartsAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Silence) validateTimeIntervals(formats strfmt.Registry) error {
+	if swag.IsZero(m.TimeIntervals) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.TimeIntervals); i++ {
+		if swag.IsZero(m.TimeIntervals[i]) { // not required
+			continue
+		}
+
+		if m.TimeIntervals[i] != nil {
+			if err := m.TimeIntervals[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("timeIntervals" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("timeIntervals" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this silence based on the context it is used
 func (m *Silence) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateApproval(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateMatchers(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateTimeIntervals(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *Silence) contextValidateApproval(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Approval != nil {
+
+		if swag.IsZero(m.Approval) { // not required
+			return nil
+		}
+
+		if err := m.Approval.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("approval")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("approval")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Silence) contextValidateMatchers(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.Matchers.ContextValidate(ctx, formats); err != nil {
@@ -176,6 +265,31 @@ func (m *Silence) contextValidateMatchers(ctx context.Context, formats strfmt.Re
 	return nil
 }
 
+func (m *Silence) contextValidateTimeIntervals(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.TimeIntervals); i++ {
+
+		if m.TimeIntervals[i] != nil {
+
+			if swag.IsZero(m.TimeIntervals[i]) { // not required
+				return nil
+			}
+
+			if err := m.TimeIntervals[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("timeIntervals" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("timeIntervals" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Silence) MarshalBinary() ([]byte, error) {
 	if m == nil {
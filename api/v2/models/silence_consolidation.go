@@ -0,0 +1,109 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SilenceConsolidation silence consolidation
+//
+// swagger:model silenceConsolidation
+type SilenceConsolidation struct {
+
+	// created by
+	// Required: true
+	CreatedBy *string `json:"createdBy"`
+
+	// silence i ds
+	// Required: true
+	// Min Items: 2
+	SilenceIDs []string `json:"silenceIDs"`
+}
+
+// Validate validates this silence consolidation
+func (m *SilenceConsolidation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedBy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSilenceIDs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SilenceConsolidation) validateCreatedBy(formats strfmt.Registry) error {
+
+	if err := validate.Required("createdBy", "body", m.CreatedBy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SilenceConsolidation) validateSilenceIDs(formats strfmt.Registry) error {
+
+	if err := validate.Required("silenceIDs", "body", m.SilenceIDs); err != nil {
+		return err
+	}
+
+	iSilenceIDsSize := int64(len(m.SilenceIDs))
+
+	if err := validate.MinItems("silenceIDs", "body", iSilenceIDsSize, 2); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this silence consolidation based on context it is used
+func (m *SilenceConsolidation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SilenceConsolidation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SilenceConsolidation) UnmarshalBinary(b []byte) error {
+	var res SilenceConsolidation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
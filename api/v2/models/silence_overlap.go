@@ -0,0 +1,155 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SilenceOverlap A pair of silences with overlapping time ranges, where the silence with ID silenceID mutes all alerts muted by the one with ID otherSilenceID
+//
+// swagger:model silenceOverlap
+type SilenceOverlap struct {
+
+	// kind
+	// Required: true
+	// Enum: [duplicate subsumes]
+	Kind *string `json:"kind"`
+
+	// other silence ID
+	// Required: true
+	OtherSilenceID *string `json:"otherSilenceID"`
+
+	// silence ID
+	// Required: true
+	SilenceID *string `json:"silenceID"`
+}
+
+// Validate validates this silence overlap
+func (m *SilenceOverlap) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOtherSilenceID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSilenceID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var silenceOverlapTypeKindPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["duplicate","subsumes"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		silenceOverlapTypeKindPropEnum = append(silenceOverlapTypeKindPropEnum, v)
+	}
+}
+
+const (
+
+	// SilenceOverlapKindDuplicate captures enum value "duplicate"
+	SilenceOverlapKindDuplicate string = "duplicate"
+
+	// SilenceOverlapKindSubsumes captures enum value "subsumes"
+	SilenceOverlapKindSubsumes string = "subsumes"
+)
+
+// prop value enum
+func (m *SilenceOverlap) validateKindEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, silenceOverlapTypeKindPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SilenceOverlap) validateKind(formats strfmt.Registry) error {
+
+	if err := validate.Required("kind", "body", m.Kind); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateKindEnum("kind", "body", *m.Kind); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SilenceOverlap) validateOtherSilenceID(formats strfmt.Registry) error {
+
+	if err := validate.Required("otherSilenceID", "body", m.OtherSilenceID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SilenceOverlap) validateSilenceID(formats strfmt.Registry) error {
+
+	if err := validate.Required("silenceID", "body", m.SilenceID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this silence overlap based on context it is used
+func (m *SilenceOverlap) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SilenceOverlap) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SilenceOverlap) UnmarshalBinary(b []byte) error {
+	var res SilenceOverlap
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,270 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SilencePreview silence preview
+//
+// swagger:model silencePreview
+type SilencePreview struct {
+
+	// The alerts the silence would mute
+	// Required: true
+	Alerts GettableAlerts `json:"alerts"`
+
+	// The aggregation groups containing alerts the silence would mute
+	// Required: true
+	Groups AlertGroups `json:"groups"`
+
+	// The receivers of the alerts the silence would mute
+	// Required: true
+	Receivers []*Receiver `json:"receivers"`
+
+	// The alerts muted by the silence being updated that it would no longer mute
+	// Required: true
+	UnmutedAlerts GettableAlerts `json:"unmutedAlerts"`
+}
+
+// Validate validates this silence preview
+func (m *SilencePreview) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAlerts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateGroups(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReceivers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUnmutedAlerts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SilencePreview) validateAlerts(formats strfmt.Registry) error {
+
+	if err := validate.Required("alerts", "body", m.Alerts); err != nil {
+		return err
+	}
+
+	if err := m.Alerts.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("alerts")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("alerts")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *SilencePreview) validateGroups(formats strfmt.Registry) error {
+
+	if err := validate.Required("groups", "body", m.Groups); err != nil {
+		return err
+	}
+
+	if err := m.Groups.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("groups")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("groups")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *SilencePreview) validateReceivers(formats strfmt.Registry) error {
+
+	if err := validate.Required("receivers", "body", m.Receivers); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Receivers); i++ {
+		if swag.IsZero(m.Receivers[i]) { // not required
+			continue
+		}
+
+		if m.Receivers[i] != nil {
+			if err := m.Receivers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("receivers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("receivers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *SilencePreview) validateUnmutedAlerts(formats strfmt.Registry) error {
+
+	if err := validate.Required("unmutedAlerts", "body", m.UnmutedAlerts); err != nil {
+		return err
+	}
+
+	if err := m.UnmutedAlerts.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("unmutedAlerts")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("unmutedAlerts")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this silence preview based on the context it is used
+func (m *SilencePreview) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAlerts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateGroups(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateReceivers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateUnmutedAlerts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SilencePreview) contextValidateAlerts(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Alerts.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("alerts")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("alerts")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *SilencePreview) contextValidateGroups(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Groups.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("groups")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("groups")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *SilencePreview) contextValidateReceivers(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Receivers); i++ {
+
+		if m.Receivers[i] != nil {
+
+			if swag.IsZero(m.Receivers[i]) { // not required
+				return nil
+			}
+
+			if err := m.Receivers[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("receivers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("receivers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *SilencePreview) contextValidateUnmutedAlerts(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.UnmutedAlerts.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("unmutedAlerts")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("unmutedAlerts")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SilencePreview) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SilencePreview) UnmarshalBinary(b []byte) error {
+	var res SilencePreview
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
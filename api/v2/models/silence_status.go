@@ -34,9 +34,12 @@ import (
 // swagger:model silenceStatus
 type SilenceStatus struct {
 
+	// next active window
+	NextActiveWindow *SilenceStatusNextActiveWindow `json:"nextActiveWindow,omitempty"`
+
 	// state
 	// Required: true
-	// Enum: [expired active pending]
+	// Enum: [expired active pending pending_approval]
 	State *string `json:"state"`
 }
 
@@ -44,6 +47,10 @@ type SilenceStatus struct {
 func (m *SilenceStatus) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateNextActiveWindow(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateState(formats); err != nil {
 		res = append(res, err)
 	}
@@ -54,11 +61,30 @@ func (m *SilenceStatus) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *SilenceStatus) validateNextActiveWindow(formats strfmt.Registry) error {
+	if swag.IsZero(m.NextActiveWindow) { // not required
+		return nil
+	}
+
+	if m.NextActiveWindow != nil {
+		if err := m.NextActiveWindow.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("nextActiveWindow")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("nextActiveWindow")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var silenceStatusTypeStatePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["expired","active","pending"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["expired","active","pending","pending_approval"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -76,6 +102,9 @@ const (
 
 	// SilenceStatusStatePending captures enum value "pending"
 	SilenceStatusStatePending string = "pending"
+
+	// SilenceStatusStatePendingApproval captures enum value "pending_approval"
+	SilenceStatusStatePendingApproval string = "pending_approval"
 )
 
 // prop value enum
@@ -100,8 +129,38 @@ func (m *SilenceStatus) validateState(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this silence status based on context it is used
+// ContextValidate validate this silence status based on the context it is used
 func (m *SilenceStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateNextActiveWindow(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SilenceStatus) contextValidateNextActiveWindow(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.NextActiveWindow != nil {
+
+		if swag.IsZero(m.NextActiveWindow) { // not required
+			return nil
+		}
+
+		if err := m.NextActiveWindow.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("nextActiveWindow")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("nextActiveWindow")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -122,3 +181,86 @@ func (m *SilenceStatus) UnmarshalBinary(b []byte) error {
 	*m = res
 	return nil
 }
+
+// SilenceStatusNextActiveWindow silence status next active window
+//
+// swagger:model SilenceStatusNextActiveWindow
+type SilenceStatusNextActiveWindow struct {
+
+	// ends at
+	// Required: true
+	// Format: date-time
+	EndsAt *strfmt.DateTime `json:"endsAt"`
+
+	// starts at
+	// Required: true
+	// Format: date-time
+	StartsAt *strfmt.DateTime `json:"startsAt"`
+}
+
+// Validate validates this silence status next active window
+func (m *SilenceStatusNextActiveWindow) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEndsAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartsAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SilenceStatusNextActiveWindow) validateEndsAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("nextActiveWindow"+"."+"endsAt", "body", m.EndsAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("nextActiveWindow"+"."+"endsAt", "body", "date-time", m.EndsAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SilenceStatusNextActiveWindow) validateStartsAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("nextActiveWindow"+"."+"startsAt", "body", m.StartsAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("nextActiveWindow"+"."+"startsAt", "body", "date-time", m.StartsAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this silence status next active window based on context it is used
+func (m *SilenceStatusNextActiveWindow) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SilenceStatusNextActiveWindow) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SilenceStatusNextActiveWindow) UnmarshalBinary(b []byte) error {
+	var res SilenceStatusNextActiveWindow
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,224 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TimeInterval time interval
+//
+// swagger:model timeInterval
+type TimeInterval struct {
+
+	// days of month
+	DaysOfMonth []string `json:"daysOfMonth"`
+
+	// location
+	Location string `json:"location,omitempty"`
+
+	// months
+	Months []string `json:"months"`
+
+	// times
+	Times []*TimeIntervalTimesItems0 `json:"times"`
+
+	// weekdays
+	Weekdays []string `json:"weekdays"`
+
+	// years
+	Years []string `json:"years"`
+}
+
+// Validate validates this time interval
+func (m *TimeInterval) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTimes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TimeInterval) validateTimes(formats strfmt.Registry) error {
+	if swag.IsZero(m.Times) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Times); i++ {
+		if swag.IsZero(m.Times[i]) { // not required
+			continue
+		}
+
+		if m.Times[i] != nil {
+			if err := m.Times[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("times" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("times" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this time interval based on the context it is used
+func (m *TimeInterval) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateTimes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TimeInterval) contextValidateTimes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Times); i++ {
+
+		if m.Times[i] != nil {
+
+			if swag.IsZero(m.Times[i]) { // not required
+				return nil
+			}
+
+			if err := m.Times[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("times" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("times" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TimeInterval) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TimeInterval) UnmarshalBinary(b []byte) error {
+	var res TimeInterval
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// TimeIntervalTimesItems0 time interval times items0
+//
+// swagger:model TimeIntervalTimesItems0
+type TimeIntervalTimesItems0 struct {
+
+	// end time
+	// Required: true
+	EndTime *string `json:"endTime"`
+
+	// start time
+	// Required: true
+	StartTime *string `json:"startTime"`
+}
+
+// Validate validates this time interval times items0
+func (m *TimeIntervalTimesItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEndTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TimeIntervalTimesItems0) validateEndTime(formats strfmt.Registry) error {
+
+	if err := validate.Required("endTime", "body", m.EndTime); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TimeIntervalTimesItems0) validateStartTime(formats strfmt.Registry) error {
+
+	if err := validate.Required("startTime", "body", m.StartTime); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this time interval times items0 based on context it is used
+func (m *TimeIntervalTimesItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TimeIntervalTimesItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TimeIntervalTimesItems0) UnmarshalBinary(b []byte) error {
+	var res TimeIntervalTimesItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,238 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+
+	open_api_models "github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// severityOrder ranks the common values of the severity label, most severe
+// first. Other values are ranked after them.
+var severityOrder = map[string]int{
+	"critical": 0,
+	"error":    1,
+	"warning":  2,
+	"info":     3,
+}
+
+// sortParam is a parsed sort query parameter.
+type sortParam struct {
+	key  string
+	desc bool
+}
+
+// parseSort parses a sort query parameter, which is one of the given keys
+// optionally prefixed with "-" to sort in descending order. The first key is
+// the default, an empty default key is the default order of the endpoint.
+func parseSort(param *string, keys ...string) (sortParam, error) {
+	if param == nil || *param == "" {
+		return sortParam{key: keys[0]}, nil
+	}
+	sp := sortParam{key: strings.TrimPrefix(*param, "-"), desc: strings.HasPrefix(*param, "-")}
+	valid := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if k == "" {
+			continue
+		}
+		if k == sp.key {
+			return sp, nil
+		}
+		valid = append(valid, k)
+	}
+	return sortParam{}, fmt.Errorf("invalid sort key %q, must be one of %s", sp.key, strings.Join(valid, ", "))
+}
+
+func (sp sortParam) String() string {
+	if sp.desc {
+		return "-" + sp.key
+	}
+	return sp.key
+}
+
+// paginate sorts the items by the keys returned by key, which must be unique
+// and compare lexically. It returns at most limit items following the cursor,
+// along with the cursor of the next page if there are more items.
+func paginate[T any](items []T, key func(T) string, sp sortParam, cursor *string, limit *int64) ([]T, string, error) {
+	keys := make(map[int]string, len(items))
+	idx := make([]int, len(items))
+	for i, it := range items {
+		keys[i] = key(it)
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		if sp.desc {
+			return keys[idx[i]] > keys[idx[j]]
+		}
+		return keys[idx[i]] < keys[idx[j]]
+	})
+
+	start := 0
+	if cursor != nil && *cursor != "" {
+		after, err := decodeCursor(*cursor, sp)
+		if err != nil {
+			return nil, "", err
+		}
+		start = sort.Search(len(idx), func(i int) bool {
+			if sp.desc {
+				return keys[idx[i]] < after
+			}
+			return keys[idx[i]] > after
+		})
+	}
+
+	end := len(idx)
+	if limit != nil && int64(end-start) > *limit {
+		end = start + int(*limit)
+	}
+
+	res := make([]T, 0, end-start)
+	for _, i := range idx[start:end] {
+		res = append(res, items[i])
+	}
+	var next string
+	if end < len(idx) {
+		next = encodeCursor(keys[idx[end-1]], sp)
+	}
+	return res, next, nil
+}
+
+// Cursors hold the sort parameter and the key of the last item of a page.
+func encodeCursor(key string, sp sortParam) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(sp.String() + "\x00" + key))
+}
+
+func decodeCursor(cursor string, sp sortParam) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", errors.New("invalid cursor")
+	}
+	s, key, ok := strings.Cut(string(b), "\x00")
+	if !ok {
+		return "", errors.New("invalid cursor")
+	}
+	if s != sp.String() {
+		return "", fmt.Errorf("cursor was returned for sort %q", s)
+	}
+	return key, nil
+}
+
+// timeKey returns a key of fixed width that sorts times chronologically.
+func timeKey(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000000000")
+}
+
+// reverseTimeKey returns a key of fixed width that sorts times in reverse
+// chronological order.
+func reverseTimeKey(t time.Time) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return '9' - r + '0'
+		}
+		return r
+	}, timeKey(t))
+}
+
+// severityKey returns a key that sorts the most severe label values first.
+func severityKey(ls open_api_models.LabelSet) string {
+	sev := ls["severity"]
+	if rank, ok := severityOrder[sev]; ok {
+		return fmt.Sprintf("%d", rank)
+	}
+	return fmt.Sprintf("%d%s", len(severityOrder), sev)
+}
+
+// projectedResponse writes a 200 response holding only the given fields of
+// the payload.
+type projectedResponse struct {
+	payload    interface{}
+	fields     []string
+	nextCursor string
+}
+
+// WriteResponse implements middleware.Responder.
+func (r *projectedResponse) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+	b, err := json.Marshal(r.payload)
+	if err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	paths := make([][]string, 0, len(r.fields))
+	for _, f := range r.fields {
+		paths = append(paths, strings.Split(f, "."))
+	}
+	if r.nextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", r.nextCursor)
+	}
+	rw.WriteHeader(http.StatusOK)
+	if err := producer.Produce(rw, project(v, paths)); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// project returns the value holding only the given paths of fields. The
+// paths apply to every element of arrays.
+func project(v interface{}, paths [][]string) interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		res := make([]interface{}, 0, len(v))
+		for _, e := range v {
+			res = append(res, project(e, paths))
+		}
+		return res
+	case map[string]interface{}:
+		res := map[string]interface{}{}
+		sub := map[string][][]string{}
+		for _, p := range paths {
+			f, ok := v[p[0]]
+			if !ok {
+				continue
+			}
+			if len(p) == 1 {
+				res[p[0]] = f
+				sub[p[0]] = nil
+				continue
+			}
+			if rest, ok := sub[p[0]]; !ok || rest != nil {
+				sub[p[0]] = append(rest, p[1:])
+			}
+		}
+		for name, rest := range sub {
+			if rest != nil {
+				res[name] = project(v[name], rest)
+			}
+		}
+		return res
+	}
+	return v
+}
@@ -0,0 +1,225 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-openapi/runtime"
+	"github.com/stretchr/testify/require"
+
+	open_api_models "github.com/prometheus/alertmanager/api/v2/models"
+	silence_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/silence"
+	"github.com/prometheus/alertmanager/silence/silencepb"
+)
+
+func TestParseSort(t *testing.T) {
+	sp, err := parseSort(nil, "fingerprint", "startsAt")
+	require.NoError(t, err)
+	require.Equal(t, sortParam{key: "fingerprint"}, sp)
+
+	s := "-startsAt"
+	sp, err = parseSort(&s, "fingerprint", "startsAt")
+	require.NoError(t, err)
+	require.Equal(t, sortParam{key: "startsAt", desc: true}, sp)
+	require.Equal(t, "-startsAt", sp.String())
+
+	s = "-"
+	_, err = parseSort(&s, "", "id")
+	require.EqualError(t, err, `invalid sort key "", must be one of id`)
+}
+
+func TestPaginate(t *testing.T) {
+	items := []string{"d", "a", "e", "c", "b"}
+	key := func(s string) string { return s }
+	limit := int64(2)
+
+	for _, tc := range []struct {
+		sp    sortParam
+		pages [][]string
+	}{
+		{
+			sp:    sortParam{key: "name"},
+			pages: [][]string{{"a", "b"}, {"c", "d"}, {"e"}},
+		},
+		{
+			sp:    sortParam{key: "name", desc: true},
+			pages: [][]string{{"e", "d"}, {"c", "b"}, {"a"}},
+		},
+	} {
+		t.Run(tc.sp.String(), func(t *testing.T) {
+			var (
+				cursor string
+				pages  [][]string
+			)
+			for {
+				page, next, err := paginate(items, key, tc.sp, &cursor, &limit)
+				require.NoError(t, err)
+				pages = append(pages, page)
+				if next == "" {
+					break
+				}
+				cursor = next
+			}
+			require.Equal(t, tc.pages, pages)
+		})
+	}
+
+	// Without a limit all items are returned.
+	all, next, err := paginate(items, key, sortParam{key: "name"}, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c", "d", "e"}, all)
+	require.Equal(t, "", next)
+
+	// Cursors only apply to the sort they were returned for.
+	_, next, err = paginate(items, key, sortParam{key: "name"}, nil, &limit)
+	require.NoError(t, err)
+	_, _, err = paginate(items, key, sortParam{key: "name", desc: true}, &next, &limit)
+	require.EqualError(t, err, `cursor was returned for sort "name"`)
+
+	invalid := "not a cursor!"
+	_, _, err = paginate(items, key, sortParam{key: "name"}, &invalid, &limit)
+	require.EqualError(t, err, "invalid cursor")
+}
+
+func TestSilenceKey(t *testing.T) {
+	// The default order of silences is the one of SortSilences.
+	updateTime := "2019-01-01T12:00:00+00:00"
+	silences := open_api_models.GettableSilences{
+		gettableSilence("silence-6-expired", "expired", updateTime,
+			"2019-01-01T12:00:00+00:00", "2019-01-01T11:00:00+00:00"),
+		gettableSilence("silence-1-active", "active", updateTime,
+			"2019-01-01T12:00:00+00:00", "2019-01-01T13:00:00+00:00"),
+		gettableSilence("silence-7-expired", "expired", updateTime,
+			"2019-01-01T12:00:00+00:00", "2019-01-01T10:00:00+00:00"),
+		gettableSilence("silence-5-expired", "expired", updateTime,
+			"2019-01-01T12:00:00+00:00", "2019-01-01T12:00:00+00:00"),
+		gettableSilence("silence-0-active", "active", updateTime,
+			"2019-01-01T12:00:00+00:00", "2019-01-01T12:00:00+00:00"),
+		gettableSilence("silence-4-pending", "pending", updateTime,
+			"2019-01-01T13:00:00+00:00", "2019-01-01T12:00:00+00:00"),
+		gettableSilence("silence-3-pending", "pending", updateTime,
+			"2019-01-01T12:00:00+00:00", "2019-01-01T12:00:00+00:00"),
+		gettableSilence("silence-2-active", "active", updateTime,
+			"2019-01-01T12:00:00+00:00", "2019-01-01T14:00:00+00:00"),
+	}
+	sorted, _, err := paginate(silences, silenceKey, sortParam{}, nil, nil)
+	require.NoError(t, err)
+	for i, sil := range sorted {
+		require.Equal(t, "silence-"+strconv.Itoa(i)+"-"+*sil.Status.State, *sil.ID)
+	}
+}
+
+func TestSeverityKey(t *testing.T) {
+	labels := []open_api_models.LabelSet{
+		{"severity": "page"},
+		{},
+		{"severity": "warning"},
+		{"severity": "critical"},
+		{"severity": "info"},
+	}
+	sorted, _, err := paginate(labels, func(ls open_api_models.LabelSet) string {
+		return severityKey(ls) + "\x00" + ls["severity"]
+	}, sortParam{}, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []open_api_models.LabelSet{
+		{"severity": "critical"},
+		{"severity": "warning"},
+		{"severity": "info"},
+		{},
+		{"severity": "page"},
+	}, sorted)
+}
+
+func TestProject(t *testing.T) {
+	var v interface{}
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"labels": {"a": "1"}, "status": {"state": "active", "silencedBy": []}, "fingerprint": "1"},
+		{"labels": {"a": "2"}, "status": {"state": "suppressed", "silencedBy": ["x"]}}
+	]`), &v))
+
+	res, err := json.Marshal(project(v, [][]string{{"labels"}, {"status", "state"}, {"labels", "a"}}))
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"labels": {"a": "1"}, "status": {"state": "active"}},
+		{"labels": {"a": "2"}, "status": {"state": "suppressed"}}
+	]`, string(res))
+}
+
+func TestGetSilencesHandlerPagination(t *testing.T) {
+	now := time.Now()
+	silences := newSilences(t)
+	for i := 0; i < 5; i++ {
+		_, err := silences.Set(&silencepb.Silence{
+			Matchers:  []*silencepb.Matcher{{Type: silencepb.Matcher_EQUAL, Name: "a", Pattern: "b"}},
+			StartsAt:  now.Add(time.Duration(i) * time.Minute),
+			EndsAt:    now.Add(time.Hour),
+			CreatedBy: "creator",
+			Comment:   "comment",
+		})
+		require.NoError(t, err)
+	}
+	api := API{
+		uptime:   time.Now(),
+		silences: silences,
+		logger:   log.NewNopLogger(),
+	}
+
+	r, err := http.NewRequest("GET", "/api/v2/silences", nil)
+	require.NoError(t, err)
+	get := func(sort, cursor string) *httptest.ResponseRecorder {
+		limit := int64(2)
+		w := httptest.NewRecorder()
+		api.getSilencesHandler(silence_ops.GetSilencesParams{
+			HTTPRequest: r,
+			Sort:        &sort,
+			Limit:       &limit,
+			Cursor:      &cursor,
+			Fields:      []string{"startsAt"},
+		}).WriteResponse(w, runtime.JSONProducer())
+		return w
+	}
+
+	var (
+		starts []string
+		cursor string
+	)
+	for {
+		w := get("-startsAt", cursor)
+		require.Equal(t, http.StatusOK, w.Code)
+		var page []map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &page))
+		require.LessOrEqual(t, len(page), 2)
+		for _, s := range page {
+			require.Len(t, s, 1)
+			starts = append(starts, s["startsAt"].(string))
+		}
+		if cursor = w.Header().Get("X-Next-Cursor"); cursor == "" {
+			break
+		}
+	}
+	require.Len(t, starts, 5)
+	require.IsDecreasing(t, starts)
+
+	w := get("updatedAt", "")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	w = get("", "invalid!")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
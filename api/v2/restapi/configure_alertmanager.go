@@ -18,6 +18,7 @@ package restapi
 
 import (
 	"crypto/tls"
+	"io"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -55,7 +56,25 @@ func configureAPI(api *operations.AlertmanagerAPI) http.Handler {
 	api.JSONConsumer = runtime.JSONConsumer()
 
 	api.JSONProducer = runtime.JSONProducer()
+	api.TextEventStreamProducer = runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		return errors.NotImplemented("textEventStream producer has not yet been implemented")
+	})
 
+	if api.AlertAcknowledgeAlertHandler == nil {
+		api.AlertAcknowledgeAlertHandler = alert.AcknowledgeAlertHandlerFunc(func(params alert.AcknowledgeAlertParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.AcknowledgeAlert has not yet been implemented")
+		})
+	}
+	if api.SilenceApproveSilenceHandler == nil {
+		api.SilenceApproveSilenceHandler = silence.ApproveSilenceHandlerFunc(func(params silence.ApproveSilenceParams) middleware.Responder {
+			return middleware.NotImplemented("operation silence.ApproveSilence has not yet been implemented")
+		})
+	}
+	if api.SilenceConsolidateSilencesHandler == nil {
+		api.SilenceConsolidateSilencesHandler = silence.ConsolidateSilencesHandlerFunc(func(params silence.ConsolidateSilencesParams) middleware.Responder {
+			return middleware.NotImplemented("operation silence.ConsolidateSilences has not yet been implemented")
+		})
+	}
 	if api.SilenceDeleteSilenceHandler == nil {
 		api.SilenceDeleteSilenceHandler = silence.DeleteSilenceHandlerFunc(func(params silence.DeleteSilenceParams) middleware.Responder {
 			return middleware.NotImplemented("operation silence.DeleteSilence has not yet been implemented")
@@ -66,11 +85,21 @@ func configureAPI(api *operations.AlertmanagerAPI) http.Handler {
 			return middleware.NotImplemented("operation alertgroup.GetAlertGroups has not yet been implemented")
 		})
 	}
+	if api.AlertGetAlertHistoryHandler == nil {
+		api.AlertGetAlertHistoryHandler = alert.GetAlertHistoryHandlerFunc(func(params alert.GetAlertHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.GetAlertHistory has not yet been implemented")
+		})
+	}
 	if api.AlertGetAlertsHandler == nil {
 		api.AlertGetAlertsHandler = alert.GetAlertsHandlerFunc(func(params alert.GetAlertsParams) middleware.Responder {
 			return middleware.NotImplemented("operation alert.GetAlerts has not yet been implemented")
 		})
 	}
+	if api.GeneralGetConfigHandler == nil {
+		api.GeneralGetConfigHandler = general.GetConfigHandlerFunc(func(params general.GetConfigParams) middleware.Responder {
+			return middleware.NotImplemented("operation general.GetConfig has not yet been implemented")
+		})
+	}
 	if api.ReceiverGetReceiversHandler == nil {
 		api.ReceiverGetReceiversHandler = receiver.GetReceiversHandlerFunc(func(params receiver.GetReceiversParams) middleware.Responder {
 			return middleware.NotImplemented("operation receiver.GetReceivers has not yet been implemented")
@@ -81,6 +110,16 @@ func configureAPI(api *operations.AlertmanagerAPI) http.Handler {
 			return middleware.NotImplemented("operation silence.GetSilence has not yet been implemented")
 		})
 	}
+	if api.SilenceGetSilenceHistoryHandler == nil {
+		api.SilenceGetSilenceHistoryHandler = silence.GetSilenceHistoryHandlerFunc(func(params silence.GetSilenceHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation silence.GetSilenceHistory has not yet been implemented")
+		})
+	}
+	if api.SilenceGetSilenceOverlapsHandler == nil {
+		api.SilenceGetSilenceOverlapsHandler = silence.GetSilenceOverlapsHandlerFunc(func(params silence.GetSilenceOverlapsParams) middleware.Responder {
+			return middleware.NotImplemented("operation silence.GetSilenceOverlaps has not yet been implemented")
+		})
+	}
 	if api.SilenceGetSilencesHandler == nil {
 		api.SilenceGetSilencesHandler = silence.GetSilencesHandlerFunc(func(params silence.GetSilencesParams) middleware.Responder {
 			return middleware.NotImplemented("operation silence.GetSilences has not yet been implemented")
@@ -101,6 +140,36 @@ func configureAPI(api *operations.AlertmanagerAPI) http.Handler {
 			return middleware.NotImplemented("operation silence.PostSilences has not yet been implemented")
 		})
 	}
+	if api.SilencePreviewSilenceHandler == nil {
+		api.SilencePreviewSilenceHandler = silence.PreviewSilenceHandlerFunc(func(params silence.PreviewSilenceParams) middleware.Responder {
+			return middleware.NotImplemented("operation silence.PreviewSilence has not yet been implemented")
+		})
+	}
+	if api.GeneralPutConfigHandler == nil {
+		api.GeneralPutConfigHandler = general.PutConfigHandlerFunc(func(params general.PutConfigParams) middleware.Responder {
+			return middleware.NotImplemented("operation general.PutConfig has not yet been implemented")
+		})
+	}
+	if api.SilenceRejectSilenceHandler == nil {
+		api.SilenceRejectSilenceHandler = silence.RejectSilenceHandlerFunc(func(params silence.RejectSilenceParams) middleware.Responder {
+			return middleware.NotImplemented("operation silence.RejectSilence has not yet been implemented")
+		})
+	}
+	if api.GeneralRollbackConfigHandler == nil {
+		api.GeneralRollbackConfigHandler = general.RollbackConfigHandlerFunc(func(params general.RollbackConfigParams) middleware.Responder {
+			return middleware.NotImplemented("operation general.RollbackConfig has not yet been implemented")
+		})
+	}
+	if api.AlertStreamAlertsHandler == nil {
+		api.AlertStreamAlertsHandler = alert.StreamAlertsHandlerFunc(func(params alert.StreamAlertsParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.StreamAlerts has not yet been implemented")
+		})
+	}
+	if api.AlertUnacknowledgeAlertHandler == nil {
+		api.AlertUnacknowledgeAlertHandler = alert.UnacknowledgeAlertHandlerFunc(func(params alert.UnacknowledgeAlertParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.UnacknowledgeAlert has not yet been implemented")
+		})
+	}
 
 	api.PreServerShutdown = func() {}
 
@@ -50,6 +50,73 @@ func init() {
   },
   "basePath": "/api/v2/",
   "paths": {
+    "/alert/{fingerprint}/acknowledgement": {
+      "post": {
+        "description": "Acknowledge a firing alert",
+        "tags": [
+          "alert"
+        ],
+        "operationId": "acknowledgeAlert",
+        "parameters": [
+          {
+            "description": "The acknowledgement",
+            "name": "acknowledgement",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/postableAcknowledgement"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Acknowledge alert response"
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "403": {
+            "$ref": "#/responses/Forbidden"
+          },
+          "404": {
+            "description": "A firing alert with the specified fingerprint was not found"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      },
+      "delete": {
+        "description": "Withdraw the acknowledgement of an alert",
+        "tags": [
+          "alert"
+        ],
+        "operationId": "unacknowledgeAlert",
+        "responses": {
+          "200": {
+            "description": "Unacknowledge alert response"
+          },
+          "403": {
+            "$ref": "#/responses/Forbidden"
+          },
+          "404": {
+            "description": "An acknowledgement of the alert with the specified fingerprint was not found"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "Fingerprint of the alert",
+          "name": "fingerprint",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/alerts": {
       "get": {
         "description": "Get a list of alerts",
@@ -101,6 +168,21 @@ func init() {
             "description": "A regex matching receivers to filter alerts by",
             "name": "receiver",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The key to sort alerts by, one of fingerprint, startsAt and severity,\nprefixed with - to sort in descending order. Alerts are sorted by\nfingerprint by default.\n",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/fields"
           }
         ],
         "responses": {
@@ -108,6 +190,12 @@ func init() {
             "description": "Get alerts response",
             "schema": {
               "$ref": "#/definitions/gettableAlerts"
+            },
+            "headers": {
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, if there are more items"
+              }
             }
           },
           "400": {
@@ -142,6 +230,9 @@ func init() {
           "400": {
             "$ref": "#/responses/BadRequest"
           },
+          "403": {
+            "$ref": "#/responses/Forbidden"
+          },
           "500": {
             "$ref": "#/responses/InternalServerError"
           }
@@ -192,6 +283,21 @@ func init() {
             "description": "A regex matching receivers to filter alerts by",
             "name": "receiver",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The key to sort alert groups by, one of labels, startsAt and severity,\nprefixed with - to sort in descending order. startsAt is the start of\nthe earliest alert of a group and severity its most severe alert.\nAlert groups are sorted by labels and receiver by default.\n",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/fields"
           }
         ],
         "responses": {
@@ -199,17 +305,208 @@ func init() {
             "description": "Get alert groups response",
             "schema": {
               "$ref": "#/definitions/alertGroups"
+            },
+            "headers": {
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, if there are more items"
+              }
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
+    "/alerts/stream": {
+      "get": {
+        "description": "Stream changes to alerts as Server-Sent Events. Each event has one of\nthe types created, updated, resolved, silenced, unsilenced, inhibited\nand uninhibited, and carries the alert as JSON. The stream starts with\na created event for every firing alert, unless it resumes from the\nevent ID given in the Last-Event-ID header.\n",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "alert"
+        ],
+        "operationId": "streamAlerts",
+        "parameters": [
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "A list of matchers to filter alerts by",
+            "name": "filter",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A regex matching receivers to filter alerts by",
+            "name": "receiver",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The ID of the last event received, to resume a stream from",
+            "name": "Last-Event-ID",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Stream of alert events",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
+    "/alerts/{fingerprint}/history": {
+      "get": {
+        "description": "Get the state transitions of an alert, oldest first",
+        "tags": [
+          "alert"
+        ],
+        "operationId": "getAlertHistory",
+        "responses": {
+          "200": {
+            "description": "Get alert history response",
+            "schema": {
+              "$ref": "#/definitions/alertHistory"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "404": {
+            "description": "No history of the alert with the specified fingerprint was found"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "Fingerprint of the alert",
+          "name": "fingerprint",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/config": {
+      "get": {
+        "description": "Get the running configuration and the versions kept for rollbacks",
+        "tags": [
+          "general"
+        ],
+        "operationId": "getConfig",
+        "responses": {
+          "200": {
+            "description": "Get config response",
+            "schema": {
+              "$ref": "#/definitions/configVersions"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Validate and apply a new configuration",
+        "tags": [
+          "general"
+        ],
+        "operationId": "putConfig",
+        "parameters": [
+          {
+            "description": "The configuration to apply",
+            "name": "config",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/postableConfig"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The configuration was applied, or is valid for a dry run",
+            "schema": {
+              "$ref": "#/definitions/configVersion"
             }
           },
           "400": {
             "$ref": "#/responses/BadRequest"
           },
+          "403": {
+            "$ref": "#/responses/Forbidden"
+          },
           "500": {
             "$ref": "#/responses/InternalServerError"
           }
         }
       }
     },
+    "/config/versions/{versionID}/rollback": {
+      "post": {
+        "description": "Apply again the configuration of a previous version",
+        "tags": [
+          "general"
+        ],
+        "operationId": "rollbackConfig",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to also write the configuration to the configuration file",
+            "name": "persist",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The configuration was applied",
+            "schema": {
+              "$ref": "#/definitions/configVersion"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "403": {
+            "$ref": "#/responses/Forbidden"
+          },
+          "404": {
+            "description": "The configuration version was not found"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "description": "ID of the configuration version to roll back to",
+          "name": "versionID",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/receivers": {
       "get": {
         "description": "Get list of all receivers (name of notification integrations)",
@@ -272,6 +569,9 @@ func init() {
           "200": {
             "description": "Delete silence response"
           },
+          "403": {
+            "$ref": "#/responses/Forbidden"
+          },
           "404": {
             "description": "A silence with the specified ID was not found"
           },
@@ -291,50 +591,198 @@ func init() {
         }
       ]
     },
-    "/silences": {
-      "get": {
-        "description": "Get a list of silences",
+    "/silence/{silenceID}/approve": {
+      "post": {
+        "description": "Approve a silence pending approval",
         "tags": [
           "silence"
         ],
-        "operationId": "getSilences",
+        "operationId": "approveSilence",
         "parameters": [
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "A list of matchers to filter silences by",
-            "name": "filter",
-            "in": "query"
+            "description": "The approval decision",
+            "name": "decision",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/silenceApprovalDecision"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Get silences response",
-            "schema": {
-              "$ref": "#/definitions/gettableSilences"
-            }
+            "description": "Approve silence response"
           },
           "400": {
             "$ref": "#/responses/BadRequest"
           },
+          "403": {
+            "$ref": "#/responses/Forbidden"
+          },
+          "404": {
+            "description": "A silence with the specified ID was not found"
+          },
           "500": {
             "$ref": "#/responses/InternalServerError"
           }
         }
       },
-      "post": {
-        "description": "Post a new silence or update an existing one",
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "ID of the silence to approve",
+          "name": "silenceID",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/silence/{silenceID}/history": {
+      "get": {
+        "description": "Get the revisions of a silence, oldest first. The last revision is the current state of the silence.",
         "tags": [
           "silence"
         ],
-        "operationId": "postSilences",
-        "parameters": [
-          {
-            "description": "The silence to create",
-            "name": "silence",
+        "operationId": "getSilenceHistory",
+        "responses": {
+          "200": {
+            "description": "Get silence history response",
+            "schema": {
+              "$ref": "#/definitions/silenceHistory"
+            }
+          },
+          "404": {
+            "description": "A silence with the specified ID was not found"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "ID of the silence to get the history of",
+          "name": "silenceID",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/silence/{silenceID}/reject": {
+      "post": {
+        "description": "Reject a silence pending approval, expiring it",
+        "tags": [
+          "silence"
+        ],
+        "operationId": "rejectSilence",
+        "parameters": [
+          {
+            "description": "The rejection decision",
+            "name": "decision",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/silenceApprovalDecision"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Reject silence response"
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "403": {
+            "$ref": "#/responses/Forbidden"
+          },
+          "404": {
+            "description": "A silence with the specified ID was not found"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "ID of the silence to reject",
+          "name": "silenceID",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/silences": {
+      "get": {
+        "description": "Get a list of silences",
+        "tags": [
+          "silence"
+        ],
+        "operationId": "getSilences",
+        "parameters": [
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "A list of matchers to filter silences by",
+            "name": "filter",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The key to sort silences by, one of startsAt, endsAt and id, prefixed\nwith - to sort in descending order. By default silences are sorted by\nstate and by the time they start or end.\n",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/fields"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get silences response",
+            "schema": {
+              "$ref": "#/definitions/gettableSilences"
+            },
+            "headers": {
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, if there are more items"
+              }
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      },
+      "post": {
+        "description": "Post a new silence or update an existing one",
+        "tags": [
+          "silence"
+        ],
+        "operationId": "postSilences",
+        "parameters": [
+          {
+            "description": "The silence to create",
+            "name": "silence",
             "in": "body",
             "required": true,
             "schema": {
@@ -357,11 +805,122 @@ func init() {
           "400": {
             "$ref": "#/responses/BadRequest"
           },
+          "403": {
+            "$ref": "#/responses/Forbidden"
+          },
+          "404": {
+            "description": "A silence with the specified ID was not found",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "/silences/consolidate": {
+      "post": {
+        "description": "Replace silences with a single silence muting all of their alerts",
+        "tags": [
+          "silence"
+        ],
+        "operationId": "consolidateSilences",
+        "parameters": [
+          {
+            "description": "The silences to consolidate",
+            "name": "consolidation",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/silenceConsolidation"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Consolidate silences response",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "silenceID": {
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "403": {
+            "$ref": "#/responses/Forbidden"
+          },
+          "404": {
+            "description": "A silence with one of the specified IDs was not found",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
+    "/silences/overlaps": {
+      "get": {
+        "description": "Get pairs of overlapping silences that have not expired, one of which mutes all alerts muted by the other",
+        "tags": [
+          "silence"
+        ],
+        "operationId": "getSilenceOverlaps",
+        "responses": {
+          "200": {
+            "description": "Get silence overlaps response",
+            "schema": {
+              "$ref": "#/definitions/silenceOverlaps"
+            }
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
+    "/silences/preview": {
+      "post": {
+        "description": "Preview the alerts a new or updated silence would affect without saving it",
+        "tags": [
+          "silence"
+        ],
+        "operationId": "previewSilence",
+        "parameters": [
+          {
+            "description": "The silence to preview",
+            "name": "silence",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/postableSilence"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Preview silence response",
+            "schema": {
+              "$ref": "#/definitions/silencePreview"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
           "404": {
             "description": "A silence with the specified ID was not found",
             "schema": {
               "type": "string"
             }
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
           }
         }
       }
@@ -385,6 +944,26 @@ func init() {
     }
   },
   "definitions": {
+    "acknowledgement": {
+      "type": "object",
+      "required": [
+        "acknowledgedBy",
+        "acknowledgedAt"
+      ],
+      "properties": {
+        "acknowledgedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "acknowledgedBy": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        }
+      },
+      "readOnly": true
+    },
     "alert": {
       "type": "object",
       "required": [
@@ -428,41 +1007,117 @@ func init() {
         "$ref": "#/definitions/alertGroup"
       }
     },
-    "alertStatus": {
+    "alertHistory": {
       "type": "object",
       "required": [
-        "state",
-        "silencedBy",
-        "inhibitedBy"
+        "fingerprint",
+        "labels",
+        "events"
+      ],
+      "properties": {
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertHistoryEvent"
+          }
+        },
+        "fingerprint": {
+          "type": "string"
+        },
+        "labels": {
+          "$ref": "#/definitions/labelSet"
+        }
+      }
+    },
+    "alertHistoryEvent": {
+      "type": "object",
+      "required": [
+        "type",
+        "timestamp"
       ],
       "properties": {
         "inhibitedBy": {
+          "description": "The alerts inhibiting the alert, for inhibited events",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
+        "integration": {
+          "description": "The integration notified, for notified events",
+          "type": "string"
+        },
+        "receiver": {
+          "description": "The receiver notified, for notified events",
+          "type": "string"
+        },
+        "resolved": {
+          "description": "Whether the notification was about the resolution of the alert, for notified events",
+          "type": "boolean"
+        },
         "silencedBy": {
+          "description": "The silences muting the alert, for silenced events",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "state": {
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "type": {
           "type": "string",
           "enum": [
-            "unprocessed",
-            "active",
-            "suppressed"
+            "firing",
+            "resolved",
+            "silenced",
+            "unsilenced",
+            "inhibited",
+            "uninhibited",
+            "notified"
           ]
         }
       }
     },
-    "alertmanagerConfig": {
+    "alertStatus": {
       "type": "object",
       "required": [
-        "original"
-      ],
+        "state",
+        "silencedBy",
+        "inhibitedBy"
+      ],
+      "properties": {
+        "acknowledgement": {
+          "$ref": "#/definitions/acknowledgement"
+        },
+        "inhibitedBy": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "silencedBy": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "unprocessed",
+            "active",
+            "suppressed"
+          ]
+        }
+      }
+    },
+    "alertmanagerConfig": {
+      "type": "object",
+      "required": [
+        "original"
+      ],
       "properties": {
         "original": {
           "type": "string"
@@ -518,6 +1173,59 @@ func init() {
         }
       }
     },
+    "configVersion": {
+      "type": "object",
+      "required": [
+        "id",
+        "source",
+        "appliedAt",
+        "config"
+      ],
+      "properties": {
+        "appliedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "config": {
+          "description": "The configuration, with secrets hidden",
+          "type": "string"
+        },
+        "diff": {
+          "description": "The unified diff of the configuration from the previous version",
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "file",
+            "api",
+            "rollback"
+          ]
+        }
+      }
+    },
+    "configVersions": {
+      "type": "object",
+      "required": [
+        "versions"
+      ],
+      "properties": {
+        "current": {
+          "$ref": "#/definitions/configVersion"
+        },
+        "versions": {
+          "description": "The versions kept for rollbacks, oldest first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configVersion"
+          }
+        }
+      }
+    },
     "gettableAlert": {
       "allOf": [
         {
@@ -656,6 +1364,20 @@ func init() {
         }
       }
     },
+    "postableAcknowledgement": {
+      "type": "object",
+      "required": [
+        "acknowledgedBy"
+      ],
+      "properties": {
+        "acknowledgedBy": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        }
+      }
+    },
     "postableAlert": {
       "allOf": [
         {
@@ -685,6 +1407,26 @@ func init() {
         "$ref": "#/definitions/postableAlert"
       }
     },
+    "postableConfig": {
+      "type": "object",
+      "required": [
+        "config"
+      ],
+      "properties": {
+        "config": {
+          "description": "The configuration in YAML",
+          "type": "string"
+        },
+        "dryRun": {
+          "description": "Only validate the configuration, without applying it",
+          "type": "boolean"
+        },
+        "persist": {
+          "description": "Whether to also write the configuration to the configuration file",
+          "type": "boolean"
+        }
+      }
+    },
     "postableSilence": {
       "allOf": [
         {
@@ -721,6 +1463,9 @@ func init() {
         "comment"
       ],
       "properties": {
+        "approval": {
+          "$ref": "#/definitions/silenceApproval"
+        },
         "comment": {
           "type": "string"
         },
@@ -737,25 +1482,248 @@ func init() {
         "startsAt": {
           "type": "string",
           "format": "date-time"
+        },
+        "timeIntervals": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/timeInterval"
+          }
+        }
+      }
+    },
+    "silenceApproval": {
+      "type": "object",
+      "required": [
+        "state"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "decidedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "decidedBy": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "approved",
+            "rejected"
+          ]
+        }
+      },
+      "readOnly": true
+    },
+    "silenceApprovalDecision": {
+      "type": "object",
+      "required": [
+        "decidedBy"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "decidedBy": {
+          "type": "string"
+        }
+      }
+    },
+    "silenceConsolidation": {
+      "type": "object",
+      "required": [
+        "silenceIDs",
+        "createdBy"
+      ],
+      "properties": {
+        "createdBy": {
+          "type": "string"
+        },
+        "silenceIDs": {
+          "type": "array",
+          "minItems": 2,
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "silenceHistory": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/silenceRevision"
+      }
+    },
+    "silenceOverlap": {
+      "description": "A pair of silences with overlapping time ranges, where the silence with ID silenceID mutes all alerts muted by the one with ID otherSilenceID",
+      "type": "object",
+      "required": [
+        "kind",
+        "silenceID",
+        "otherSilenceID"
+      ],
+      "properties": {
+        "kind": {
+          "type": "string",
+          "enum": [
+            "duplicate",
+            "subsumes"
+          ]
+        },
+        "otherSilenceID": {
+          "type": "string"
+        },
+        "silenceID": {
+          "type": "string"
+        }
+      }
+    },
+    "silenceOverlaps": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/silenceOverlap"
+      }
+    },
+    "silencePreview": {
+      "type": "object",
+      "required": [
+        "alerts",
+        "unmutedAlerts",
+        "receivers",
+        "groups"
+      ],
+      "properties": {
+        "alerts": {
+          "description": "The alerts the silence would mute",
+          "$ref": "#/definitions/gettableAlerts"
+        },
+        "groups": {
+          "description": "The aggregation groups containing alerts the silence would mute",
+          "$ref": "#/definitions/alertGroups"
+        },
+        "receivers": {
+          "description": "The receivers of the alerts the silence would mute",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/receiver"
+          }
+        },
+        "unmutedAlerts": {
+          "description": "The alerts muted by the silence being updated that it would no longer mute",
+          "$ref": "#/definitions/gettableAlerts"
         }
       }
     },
+    "silenceRevision": {
+      "allOf": [
+        {
+          "type": "object",
+          "required": [
+            "id",
+            "updatedAt"
+          ],
+          "properties": {
+            "id": {
+              "type": "string"
+            },
+            "updatedAt": {
+              "type": "string",
+              "format": "date-time"
+            }
+          }
+        },
+        {
+          "$ref": "#/definitions/silence"
+        }
+      ]
+    },
     "silenceStatus": {
       "type": "object",
       "required": [
         "state"
       ],
       "properties": {
+        "nextActiveWindow": {
+          "type": "object",
+          "required": [
+            "startsAt",
+            "endsAt"
+          ],
+          "properties": {
+            "endsAt": {
+              "type": "string",
+              "format": "date-time"
+            },
+            "startsAt": {
+              "type": "string",
+              "format": "date-time"
+            }
+          }
+        },
         "state": {
           "type": "string",
           "enum": [
             "expired",
             "active",
-            "pending"
+            "pending",
+            "pending_approval"
           ]
         }
       }
     },
+    "timeInterval": {
+      "type": "object",
+      "properties": {
+        "daysOfMonth": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "location": {
+          "type": "string"
+        },
+        "months": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "times": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": [
+              "startTime",
+              "endTime"
+            ],
+            "properties": {
+              "endTime": {
+                "type": "string"
+              },
+              "startTime": {
+                "type": "string"
+              }
+            }
+          }
+        },
+        "weekdays": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "years": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "versionInfo": {
       "type": "object",
       "required": [
@@ -788,6 +1756,31 @@ func init() {
       }
     }
   },
+  "parameters": {
+    "cursor": {
+      "type": "string",
+      "description": "The cursor returned in the X-Next-Cursor header of the previous page",
+      "name": "cursor",
+      "in": "query"
+    },
+    "fields": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "collectionFormat": "multi",
+      "description": "The fields to return for each item, as dot-separated paths such as\nlabels or status.state. All fields are returned by default.\n",
+      "name": "fields",
+      "in": "query"
+    },
+    "limit": {
+      "minimum": 1,
+      "type": "integer",
+      "description": "The maximum number of items to return",
+      "name": "limit",
+      "in": "query"
+    }
+  },
   "responses": {
     "BadRequest": {
       "description": "Bad request",
@@ -795,6 +1788,12 @@ func init() {
         "type": "string"
       }
     },
+    "Forbidden": {
+      "description": "Forbidden",
+      "schema": {
+        "type": "string"
+      }
+    },
     "InternalServerError": {
       "description": "Internal server error",
       "schema": {
@@ -840,8 +1839,90 @@ func init() {
   },
   "basePath": "/api/v2/",
   "paths": {
-    "/alerts": {
-      "get": {
+    "/alert/{fingerprint}/acknowledgement": {
+      "post": {
+        "description": "Acknowledge a firing alert",
+        "tags": [
+          "alert"
+        ],
+        "operationId": "acknowledgeAlert",
+        "parameters": [
+          {
+            "description": "The acknowledgement",
+            "name": "acknowledgement",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/postableAcknowledgement"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Acknowledge alert response"
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "404": {
+            "description": "A firing alert with the specified fingerprint was not found"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Withdraw the acknowledgement of an alert",
+        "tags": [
+          "alert"
+        ],
+        "operationId": "unacknowledgeAlert",
+        "responses": {
+          "200": {
+            "description": "Unacknowledge alert response"
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "404": {
+            "description": "An acknowledgement of the alert with the specified fingerprint was not found"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "Fingerprint of the alert",
+          "name": "fingerprint",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/alerts": {
+      "get": {
         "description": "Get a list of alerts",
         "tags": [
           "alert"
@@ -891,6 +1972,35 @@ func init() {
             "description": "A regex matching receivers to filter alerts by",
             "name": "receiver",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The key to sort alerts by, one of fingerprint, startsAt and severity,\nprefixed with - to sort in descending order. Alerts are sorted by\nfingerprint by default.\n",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of items to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor returned in the X-Next-Cursor header of the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "The fields to return for each item, as dot-separated paths such as\nlabels or status.state. All fields are returned by default.\n",
+            "name": "fields",
+            "in": "query"
           }
         ],
         "responses": {
@@ -898,6 +2008,12 @@ func init() {
             "description": "Get alerts response",
             "schema": {
               "$ref": "#/definitions/gettableAlerts"
+            },
+            "headers": {
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, if there are more items"
+              }
             }
           },
           "400": {
@@ -941,6 +2057,12 @@ func init() {
               "type": "string"
             }
           },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "type": "string"
+            }
+          },
           "500": {
             "description": "Internal server error",
             "schema": {
@@ -994,6 +2116,35 @@ func init() {
             "description": "A regex matching receivers to filter alerts by",
             "name": "receiver",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The key to sort alert groups by, one of labels, startsAt and severity,\nprefixed with - to sort in descending order. startsAt is the start of\nthe earliest alert of a group and severity its most severe alert.\nAlert groups are sorted by labels and receiver by default.\n",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of items to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor returned in the X-Next-Cursor header of the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "The fields to return for each item, as dot-separated paths such as\nlabels or status.state. All fields are returned by default.\n",
+            "name": "fields",
+            "in": "query"
           }
         ],
         "responses": {
@@ -1001,6 +2152,12 @@ func init() {
             "description": "Get alert groups response",
             "schema": {
               "$ref": "#/definitions/alertGroups"
+            },
+            "headers": {
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, if there are more items"
+              }
             }
           },
           "400": {
@@ -1018,6 +2175,221 @@ func init() {
         }
       }
     },
+    "/alerts/stream": {
+      "get": {
+        "description": "Stream changes to alerts as Server-Sent Events. Each event has one of\nthe types created, updated, resolved, silenced, unsilenced, inhibited\nand uninhibited, and carries the alert as JSON. The stream starts with\na created event for every firing alert, unless it resumes from the\nevent ID given in the Last-Event-ID header.\n",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "alert"
+        ],
+        "operationId": "streamAlerts",
+        "parameters": [
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "A list of matchers to filter alerts by",
+            "name": "filter",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A regex matching receivers to filter alerts by",
+            "name": "receiver",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The ID of the last event received, to resume a stream from",
+            "name": "Last-Event-ID",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Stream of alert events",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "/alerts/{fingerprint}/history": {
+      "get": {
+        "description": "Get the state transitions of an alert, oldest first",
+        "tags": [
+          "alert"
+        ],
+        "operationId": "getAlertHistory",
+        "responses": {
+          "200": {
+            "description": "Get alert history response",
+            "schema": {
+              "$ref": "#/definitions/alertHistory"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "404": {
+            "description": "No history of the alert with the specified fingerprint was found"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "Fingerprint of the alert",
+          "name": "fingerprint",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/config": {
+      "get": {
+        "description": "Get the running configuration and the versions kept for rollbacks",
+        "tags": [
+          "general"
+        ],
+        "operationId": "getConfig",
+        "responses": {
+          "200": {
+            "description": "Get config response",
+            "schema": {
+              "$ref": "#/definitions/configVersions"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Validate and apply a new configuration",
+        "tags": [
+          "general"
+        ],
+        "operationId": "putConfig",
+        "parameters": [
+          {
+            "description": "The configuration to apply",
+            "name": "config",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/postableConfig"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The configuration was applied, or is valid for a dry run",
+            "schema": {
+              "$ref": "#/definitions/configVersion"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "/config/versions/{versionID}/rollback": {
+      "post": {
+        "description": "Apply again the configuration of a previous version",
+        "tags": [
+          "general"
+        ],
+        "operationId": "rollbackConfig",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to also write the configuration to the configuration file",
+            "name": "persist",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The configuration was applied",
+            "schema": {
+              "$ref": "#/definitions/configVersion"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "404": {
+            "description": "The configuration version was not found"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "integer",
+          "format": "int64",
+          "description": "ID of the configuration version to roll back to",
+          "name": "versionID",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/receivers": {
       "get": {
         "description": "Get list of all receivers (name of notification integrations)",
@@ -1036,20 +2408,149 @@ func init() {
             }
           }
         }
-      }
+      }
+    },
+    "/silence/{silenceID}": {
+      "get": {
+        "description": "Get a silence by its ID",
+        "tags": [
+          "silence"
+        ],
+        "operationId": "getSilence",
+        "responses": {
+          "200": {
+            "description": "Get silence response",
+            "schema": {
+              "$ref": "#/definitions/gettableSilence"
+            }
+          },
+          "404": {
+            "description": "A silence with the specified ID was not found"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a silence by its ID",
+        "tags": [
+          "silence"
+        ],
+        "operationId": "deleteSilence",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "ID of the silence to get",
+            "name": "silenceID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Delete silence response"
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "404": {
+            "description": "A silence with the specified ID was not found"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "ID of the silence to get",
+          "name": "silenceID",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/silence/{silenceID}/approve": {
+      "post": {
+        "description": "Approve a silence pending approval",
+        "tags": [
+          "silence"
+        ],
+        "operationId": "approveSilence",
+        "parameters": [
+          {
+            "description": "The approval decision",
+            "name": "decision",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/silenceApprovalDecision"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Approve silence response"
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "404": {
+            "description": "A silence with the specified ID was not found"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "ID of the silence to approve",
+          "name": "silenceID",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/silence/{silenceID}": {
+    "/silence/{silenceID}/history": {
       "get": {
-        "description": "Get a silence by its ID",
+        "description": "Get the revisions of a silence, oldest first. The last revision is the current state of the silence.",
         "tags": [
           "silence"
         ],
-        "operationId": "getSilence",
+        "operationId": "getSilenceHistory",
         "responses": {
           "200": {
-            "description": "Get silence response",
+            "description": "Get silence history response",
             "schema": {
-              "$ref": "#/definitions/gettableSilence"
+              "$ref": "#/definitions/silenceHistory"
             }
           },
           "404": {
@@ -1063,25 +2564,50 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Delete a silence by its ID",
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "ID of the silence to get the history of",
+          "name": "silenceID",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/silence/{silenceID}/reject": {
+      "post": {
+        "description": "Reject a silence pending approval, expiring it",
         "tags": [
           "silence"
         ],
-        "operationId": "deleteSilence",
+        "operationId": "rejectSilence",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "ID of the silence to get",
-            "name": "silenceID",
-            "in": "path",
-            "required": true
+            "description": "The rejection decision",
+            "name": "decision",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/silenceApprovalDecision"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Delete silence response"
+            "description": "Reject silence response"
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "type": "string"
+            }
           },
           "404": {
             "description": "A silence with the specified ID was not found"
@@ -1098,7 +2624,7 @@ func init() {
         {
           "type": "string",
           "format": "uuid",
-          "description": "ID of the silence to get",
+          "description": "ID of the silence to reject",
           "name": "silenceID",
           "in": "path",
           "required": true
@@ -1122,6 +2648,35 @@ func init() {
             "description": "A list of matchers to filter silences by",
             "name": "filter",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The key to sort silences by, one of startsAt, endsAt and id, prefixed\nwith - to sort in descending order. By default silences are sorted by\nstate and by the time they start or end.\n",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of items to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor returned in the X-Next-Cursor header of the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "The fields to return for each item, as dot-separated paths such as\nlabels or status.state. All fields are returned by default.\n",
+            "name": "fields",
+            "in": "query"
           }
         ],
         "responses": {
@@ -1129,6 +2684,12 @@ func init() {
             "description": "Get silences response",
             "schema": {
               "$ref": "#/definitions/gettableSilences"
+            },
+            "headers": {
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, if there are more items"
+              }
             }
           },
           "400": {
@@ -1180,11 +2741,143 @@ func init() {
               "type": "string"
             }
           },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "404": {
+            "description": "A silence with the specified ID was not found",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "/silences/consolidate": {
+      "post": {
+        "description": "Replace silences with a single silence muting all of their alerts",
+        "tags": [
+          "silence"
+        ],
+        "operationId": "consolidateSilences",
+        "parameters": [
+          {
+            "description": "The silences to consolidate",
+            "name": "consolidation",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/silenceConsolidation"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Consolidate silences response",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "silenceID": {
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "404": {
+            "description": "A silence with one of the specified IDs was not found",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "/silences/overlaps": {
+      "get": {
+        "description": "Get pairs of overlapping silences that have not expired, one of which mutes all alerts muted by the other",
+        "tags": [
+          "silence"
+        ],
+        "operationId": "getSilenceOverlaps",
+        "responses": {
+          "200": {
+            "description": "Get silence overlaps response",
+            "schema": {
+              "$ref": "#/definitions/silenceOverlaps"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "/silences/preview": {
+      "post": {
+        "description": "Preview the alerts a new or updated silence would affect without saving it",
+        "tags": [
+          "silence"
+        ],
+        "operationId": "previewSilence",
+        "parameters": [
+          {
+            "description": "The silence to preview",
+            "name": "silence",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/postableSilence"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Preview silence response",
+            "schema": {
+              "$ref": "#/definitions/silencePreview"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
           "404": {
             "description": "A silence with the specified ID was not found",
             "schema": {
               "type": "string"
             }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
           }
         }
       }
@@ -1208,6 +2901,58 @@ func init() {
     }
   },
   "definitions": {
+    "SilenceStatusNextActiveWindow": {
+      "type": "object",
+      "required": [
+        "startsAt",
+        "endsAt"
+      ],
+      "properties": {
+        "endsAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "startsAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "TimeIntervalTimesItems0": {
+      "type": "object",
+      "required": [
+        "startTime",
+        "endTime"
+      ],
+      "properties": {
+        "endTime": {
+          "type": "string"
+        },
+        "startTime": {
+          "type": "string"
+        }
+      }
+    },
+    "acknowledgement": {
+      "type": "object",
+      "required": [
+        "acknowledgedBy",
+        "acknowledgedAt"
+      ],
+      "properties": {
+        "acknowledgedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "acknowledgedBy": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        }
+      },
+      "readOnly": true
+    },
     "alert": {
       "type": "object",
       "required": [
@@ -1223,34 +2968,107 @@ func init() {
         }
       }
     },
-    "alertGroup": {
+    "alertGroup": {
+      "type": "object",
+      "required": [
+        "labels",
+        "receiver",
+        "alerts"
+      ],
+      "properties": {
+        "alerts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/gettableAlert"
+          }
+        },
+        "labels": {
+          "$ref": "#/definitions/labelSet"
+        },
+        "receiver": {
+          "$ref": "#/definitions/receiver"
+        }
+      }
+    },
+    "alertGroups": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/alertGroup"
+      }
+    },
+    "alertHistory": {
+      "type": "object",
+      "required": [
+        "fingerprint",
+        "labels",
+        "events"
+      ],
+      "properties": {
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertHistoryEvent"
+          }
+        },
+        "fingerprint": {
+          "type": "string"
+        },
+        "labels": {
+          "$ref": "#/definitions/labelSet"
+        }
+      }
+    },
+    "alertHistoryEvent": {
       "type": "object",
       "required": [
-        "labels",
-        "receiver",
-        "alerts"
+        "type",
+        "timestamp"
       ],
       "properties": {
-        "alerts": {
+        "inhibitedBy": {
+          "description": "The alerts inhibiting the alert, for inhibited events",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/gettableAlert"
+            "type": "string"
           }
         },
-        "labels": {
-          "$ref": "#/definitions/labelSet"
+        "integration": {
+          "description": "The integration notified, for notified events",
+          "type": "string"
         },
         "receiver": {
-          "$ref": "#/definitions/receiver"
+          "description": "The receiver notified, for notified events",
+          "type": "string"
+        },
+        "resolved": {
+          "description": "Whether the notification was about the resolution of the alert, for notified events",
+          "type": "boolean"
+        },
+        "silencedBy": {
+          "description": "The silences muting the alert, for silenced events",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "firing",
+            "resolved",
+            "silenced",
+            "unsilenced",
+            "inhibited",
+            "uninhibited",
+            "notified"
+          ]
         }
       }
     },
-    "alertGroups": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/alertGroup"
-      }
-    },
     "alertStatus": {
       "type": "object",
       "required": [
@@ -1259,6 +3077,9 @@ func init() {
         "inhibitedBy"
       ],
       "properties": {
+        "acknowledgement": {
+          "$ref": "#/definitions/acknowledgement"
+        },
         "inhibitedBy": {
           "type": "array",
           "items": {
@@ -1341,6 +3162,59 @@ func init() {
         }
       }
     },
+    "configVersion": {
+      "type": "object",
+      "required": [
+        "id",
+        "source",
+        "appliedAt",
+        "config"
+      ],
+      "properties": {
+        "appliedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "config": {
+          "description": "The configuration, with secrets hidden",
+          "type": "string"
+        },
+        "diff": {
+          "description": "The unified diff of the configuration from the previous version",
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "file",
+            "api",
+            "rollback"
+          ]
+        }
+      }
+    },
+    "configVersions": {
+      "type": "object",
+      "required": [
+        "versions"
+      ],
+      "properties": {
+        "current": {
+          "$ref": "#/definitions/configVersion"
+        },
+        "versions": {
+          "description": "The versions kept for rollbacks, oldest first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configVersion"
+          }
+        }
+      }
+    },
     "gettableAlert": {
       "allOf": [
         {
@@ -1479,6 +3353,20 @@ func init() {
         }
       }
     },
+    "postableAcknowledgement": {
+      "type": "object",
+      "required": [
+        "acknowledgedBy"
+      ],
+      "properties": {
+        "acknowledgedBy": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        }
+      }
+    },
     "postableAlert": {
       "allOf": [
         {
@@ -1508,6 +3396,26 @@ func init() {
         "$ref": "#/definitions/postableAlert"
       }
     },
+    "postableConfig": {
+      "type": "object",
+      "required": [
+        "config"
+      ],
+      "properties": {
+        "config": {
+          "description": "The configuration in YAML",
+          "type": "string"
+        },
+        "dryRun": {
+          "description": "Only validate the configuration, without applying it",
+          "type": "boolean"
+        },
+        "persist": {
+          "description": "Whether to also write the configuration to the configuration file",
+          "type": "boolean"
+        }
+      }
+    },
     "postableSilence": {
       "allOf": [
         {
@@ -1544,6 +3452,9 @@ func init() {
         "comment"
       ],
       "properties": {
+        "approval": {
+          "$ref": "#/definitions/silenceApproval"
+        },
         "comment": {
           "type": "string"
         },
@@ -1560,25 +3471,236 @@ func init() {
         "startsAt": {
           "type": "string",
           "format": "date-time"
+        },
+        "timeIntervals": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/timeInterval"
+          }
+        }
+      }
+    },
+    "silenceApproval": {
+      "type": "object",
+      "required": [
+        "state"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "decidedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "decidedBy": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "approved",
+            "rejected"
+          ]
+        }
+      },
+      "readOnly": true
+    },
+    "silenceApprovalDecision": {
+      "type": "object",
+      "required": [
+        "decidedBy"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "decidedBy": {
+          "type": "string"
+        }
+      }
+    },
+    "silenceConsolidation": {
+      "type": "object",
+      "required": [
+        "silenceIDs",
+        "createdBy"
+      ],
+      "properties": {
+        "createdBy": {
+          "type": "string"
+        },
+        "silenceIDs": {
+          "type": "array",
+          "minItems": 2,
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "silenceHistory": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/silenceRevision"
+      }
+    },
+    "silenceOverlap": {
+      "description": "A pair of silences with overlapping time ranges, where the silence with ID silenceID mutes all alerts muted by the one with ID otherSilenceID",
+      "type": "object",
+      "required": [
+        "kind",
+        "silenceID",
+        "otherSilenceID"
+      ],
+      "properties": {
+        "kind": {
+          "type": "string",
+          "enum": [
+            "duplicate",
+            "subsumes"
+          ]
+        },
+        "otherSilenceID": {
+          "type": "string"
+        },
+        "silenceID": {
+          "type": "string"
+        }
+      }
+    },
+    "silenceOverlaps": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/silenceOverlap"
+      }
+    },
+    "silencePreview": {
+      "type": "object",
+      "required": [
+        "alerts",
+        "unmutedAlerts",
+        "receivers",
+        "groups"
+      ],
+      "properties": {
+        "alerts": {
+          "description": "The alerts the silence would mute",
+          "$ref": "#/definitions/gettableAlerts"
+        },
+        "groups": {
+          "description": "The aggregation groups containing alerts the silence would mute",
+          "$ref": "#/definitions/alertGroups"
+        },
+        "receivers": {
+          "description": "The receivers of the alerts the silence would mute",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/receiver"
+          }
+        },
+        "unmutedAlerts": {
+          "description": "The alerts muted by the silence being updated that it would no longer mute",
+          "$ref": "#/definitions/gettableAlerts"
         }
       }
     },
+    "silenceRevision": {
+      "allOf": [
+        {
+          "type": "object",
+          "required": [
+            "id",
+            "updatedAt"
+          ],
+          "properties": {
+            "id": {
+              "type": "string"
+            },
+            "updatedAt": {
+              "type": "string",
+              "format": "date-time"
+            }
+          }
+        },
+        {
+          "$ref": "#/definitions/silence"
+        }
+      ]
+    },
     "silenceStatus": {
       "type": "object",
       "required": [
         "state"
       ],
       "properties": {
+        "nextActiveWindow": {
+          "type": "object",
+          "required": [
+            "startsAt",
+            "endsAt"
+          ],
+          "properties": {
+            "endsAt": {
+              "type": "string",
+              "format": "date-time"
+            },
+            "startsAt": {
+              "type": "string",
+              "format": "date-time"
+            }
+          }
+        },
         "state": {
           "type": "string",
           "enum": [
             "expired",
             "active",
-            "pending"
+            "pending",
+            "pending_approval"
           ]
         }
       }
     },
+    "timeInterval": {
+      "type": "object",
+      "properties": {
+        "daysOfMonth": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "location": {
+          "type": "string"
+        },
+        "months": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "times": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TimeIntervalTimesItems0"
+          }
+        },
+        "weekdays": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "years": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "versionInfo": {
       "type": "object",
       "required": [
@@ -1611,6 +3733,31 @@ func init() {
       }
     }
   },
+  "parameters": {
+    "cursor": {
+      "type": "string",
+      "description": "The cursor returned in the X-Next-Cursor header of the previous page",
+      "name": "cursor",
+      "in": "query"
+    },
+    "fields": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "collectionFormat": "multi",
+      "description": "The fields to return for each item, as dot-separated paths such as\nlabels or status.state. All fields are returned by default.\n",
+      "name": "fields",
+      "in": "query"
+    },
+    "limit": {
+      "minimum": 1,
+      "type": "integer",
+      "description": "The maximum number of items to return",
+      "name": "limit",
+      "in": "query"
+    }
+  },
   "responses": {
     "BadRequest": {
       "description": "Bad request",
@@ -1618,6 +3765,12 @@ func init() {
         "type": "string"
       }
     },
+    "Forbidden": {
+      "description": "Forbidden",
+      "schema": {
+        "type": "string"
+      }
+    },
     "InternalServerError": {
       "description": "Internal server error",
       "schema": {
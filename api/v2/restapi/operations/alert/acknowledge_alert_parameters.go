@@ -0,0 +1,123 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// NewAcknowledgeAlertParams creates a new AcknowledgeAlertParams object
+//
+// There are no default values defined in the spec.
+func NewAcknowledgeAlertParams() AcknowledgeAlertParams {
+
+	return AcknowledgeAlertParams{}
+}
+
+// AcknowledgeAlertParams contains all the bound params for the acknowledge alert operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters acknowledgeAlert
+type AcknowledgeAlertParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The acknowledgement
+	  Required: true
+	  In: body
+	*/
+	Acknowledgement *models.PostableAcknowledgement
+	/*Fingerprint of the alert
+	  Required: true
+	  In: path
+	*/
+	Fingerprint string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewAcknowledgeAlertParams() beforehand.
+func (o *AcknowledgeAlertParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.PostableAcknowledgement
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("acknowledgement", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("acknowledgement", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(r.Context())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Acknowledgement = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("acknowledgement", "body", ""))
+	}
+
+	rFingerprint, rhkFingerprint, _ := route.Params.GetOK("fingerprint")
+	if err := o.bindFingerprint(rFingerprint, rhkFingerprint, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindFingerprint binds and validates parameter Fingerprint from path.
+func (o *AcknowledgeAlertParams) bindFingerprint(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.Fingerprint = raw
+
+	return nil
+}
@@ -0,0 +1,205 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+)
+
+// AcknowledgeAlertOKCode is the HTTP code returned for type AcknowledgeAlertOK
+const AcknowledgeAlertOKCode int = 200
+
+/*
+AcknowledgeAlertOK Acknowledge alert response
+
+swagger:response acknowledgeAlertOK
+*/
+type AcknowledgeAlertOK struct {
+}
+
+// NewAcknowledgeAlertOK creates AcknowledgeAlertOK with default headers values
+func NewAcknowledgeAlertOK() *AcknowledgeAlertOK {
+
+	return &AcknowledgeAlertOK{}
+}
+
+// WriteResponse to the client
+func (o *AcknowledgeAlertOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// AcknowledgeAlertBadRequestCode is the HTTP code returned for type AcknowledgeAlertBadRequest
+const AcknowledgeAlertBadRequestCode int = 400
+
+/*
+AcknowledgeAlertBadRequest Bad request
+
+swagger:response acknowledgeAlertBadRequest
+*/
+type AcknowledgeAlertBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewAcknowledgeAlertBadRequest creates AcknowledgeAlertBadRequest with default headers values
+func NewAcknowledgeAlertBadRequest() *AcknowledgeAlertBadRequest {
+
+	return &AcknowledgeAlertBadRequest{}
+}
+
+// WithPayload adds the payload to the acknowledge alert bad request response
+func (o *AcknowledgeAlertBadRequest) WithPayload(payload string) *AcknowledgeAlertBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the acknowledge alert bad request response
+func (o *AcknowledgeAlertBadRequest) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AcknowledgeAlertBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// AcknowledgeAlertForbiddenCode is the HTTP code returned for type AcknowledgeAlertForbidden
+const AcknowledgeAlertForbiddenCode int = 403
+
+/*
+AcknowledgeAlertForbidden Forbidden
+
+swagger:response acknowledgeAlertForbidden
+*/
+type AcknowledgeAlertForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewAcknowledgeAlertForbidden creates AcknowledgeAlertForbidden with default headers values
+func NewAcknowledgeAlertForbidden() *AcknowledgeAlertForbidden {
+
+	return &AcknowledgeAlertForbidden{}
+}
+
+// WithPayload adds the payload to the acknowledge alert forbidden response
+func (o *AcknowledgeAlertForbidden) WithPayload(payload string) *AcknowledgeAlertForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the acknowledge alert forbidden response
+func (o *AcknowledgeAlertForbidden) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AcknowledgeAlertForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// AcknowledgeAlertNotFoundCode is the HTTP code returned for type AcknowledgeAlertNotFound
+const AcknowledgeAlertNotFoundCode int = 404
+
+/*
+AcknowledgeAlertNotFound A firing alert with the specified fingerprint was not found
+
+swagger:response acknowledgeAlertNotFound
+*/
+type AcknowledgeAlertNotFound struct {
+}
+
+// NewAcknowledgeAlertNotFound creates AcknowledgeAlertNotFound with default headers values
+func NewAcknowledgeAlertNotFound() *AcknowledgeAlertNotFound {
+
+	return &AcknowledgeAlertNotFound{}
+}
+
+// WriteResponse to the client
+func (o *AcknowledgeAlertNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// AcknowledgeAlertInternalServerErrorCode is the HTTP code returned for type AcknowledgeAlertInternalServerError
+const AcknowledgeAlertInternalServerErrorCode int = 500
+
+/*
+AcknowledgeAlertInternalServerError Internal server error
+
+swagger:response acknowledgeAlertInternalServerError
+*/
+type AcknowledgeAlertInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewAcknowledgeAlertInternalServerError creates AcknowledgeAlertInternalServerError with default headers values
+func NewAcknowledgeAlertInternalServerError() *AcknowledgeAlertInternalServerError {
+
+	return &AcknowledgeAlertInternalServerError{}
+}
+
+// WithPayload adds the payload to the acknowledge alert internal server error response
+func (o *AcknowledgeAlertInternalServerError) WithPayload(payload string) *AcknowledgeAlertInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the acknowledge alert internal server error response
+func (o *AcknowledgeAlertInternalServerError) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AcknowledgeAlertInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
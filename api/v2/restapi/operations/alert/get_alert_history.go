@@ -0,0 +1,70 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetAlertHistoryHandlerFunc turns a function with the right signature into a get alert history handler
+type GetAlertHistoryHandlerFunc func(GetAlertHistoryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetAlertHistoryHandlerFunc) Handle(params GetAlertHistoryParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetAlertHistoryHandler interface for that can handle valid get alert history params
+type GetAlertHistoryHandler interface {
+	Handle(GetAlertHistoryParams) middleware.Responder
+}
+
+// NewGetAlertHistory creates a new http.Handler for the get alert history operation
+func NewGetAlertHistory(ctx *middleware.Context, handler GetAlertHistoryHandler) *GetAlertHistory {
+	return &GetAlertHistory{Context: ctx, Handler: handler}
+}
+
+/*
+	GetAlertHistory swagger:route GET /alerts/{fingerprint}/history alert getAlertHistory
+
+Get the state transitions of an alert, oldest first
+*/
+type GetAlertHistory struct {
+	Context *middleware.Context
+	Handler GetAlertHistoryHandler
+}
+
+func (o *GetAlertHistory) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetAlertHistoryParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,85 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetAlertHistoryParams creates a new GetAlertHistoryParams object
+//
+// There are no default values defined in the spec.
+func NewGetAlertHistoryParams() GetAlertHistoryParams {
+
+	return GetAlertHistoryParams{}
+}
+
+// GetAlertHistoryParams contains all the bound params for the get alert history operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getAlertHistory
+type GetAlertHistoryParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Fingerprint of the alert
+	  Required: true
+	  In: path
+	*/
+	Fingerprint string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetAlertHistoryParams() beforehand.
+func (o *GetAlertHistoryParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rFingerprint, rhkFingerprint, _ := route.Params.GetOK("fingerprint")
+	if err := o.bindFingerprint(rFingerprint, rhkFingerprint, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindFingerprint binds and validates parameter Fingerprint from path.
+func (o *GetAlertHistoryParams) bindFingerprint(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.Fingerprint = raw
+
+	return nil
+}
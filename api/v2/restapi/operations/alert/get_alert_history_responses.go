@@ -0,0 +1,184 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// GetAlertHistoryOKCode is the HTTP code returned for type GetAlertHistoryOK
+const GetAlertHistoryOKCode int = 200
+
+/*
+GetAlertHistoryOK Get alert history response
+
+swagger:response getAlertHistoryOK
+*/
+type GetAlertHistoryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.AlertHistory `json:"body,omitempty"`
+}
+
+// NewGetAlertHistoryOK creates GetAlertHistoryOK with default headers values
+func NewGetAlertHistoryOK() *GetAlertHistoryOK {
+
+	return &GetAlertHistoryOK{}
+}
+
+// WithPayload adds the payload to the get alert history o k response
+func (o *GetAlertHistoryOK) WithPayload(payload *models.AlertHistory) *GetAlertHistoryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get alert history o k response
+func (o *GetAlertHistoryOK) SetPayload(payload *models.AlertHistory) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAlertHistoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetAlertHistoryBadRequestCode is the HTTP code returned for type GetAlertHistoryBadRequest
+const GetAlertHistoryBadRequestCode int = 400
+
+/*
+GetAlertHistoryBadRequest Bad request
+
+swagger:response getAlertHistoryBadRequest
+*/
+type GetAlertHistoryBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewGetAlertHistoryBadRequest creates GetAlertHistoryBadRequest with default headers values
+func NewGetAlertHistoryBadRequest() *GetAlertHistoryBadRequest {
+
+	return &GetAlertHistoryBadRequest{}
+}
+
+// WithPayload adds the payload to the get alert history bad request response
+func (o *GetAlertHistoryBadRequest) WithPayload(payload string) *GetAlertHistoryBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get alert history bad request response
+func (o *GetAlertHistoryBadRequest) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAlertHistoryBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// GetAlertHistoryNotFoundCode is the HTTP code returned for type GetAlertHistoryNotFound
+const GetAlertHistoryNotFoundCode int = 404
+
+/*
+GetAlertHistoryNotFound No history of the alert with the specified fingerprint was found
+
+swagger:response getAlertHistoryNotFound
+*/
+type GetAlertHistoryNotFound struct {
+}
+
+// NewGetAlertHistoryNotFound creates GetAlertHistoryNotFound with default headers values
+func NewGetAlertHistoryNotFound() *GetAlertHistoryNotFound {
+
+	return &GetAlertHistoryNotFound{}
+}
+
+// WriteResponse to the client
+func (o *GetAlertHistoryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// GetAlertHistoryInternalServerErrorCode is the HTTP code returned for type GetAlertHistoryInternalServerError
+const GetAlertHistoryInternalServerErrorCode int = 500
+
+/*
+GetAlertHistoryInternalServerError Internal server error
+
+swagger:response getAlertHistoryInternalServerError
+*/
+type GetAlertHistoryInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewGetAlertHistoryInternalServerError creates GetAlertHistoryInternalServerError with default headers values
+func NewGetAlertHistoryInternalServerError() *GetAlertHistoryInternalServerError {
+
+	return &GetAlertHistoryInternalServerError{}
+}
+
+// WithPayload adds the payload to the get alert history internal server error response
+func (o *GetAlertHistoryInternalServerError) WithPayload(payload string) *GetAlertHistoryInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get alert history internal server error response
+func (o *GetAlertHistoryInternalServerError) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAlertHistoryInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // NewGetAlertsParams creates a new GetAlertsParams object
@@ -40,7 +41,8 @@ func NewGetAlertsParams() GetAlertsParams {
 
 		inhibitedDefault = bool(true)
 
-		silencedDefault    = bool(true)
+		silencedDefault = bool(true)
+
 		unprocessedDefault = bool(true)
 	)
 
@@ -69,6 +71,17 @@ type GetAlertsParams struct {
 	  Default: true
 	*/
 	Active *bool
+	/*The cursor returned in the X-Next-Cursor header of the previous page
+	  In: query
+	*/
+	Cursor *string
+	/*The fields to return for each item, as dot-separated paths such as
+	labels or status.state. All fields are returned by default.
+
+	  In: query
+	  Collection Format: multi
+	*/
+	Fields []string
 	/*A list of matchers to filter alerts by
 	  In: query
 	  Collection Format: multi
@@ -79,6 +92,11 @@ type GetAlertsParams struct {
 	  Default: true
 	*/
 	Inhibited *bool
+	/*The maximum number of items to return
+	  Minimum: 1
+	  In: query
+	*/
+	Limit *int64
 	/*A regex matching receivers to filter alerts by
 	  In: query
 	*/
@@ -88,6 +106,13 @@ type GetAlertsParams struct {
 	  Default: true
 	*/
 	Silenced *bool
+	/*The key to sort alerts by, one of fingerprint, startsAt and severity,
+	prefixed with - to sort in descending order. Alerts are sorted by
+	fingerprint by default.
+
+	  In: query
+	*/
+	Sort *string
 	/*Show unprocessed alerts
 	  In: query
 	  Default: true
@@ -111,6 +136,16 @@ func (o *GetAlertsParams) BindRequest(r *http.Request, route *middleware.Matched
 		res = append(res, err)
 	}
 
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qFields, qhkFields, _ := qs.GetOK("fields")
+	if err := o.bindFields(qFields, qhkFields, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qFilter, qhkFilter, _ := qs.GetOK("filter")
 	if err := o.bindFilter(qFilter, qhkFilter, route.Formats); err != nil {
 		res = append(res, err)
@@ -121,6 +156,11 @@ func (o *GetAlertsParams) BindRequest(r *http.Request, route *middleware.Matched
 		res = append(res, err)
 	}
 
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qReceiver, qhkReceiver, _ := qs.GetOK("receiver")
 	if err := o.bindReceiver(qReceiver, qhkReceiver, route.Formats); err != nil {
 		res = append(res, err)
@@ -131,6 +171,11 @@ func (o *GetAlertsParams) BindRequest(r *http.Request, route *middleware.Matched
 		res = append(res, err)
 	}
 
+	qSort, qhkSort, _ := qs.GetOK("sort")
+	if err := o.bindSort(qSort, qhkSort, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qUnprocessed, qhkUnprocessed, _ := qs.GetOK("unprocessed")
 	if err := o.bindUnprocessed(qUnprocessed, qhkUnprocessed, route.Formats); err != nil {
 		res = append(res, err)
@@ -165,6 +210,46 @@ func (o *GetAlertsParams) bindActive(rawData []string, hasKey bool, formats strf
 	return nil
 }
 
+// bindCursor binds and validates parameter Cursor from query.
+func (o *GetAlertsParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Cursor = &raw
+
+	return nil
+}
+
+// bindFields binds and validates array parameter Fields from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *GetAlertsParams) bindFields(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	fieldsIC := rawData
+	if len(fieldsIC) == 0 {
+		return nil
+	}
+
+	var fieldsIR []string
+	for _, fieldsIV := range fieldsIC {
+		fieldsI := fieldsIV
+
+		fieldsIR = append(fieldsIR, fieldsI)
+	}
+
+	o.Fields = fieldsIR
+
+	return nil
+}
+
 // bindFilter binds and validates array parameter Filter from query.
 //
 // Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
@@ -211,6 +296,43 @@ func (o *GetAlertsParams) bindInhibited(rawData []string, hasKey bool, formats s
 	return nil
 }
 
+// bindLimit binds and validates parameter Limit from query.
+func (o *GetAlertsParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *GetAlertsParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // bindReceiver binds and validates parameter Receiver from query.
 func (o *GetAlertsParams) bindReceiver(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -253,6 +375,24 @@ func (o *GetAlertsParams) bindSilenced(rawData []string, hasKey bool, formats st
 	return nil
 }
 
+// bindSort binds and validates parameter Sort from query.
+func (o *GetAlertsParams) bindSort(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Sort = &raw
+
+	return nil
+}
+
 // bindUnprocessed binds and validates parameter Unprocessed from query.
 func (o *GetAlertsParams) bindUnprocessed(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -36,6 +36,10 @@ GetAlertsOK Get alerts response
 swagger:response getAlertsOK
 */
 type GetAlertsOK struct {
+	/*The cursor of the next page, if there are more items
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
 
 	/*
 	  In: Body
@@ -49,6 +53,17 @@ func NewGetAlertsOK() *GetAlertsOK {
 	return &GetAlertsOK{}
 }
 
+// WithXNextCursor adds the xNextCursor to the get alerts o k response
+func (o *GetAlertsOK) WithXNextCursor(xNextCursor string) *GetAlertsOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the get alerts o k response
+func (o *GetAlertsOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
 // WithPayload adds the payload to the get alerts o k response
 func (o *GetAlertsOK) WithPayload(payload models.GettableAlerts) *GetAlertsOK {
 	o.Payload = payload
@@ -63,6 +78,13 @@ func (o *GetAlertsOK) SetPayload(payload models.GettableAlerts) {
 // WriteResponse to the client
 func (o *GetAlertsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -30,10 +30,14 @@ import (
 // GetAlertsURL generates an URL for the get alerts operation
 type GetAlertsURL struct {
 	Active      *bool
+	Cursor      *string
+	Fields      []string
 	Filter      []string
 	Inhibited   *bool
+	Limit       *int64
 	Receiver    *string
 	Silenced    *bool
+	Sort        *string
 	Unprocessed *bool
 
 	_basePath string
@@ -78,6 +82,28 @@ func (o *GetAlertsURL) Build() (*url.URL, error) {
 		qs.Set("active", activeQ)
 	}
 
+	var cursorQ string
+	if o.Cursor != nil {
+		cursorQ = *o.Cursor
+	}
+	if cursorQ != "" {
+		qs.Set("cursor", cursorQ)
+	}
+
+	var fieldsIR []string
+	for _, fieldsI := range o.Fields {
+		fieldsIS := fieldsI
+		if fieldsIS != "" {
+			fieldsIR = append(fieldsIR, fieldsIS)
+		}
+	}
+
+	fields := swag.JoinByFormat(fieldsIR, "multi")
+
+	for _, qsv := range fields {
+		qs.Add("fields", qsv)
+	}
+
 	var filterIR []string
 	for _, filterI := range o.Filter {
 		filterIS := filterI
@@ -100,6 +126,14 @@ func (o *GetAlertsURL) Build() (*url.URL, error) {
 		qs.Set("inhibited", inhibitedQ)
 	}
 
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt64(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
 	var receiverQ string
 	if o.Receiver != nil {
 		receiverQ = *o.Receiver
@@ -116,6 +150,14 @@ func (o *GetAlertsURL) Build() (*url.URL, error) {
 		qs.Set("silenced", silencedQ)
 	}
 
+	var sortQ string
+	if o.Sort != nil {
+		sortQ = *o.Sort
+	}
+	if sortQ != "" {
+		qs.Set("sort", sortQ)
+	}
+
 	var unprocessedQ string
 	if o.Unprocessed != nil {
 		unprocessedQ = swag.FormatBool(*o.Unprocessed)
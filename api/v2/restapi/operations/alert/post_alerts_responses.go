@@ -93,6 +93,49 @@ func (o *PostAlertsBadRequest) WriteResponse(rw http.ResponseWriter, producer ru
 	}
 }
 
+// PostAlertsForbiddenCode is the HTTP code returned for type PostAlertsForbidden
+const PostAlertsForbiddenCode int = 403
+
+/*
+PostAlertsForbidden Forbidden
+
+swagger:response postAlertsForbidden
+*/
+type PostAlertsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewPostAlertsForbidden creates PostAlertsForbidden with default headers values
+func NewPostAlertsForbidden() *PostAlertsForbidden {
+
+	return &PostAlertsForbidden{}
+}
+
+// WithPayload adds the payload to the post alerts forbidden response
+func (o *PostAlertsForbidden) WithPayload(payload string) *PostAlertsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post alerts forbidden response
+func (o *PostAlertsForbidden) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostAlertsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
 // PostAlertsInternalServerErrorCode is the HTTP code returned for type PostAlertsInternalServerError
 const PostAlertsInternalServerErrorCode int = 500
 
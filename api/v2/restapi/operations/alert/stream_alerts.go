@@ -0,0 +1,74 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// StreamAlertsHandlerFunc turns a function with the right signature into a stream alerts handler
+type StreamAlertsHandlerFunc func(StreamAlertsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn StreamAlertsHandlerFunc) Handle(params StreamAlertsParams) middleware.Responder {
+	return fn(params)
+}
+
+// StreamAlertsHandler interface for that can handle valid stream alerts params
+type StreamAlertsHandler interface {
+	Handle(StreamAlertsParams) middleware.Responder
+}
+
+// NewStreamAlerts creates a new http.Handler for the stream alerts operation
+func NewStreamAlerts(ctx *middleware.Context, handler StreamAlertsHandler) *StreamAlerts {
+	return &StreamAlerts{Context: ctx, Handler: handler}
+}
+
+/*
+	StreamAlerts swagger:route GET /alerts/stream alert streamAlerts
+
+Stream changes to alerts as Server-Sent Events. Each event has one of
+the types created, updated, resolved, silenced, unsilenced, inhibited
+and uninhibited, and carries the alert as JSON. The stream starts with
+a created event for every firing alert, unless it resumes from the
+event ID given in the Last-Event-ID header.
+*/
+type StreamAlerts struct {
+	Context *middleware.Context
+	Handler StreamAlertsHandler
+}
+
+func (o *StreamAlerts) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewStreamAlertsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
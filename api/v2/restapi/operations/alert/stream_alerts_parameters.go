@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewStreamAlertsParams creates a new StreamAlertsParams object
+//
+// There are no default values defined in the spec.
+func NewStreamAlertsParams() StreamAlertsParams {
+
+	return StreamAlertsParams{}
+}
+
+// StreamAlertsParams contains all the bound params for the stream alerts operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters streamAlerts
+type StreamAlertsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The ID of the last event received, to resume a stream from
+	  In: header
+	*/
+	LastEventID *string
+	/*A list of matchers to filter alerts by
+	  In: query
+	  Collection Format: multi
+	*/
+	Filter []string
+	/*A regex matching receivers to filter alerts by
+	  In: query
+	*/
+	Receiver *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewStreamAlertsParams() beforehand.
+func (o *StreamAlertsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	if err := o.bindLastEventID(r.Header[http.CanonicalHeaderKey("Last-Event-ID")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qFilter, qhkFilter, _ := qs.GetOK("filter")
+	if err := o.bindFilter(qFilter, qhkFilter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qReceiver, qhkReceiver, _ := qs.GetOK("receiver")
+	if err := o.bindReceiver(qReceiver, qhkReceiver, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindLastEventID binds and validates parameter LastEventID from header.
+func (o *StreamAlertsParams) bindLastEventID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.LastEventID = &raw
+
+	return nil
+}
+
+// bindFilter binds and validates array parameter Filter from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *StreamAlertsParams) bindFilter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	filterIC := rawData
+	if len(filterIC) == 0 {
+		return nil
+	}
+
+	var filterIR []string
+	for _, filterIV := range filterIC {
+		filterI := filterIV
+
+		filterIR = append(filterIR, filterI)
+	}
+
+	o.Filter = filterIR
+
+	return nil
+}
+
+// bindReceiver binds and validates parameter Receiver from query.
+func (o *StreamAlertsParams) bindReceiver(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Receiver = &raw
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// UnacknowledgeAlertHandlerFunc turns a function with the right signature into a unacknowledge alert handler
+type UnacknowledgeAlertHandlerFunc func(UnacknowledgeAlertParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UnacknowledgeAlertHandlerFunc) Handle(params UnacknowledgeAlertParams) middleware.Responder {
+	return fn(params)
+}
+
+// UnacknowledgeAlertHandler interface for that can handle valid unacknowledge alert params
+type UnacknowledgeAlertHandler interface {
+	Handle(UnacknowledgeAlertParams) middleware.Responder
+}
+
+// NewUnacknowledgeAlert creates a new http.Handler for the unacknowledge alert operation
+func NewUnacknowledgeAlert(ctx *middleware.Context, handler UnacknowledgeAlertHandler) *UnacknowledgeAlert {
+	return &UnacknowledgeAlert{Context: ctx, Handler: handler}
+}
+
+/*
+	UnacknowledgeAlert swagger:route DELETE /alert/{fingerprint}/acknowledgement alert unacknowledgeAlert
+
+Withdraw the acknowledgement of an alert
+*/
+type UnacknowledgeAlert struct {
+	Context *middleware.Context
+	Handler UnacknowledgeAlertHandler
+}
+
+func (o *UnacknowledgeAlert) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewUnacknowledgeAlertParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,162 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+)
+
+// UnacknowledgeAlertOKCode is the HTTP code returned for type UnacknowledgeAlertOK
+const UnacknowledgeAlertOKCode int = 200
+
+/*
+UnacknowledgeAlertOK Unacknowledge alert response
+
+swagger:response unacknowledgeAlertOK
+*/
+type UnacknowledgeAlertOK struct {
+}
+
+// NewUnacknowledgeAlertOK creates UnacknowledgeAlertOK with default headers values
+func NewUnacknowledgeAlertOK() *UnacknowledgeAlertOK {
+
+	return &UnacknowledgeAlertOK{}
+}
+
+// WriteResponse to the client
+func (o *UnacknowledgeAlertOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// UnacknowledgeAlertForbiddenCode is the HTTP code returned for type UnacknowledgeAlertForbidden
+const UnacknowledgeAlertForbiddenCode int = 403
+
+/*
+UnacknowledgeAlertForbidden Forbidden
+
+swagger:response unacknowledgeAlertForbidden
+*/
+type UnacknowledgeAlertForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewUnacknowledgeAlertForbidden creates UnacknowledgeAlertForbidden with default headers values
+func NewUnacknowledgeAlertForbidden() *UnacknowledgeAlertForbidden {
+
+	return &UnacknowledgeAlertForbidden{}
+}
+
+// WithPayload adds the payload to the unacknowledge alert forbidden response
+func (o *UnacknowledgeAlertForbidden) WithPayload(payload string) *UnacknowledgeAlertForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the unacknowledge alert forbidden response
+func (o *UnacknowledgeAlertForbidden) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UnacknowledgeAlertForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// UnacknowledgeAlertNotFoundCode is the HTTP code returned for type UnacknowledgeAlertNotFound
+const UnacknowledgeAlertNotFoundCode int = 404
+
+/*
+UnacknowledgeAlertNotFound An acknowledgement of the alert with the specified fingerprint was not found
+
+swagger:response unacknowledgeAlertNotFound
+*/
+type UnacknowledgeAlertNotFound struct {
+}
+
+// NewUnacknowledgeAlertNotFound creates UnacknowledgeAlertNotFound with default headers values
+func NewUnacknowledgeAlertNotFound() *UnacknowledgeAlertNotFound {
+
+	return &UnacknowledgeAlertNotFound{}
+}
+
+// WriteResponse to the client
+func (o *UnacknowledgeAlertNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// UnacknowledgeAlertInternalServerErrorCode is the HTTP code returned for type UnacknowledgeAlertInternalServerError
+const UnacknowledgeAlertInternalServerErrorCode int = 500
+
+/*
+UnacknowledgeAlertInternalServerError Internal server error
+
+swagger:response unacknowledgeAlertInternalServerError
+*/
+type UnacknowledgeAlertInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewUnacknowledgeAlertInternalServerError creates UnacknowledgeAlertInternalServerError with default headers values
+func NewUnacknowledgeAlertInternalServerError() *UnacknowledgeAlertInternalServerError {
+
+	return &UnacknowledgeAlertInternalServerError{}
+}
+
+// WithPayload adds the payload to the unacknowledge alert internal server error response
+func (o *UnacknowledgeAlertInternalServerError) WithPayload(payload string) *UnacknowledgeAlertInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the unacknowledge alert internal server error response
+func (o *UnacknowledgeAlertInternalServerError) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UnacknowledgeAlertInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
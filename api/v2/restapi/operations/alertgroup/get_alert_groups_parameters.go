@@ -27,6 +27,7 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // NewGetAlertGroupsParams creates a new GetAlertGroupsParams object
@@ -66,6 +67,17 @@ type GetAlertGroupsParams struct {
 	  Default: true
 	*/
 	Active *bool
+	/*The cursor returned in the X-Next-Cursor header of the previous page
+	  In: query
+	*/
+	Cursor *string
+	/*The fields to return for each item, as dot-separated paths such as
+	labels or status.state. All fields are returned by default.
+
+	  In: query
+	  Collection Format: multi
+	*/
+	Fields []string
 	/*A list of matchers to filter alerts by
 	  In: query
 	  Collection Format: multi
@@ -76,6 +88,11 @@ type GetAlertGroupsParams struct {
 	  Default: true
 	*/
 	Inhibited *bool
+	/*The maximum number of items to return
+	  Minimum: 1
+	  In: query
+	*/
+	Limit *int64
 	/*A regex matching receivers to filter alerts by
 	  In: query
 	*/
@@ -85,6 +102,14 @@ type GetAlertGroupsParams struct {
 	  Default: true
 	*/
 	Silenced *bool
+	/*The key to sort alert groups by, one of labels, startsAt and severity,
+	prefixed with - to sort in descending order. startsAt is the start of
+	the earliest alert of a group and severity its most severe alert.
+	Alert groups are sorted by labels and receiver by default.
+
+	  In: query
+	*/
+	Sort *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -103,6 +128,16 @@ func (o *GetAlertGroupsParams) BindRequest(r *http.Request, route *middleware.Ma
 		res = append(res, err)
 	}
 
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qFields, qhkFields, _ := qs.GetOK("fields")
+	if err := o.bindFields(qFields, qhkFields, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qFilter, qhkFilter, _ := qs.GetOK("filter")
 	if err := o.bindFilter(qFilter, qhkFilter, route.Formats); err != nil {
 		res = append(res, err)
@@ -113,6 +148,11 @@ func (o *GetAlertGroupsParams) BindRequest(r *http.Request, route *middleware.Ma
 		res = append(res, err)
 	}
 
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qReceiver, qhkReceiver, _ := qs.GetOK("receiver")
 	if err := o.bindReceiver(qReceiver, qhkReceiver, route.Formats); err != nil {
 		res = append(res, err)
@@ -122,6 +162,11 @@ func (o *GetAlertGroupsParams) BindRequest(r *http.Request, route *middleware.Ma
 	if err := o.bindSilenced(qSilenced, qhkSilenced, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qSort, qhkSort, _ := qs.GetOK("sort")
+	if err := o.bindSort(qSort, qhkSort, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -152,6 +197,46 @@ func (o *GetAlertGroupsParams) bindActive(rawData []string, hasKey bool, formats
 	return nil
 }
 
+// bindCursor binds and validates parameter Cursor from query.
+func (o *GetAlertGroupsParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Cursor = &raw
+
+	return nil
+}
+
+// bindFields binds and validates array parameter Fields from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *GetAlertGroupsParams) bindFields(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	fieldsIC := rawData
+	if len(fieldsIC) == 0 {
+		return nil
+	}
+
+	var fieldsIR []string
+	for _, fieldsIV := range fieldsIC {
+		fieldsI := fieldsIV
+
+		fieldsIR = append(fieldsIR, fieldsI)
+	}
+
+	o.Fields = fieldsIR
+
+	return nil
+}
+
 // bindFilter binds and validates array parameter Filter from query.
 //
 // Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
@@ -198,6 +283,43 @@ func (o *GetAlertGroupsParams) bindInhibited(rawData []string, hasKey bool, form
 	return nil
 }
 
+// bindLimit binds and validates parameter Limit from query.
+func (o *GetAlertGroupsParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *GetAlertGroupsParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // bindReceiver binds and validates parameter Receiver from query.
 func (o *GetAlertGroupsParams) bindReceiver(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -239,3 +361,21 @@ func (o *GetAlertGroupsParams) bindSilenced(rawData []string, hasKey bool, forma
 
 	return nil
 }
+
+// bindSort binds and validates parameter Sort from query.
+func (o *GetAlertGroupsParams) bindSort(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Sort = &raw
+
+	return nil
+}
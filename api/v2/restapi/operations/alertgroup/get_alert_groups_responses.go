@@ -36,6 +36,10 @@ GetAlertGroupsOK Get alert groups response
 swagger:response getAlertGroupsOK
 */
 type GetAlertGroupsOK struct {
+	/*The cursor of the next page, if there are more items
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
 
 	/*
 	  In: Body
@@ -49,6 +53,17 @@ func NewGetAlertGroupsOK() *GetAlertGroupsOK {
 	return &GetAlertGroupsOK{}
 }
 
+// WithXNextCursor adds the xNextCursor to the get alert groups o k response
+func (o *GetAlertGroupsOK) WithXNextCursor(xNextCursor string) *GetAlertGroupsOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the get alert groups o k response
+func (o *GetAlertGroupsOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
 // WithPayload adds the payload to the get alert groups o k response
 func (o *GetAlertGroupsOK) WithPayload(payload models.AlertGroups) *GetAlertGroupsOK {
 	o.Payload = payload
@@ -63,6 +78,13 @@ func (o *GetAlertGroupsOK) SetPayload(payload models.AlertGroups) {
 // WriteResponse to the client
 func (o *GetAlertGroupsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
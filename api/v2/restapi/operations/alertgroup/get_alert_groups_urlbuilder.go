@@ -30,10 +30,14 @@ import (
 // GetAlertGroupsURL generates an URL for the get alert groups operation
 type GetAlertGroupsURL struct {
 	Active    *bool
+	Cursor    *string
+	Fields    []string
 	Filter    []string
 	Inhibited *bool
+	Limit     *int64
 	Receiver  *string
 	Silenced  *bool
+	Sort      *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -77,6 +81,28 @@ func (o *GetAlertGroupsURL) Build() (*url.URL, error) {
 		qs.Set("active", activeQ)
 	}
 
+	var cursorQ string
+	if o.Cursor != nil {
+		cursorQ = *o.Cursor
+	}
+	if cursorQ != "" {
+		qs.Set("cursor", cursorQ)
+	}
+
+	var fieldsIR []string
+	for _, fieldsI := range o.Fields {
+		fieldsIS := fieldsI
+		if fieldsIS != "" {
+			fieldsIR = append(fieldsIR, fieldsIS)
+		}
+	}
+
+	fields := swag.JoinByFormat(fieldsIR, "multi")
+
+	for _, qsv := range fields {
+		qs.Add("fields", qsv)
+	}
+
 	var filterIR []string
 	for _, filterI := range o.Filter {
 		filterIS := filterI
@@ -99,6 +125,14 @@ func (o *GetAlertGroupsURL) Build() (*url.URL, error) {
 		qs.Set("inhibited", inhibitedQ)
 	}
 
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt64(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
 	var receiverQ string
 	if o.Receiver != nil {
 		receiverQ = *o.Receiver
@@ -115,6 +149,14 @@ func (o *GetAlertGroupsURL) Build() (*url.URL, error) {
 		qs.Set("silenced", silencedQ)
 	}
 
+	var sortQ string
+	if o.Sort != nil {
+		sortQ = *o.Sort
+	}
+	if sortQ != "" {
+		qs.Set("sort", sortQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
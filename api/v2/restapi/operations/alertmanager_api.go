@@ -21,6 +21,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -61,22 +62,46 @@ func NewAlertmanagerAPI(spec *loads.Document) *AlertmanagerAPI {
 		JSONConsumer: runtime.JSONConsumer(),
 
 		JSONProducer: runtime.JSONProducer(),
+		TextEventStreamProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("textEventStream producer has not yet been implemented")
+		}),
 
+		AlertAcknowledgeAlertHandler: alert.AcknowledgeAlertHandlerFunc(func(params alert.AcknowledgeAlertParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.AcknowledgeAlert has not yet been implemented")
+		}),
+		SilenceApproveSilenceHandler: silence.ApproveSilenceHandlerFunc(func(params silence.ApproveSilenceParams) middleware.Responder {
+			return middleware.NotImplemented("operation silence.ApproveSilence has not yet been implemented")
+		}),
+		SilenceConsolidateSilencesHandler: silence.ConsolidateSilencesHandlerFunc(func(params silence.ConsolidateSilencesParams) middleware.Responder {
+			return middleware.NotImplemented("operation silence.ConsolidateSilences has not yet been implemented")
+		}),
 		SilenceDeleteSilenceHandler: silence.DeleteSilenceHandlerFunc(func(params silence.DeleteSilenceParams) middleware.Responder {
 			return middleware.NotImplemented("operation silence.DeleteSilence has not yet been implemented")
 		}),
 		AlertgroupGetAlertGroupsHandler: alertgroup.GetAlertGroupsHandlerFunc(func(params alertgroup.GetAlertGroupsParams) middleware.Responder {
 			return middleware.NotImplemented("operation alertgroup.GetAlertGroups has not yet been implemented")
 		}),
+		AlertGetAlertHistoryHandler: alert.GetAlertHistoryHandlerFunc(func(params alert.GetAlertHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.GetAlertHistory has not yet been implemented")
+		}),
 		AlertGetAlertsHandler: alert.GetAlertsHandlerFunc(func(params alert.GetAlertsParams) middleware.Responder {
 			return middleware.NotImplemented("operation alert.GetAlerts has not yet been implemented")
 		}),
+		GeneralGetConfigHandler: general.GetConfigHandlerFunc(func(params general.GetConfigParams) middleware.Responder {
+			return middleware.NotImplemented("operation general.GetConfig has not yet been implemented")
+		}),
 		ReceiverGetReceiversHandler: receiver.GetReceiversHandlerFunc(func(params receiver.GetReceiversParams) middleware.Responder {
 			return middleware.NotImplemented("operation receiver.GetReceivers has not yet been implemented")
 		}),
 		SilenceGetSilenceHandler: silence.GetSilenceHandlerFunc(func(params silence.GetSilenceParams) middleware.Responder {
 			return middleware.NotImplemented("operation silence.GetSilence has not yet been implemented")
 		}),
+		SilenceGetSilenceHistoryHandler: silence.GetSilenceHistoryHandlerFunc(func(params silence.GetSilenceHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation silence.GetSilenceHistory has not yet been implemented")
+		}),
+		SilenceGetSilenceOverlapsHandler: silence.GetSilenceOverlapsHandlerFunc(func(params silence.GetSilenceOverlapsParams) middleware.Responder {
+			return middleware.NotImplemented("operation silence.GetSilenceOverlaps has not yet been implemented")
+		}),
 		SilenceGetSilencesHandler: silence.GetSilencesHandlerFunc(func(params silence.GetSilencesParams) middleware.Responder {
 			return middleware.NotImplemented("operation silence.GetSilences has not yet been implemented")
 		}),
@@ -89,6 +114,24 @@ func NewAlertmanagerAPI(spec *loads.Document) *AlertmanagerAPI {
 		SilencePostSilencesHandler: silence.PostSilencesHandlerFunc(func(params silence.PostSilencesParams) middleware.Responder {
 			return middleware.NotImplemented("operation silence.PostSilences has not yet been implemented")
 		}),
+		SilencePreviewSilenceHandler: silence.PreviewSilenceHandlerFunc(func(params silence.PreviewSilenceParams) middleware.Responder {
+			return middleware.NotImplemented("operation silence.PreviewSilence has not yet been implemented")
+		}),
+		GeneralPutConfigHandler: general.PutConfigHandlerFunc(func(params general.PutConfigParams) middleware.Responder {
+			return middleware.NotImplemented("operation general.PutConfig has not yet been implemented")
+		}),
+		SilenceRejectSilenceHandler: silence.RejectSilenceHandlerFunc(func(params silence.RejectSilenceParams) middleware.Responder {
+			return middleware.NotImplemented("operation silence.RejectSilence has not yet been implemented")
+		}),
+		GeneralRollbackConfigHandler: general.RollbackConfigHandlerFunc(func(params general.RollbackConfigParams) middleware.Responder {
+			return middleware.NotImplemented("operation general.RollbackConfig has not yet been implemented")
+		}),
+		AlertStreamAlertsHandler: alert.StreamAlertsHandlerFunc(func(params alert.StreamAlertsParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.StreamAlerts has not yet been implemented")
+		}),
+		AlertUnacknowledgeAlertHandler: alert.UnacknowledgeAlertHandlerFunc(func(params alert.UnacknowledgeAlertParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.UnacknowledgeAlert has not yet been implemented")
+		}),
 	}
 }
 
@@ -124,17 +167,34 @@ type AlertmanagerAPI struct {
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
-
+	// TextEventStreamProducer registers a producer for the following mime types:
+	//   - text/event-stream
+	TextEventStreamProducer runtime.Producer
+
+	// AlertAcknowledgeAlertHandler sets the operation handler for the acknowledge alert operation
+	AlertAcknowledgeAlertHandler alert.AcknowledgeAlertHandler
+	// SilenceApproveSilenceHandler sets the operation handler for the approve silence operation
+	SilenceApproveSilenceHandler silence.ApproveSilenceHandler
+	// SilenceConsolidateSilencesHandler sets the operation handler for the consolidate silences operation
+	SilenceConsolidateSilencesHandler silence.ConsolidateSilencesHandler
 	// SilenceDeleteSilenceHandler sets the operation handler for the delete silence operation
 	SilenceDeleteSilenceHandler silence.DeleteSilenceHandler
 	// AlertgroupGetAlertGroupsHandler sets the operation handler for the get alert groups operation
 	AlertgroupGetAlertGroupsHandler alertgroup.GetAlertGroupsHandler
+	// AlertGetAlertHistoryHandler sets the operation handler for the get alert history operation
+	AlertGetAlertHistoryHandler alert.GetAlertHistoryHandler
 	// AlertGetAlertsHandler sets the operation handler for the get alerts operation
 	AlertGetAlertsHandler alert.GetAlertsHandler
+	// GeneralGetConfigHandler sets the operation handler for the get config operation
+	GeneralGetConfigHandler general.GetConfigHandler
 	// ReceiverGetReceiversHandler sets the operation handler for the get receivers operation
 	ReceiverGetReceiversHandler receiver.GetReceiversHandler
 	// SilenceGetSilenceHandler sets the operation handler for the get silence operation
 	SilenceGetSilenceHandler silence.GetSilenceHandler
+	// SilenceGetSilenceHistoryHandler sets the operation handler for the get silence history operation
+	SilenceGetSilenceHistoryHandler silence.GetSilenceHistoryHandler
+	// SilenceGetSilenceOverlapsHandler sets the operation handler for the get silence overlaps operation
+	SilenceGetSilenceOverlapsHandler silence.GetSilenceOverlapsHandler
 	// SilenceGetSilencesHandler sets the operation handler for the get silences operation
 	SilenceGetSilencesHandler silence.GetSilencesHandler
 	// GeneralGetStatusHandler sets the operation handler for the get status operation
@@ -143,6 +203,18 @@ type AlertmanagerAPI struct {
 	AlertPostAlertsHandler alert.PostAlertsHandler
 	// SilencePostSilencesHandler sets the operation handler for the post silences operation
 	SilencePostSilencesHandler silence.PostSilencesHandler
+	// SilencePreviewSilenceHandler sets the operation handler for the preview silence operation
+	SilencePreviewSilenceHandler silence.PreviewSilenceHandler
+	// GeneralPutConfigHandler sets the operation handler for the put config operation
+	GeneralPutConfigHandler general.PutConfigHandler
+	// SilenceRejectSilenceHandler sets the operation handler for the reject silence operation
+	SilenceRejectSilenceHandler silence.RejectSilenceHandler
+	// GeneralRollbackConfigHandler sets the operation handler for the rollback config operation
+	GeneralRollbackConfigHandler general.RollbackConfigHandler
+	// AlertStreamAlertsHandler sets the operation handler for the stream alerts operation
+	AlertStreamAlertsHandler alert.StreamAlertsHandler
+	// AlertUnacknowledgeAlertHandler sets the operation handler for the unacknowledge alert operation
+	AlertUnacknowledgeAlertHandler alert.UnacknowledgeAlertHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -219,22 +291,46 @@ func (o *AlertmanagerAPI) Validate() error {
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TextEventStreamProducer == nil {
+		unregistered = append(unregistered, "TextEventStreamProducer")
+	}
 
+	if o.AlertAcknowledgeAlertHandler == nil {
+		unregistered = append(unregistered, "alert.AcknowledgeAlertHandler")
+	}
+	if o.SilenceApproveSilenceHandler == nil {
+		unregistered = append(unregistered, "silence.ApproveSilenceHandler")
+	}
+	if o.SilenceConsolidateSilencesHandler == nil {
+		unregistered = append(unregistered, "silence.ConsolidateSilencesHandler")
+	}
 	if o.SilenceDeleteSilenceHandler == nil {
 		unregistered = append(unregistered, "silence.DeleteSilenceHandler")
 	}
 	if o.AlertgroupGetAlertGroupsHandler == nil {
 		unregistered = append(unregistered, "alertgroup.GetAlertGroupsHandler")
 	}
+	if o.AlertGetAlertHistoryHandler == nil {
+		unregistered = append(unregistered, "alert.GetAlertHistoryHandler")
+	}
 	if o.AlertGetAlertsHandler == nil {
 		unregistered = append(unregistered, "alert.GetAlertsHandler")
 	}
+	if o.GeneralGetConfigHandler == nil {
+		unregistered = append(unregistered, "general.GetConfigHandler")
+	}
 	if o.ReceiverGetReceiversHandler == nil {
 		unregistered = append(unregistered, "receiver.GetReceiversHandler")
 	}
 	if o.SilenceGetSilenceHandler == nil {
 		unregistered = append(unregistered, "silence.GetSilenceHandler")
 	}
+	if o.SilenceGetSilenceHistoryHandler == nil {
+		unregistered = append(unregistered, "silence.GetSilenceHistoryHandler")
+	}
+	if o.SilenceGetSilenceOverlapsHandler == nil {
+		unregistered = append(unregistered, "silence.GetSilenceOverlapsHandler")
+	}
 	if o.SilenceGetSilencesHandler == nil {
 		unregistered = append(unregistered, "silence.GetSilencesHandler")
 	}
@@ -247,6 +343,24 @@ func (o *AlertmanagerAPI) Validate() error {
 	if o.SilencePostSilencesHandler == nil {
 		unregistered = append(unregistered, "silence.PostSilencesHandler")
 	}
+	if o.SilencePreviewSilenceHandler == nil {
+		unregistered = append(unregistered, "silence.PreviewSilenceHandler")
+	}
+	if o.GeneralPutConfigHandler == nil {
+		unregistered = append(unregistered, "general.PutConfigHandler")
+	}
+	if o.SilenceRejectSilenceHandler == nil {
+		unregistered = append(unregistered, "silence.RejectSilenceHandler")
+	}
+	if o.GeneralRollbackConfigHandler == nil {
+		unregistered = append(unregistered, "general.RollbackConfigHandler")
+	}
+	if o.AlertStreamAlertsHandler == nil {
+		unregistered = append(unregistered, "alert.StreamAlertsHandler")
+	}
+	if o.AlertUnacknowledgeAlertHandler == nil {
+		unregistered = append(unregistered, "alert.UnacknowledgeAlertHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -295,6 +409,8 @@ func (o *AlertmanagerAPI) ProducersFor(mediaTypes []string) map[string]runtime.P
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/event-stream":
+			result["text/event-stream"] = o.TextEventStreamProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -335,6 +451,18 @@ func (o *AlertmanagerAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/alert/{fingerprint}/acknowledgement"] = alert.NewAcknowledgeAlert(o.context, o.AlertAcknowledgeAlertHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/silence/{silenceID}/approve"] = silence.NewApproveSilence(o.context, o.SilenceApproveSilenceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/silences/consolidate"] = silence.NewConsolidateSilences(o.context, o.SilenceConsolidateSilencesHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -346,10 +474,18 @@ func (o *AlertmanagerAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/alerts/{fingerprint}/history"] = alert.NewGetAlertHistory(o.context, o.AlertGetAlertHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/alerts"] = alert.NewGetAlerts(o.context, o.AlertGetAlertsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/config"] = general.NewGetConfig(o.context, o.GeneralGetConfigHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/receivers"] = receiver.NewGetReceivers(o.context, o.ReceiverGetReceiversHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -358,6 +494,14 @@ func (o *AlertmanagerAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/silence/{silenceID}/history"] = silence.NewGetSilenceHistory(o.context, o.SilenceGetSilenceHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/silences/overlaps"] = silence.NewGetSilenceOverlaps(o.context, o.SilenceGetSilenceOverlapsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/silences"] = silence.NewGetSilences(o.context, o.SilenceGetSilencesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -371,6 +515,30 @@ func (o *AlertmanagerAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/silences"] = silence.NewPostSilences(o.context, o.SilencePostSilencesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/silences/preview"] = silence.NewPreviewSilence(o.context, o.SilencePreviewSilenceHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/config"] = general.NewPutConfig(o.context, o.GeneralPutConfigHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/silence/{silenceID}/reject"] = silence.NewRejectSilence(o.context, o.SilenceRejectSilenceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/config/versions/{versionID}/rollback"] = general.NewRollbackConfig(o.context, o.GeneralRollbackConfigHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/alerts/stream"] = alert.NewStreamAlerts(o.context, o.AlertStreamAlertsHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/alert/{fingerprint}/acknowledgement"] = alert.NewUnacknowledgeAlert(o.context, o.AlertUnacknowledgeAlertHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -0,0 +1,70 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package general
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PutConfigHandlerFunc turns a function with the right signature into a put config handler
+type PutConfigHandlerFunc func(PutConfigParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PutConfigHandlerFunc) Handle(params PutConfigParams) middleware.Responder {
+	return fn(params)
+}
+
+// PutConfigHandler interface for that can handle valid put config params
+type PutConfigHandler interface {
+	Handle(PutConfigParams) middleware.Responder
+}
+
+// NewPutConfig creates a new http.Handler for the put config operation
+func NewPutConfig(ctx *middleware.Context, handler PutConfigHandler) *PutConfig {
+	return &PutConfig{Context: ctx, Handler: handler}
+}
+
+/*
+	PutConfig swagger:route PUT /config general putConfig
+
+Validate and apply a new configuration
+*/
+type PutConfig struct {
+	Context *middleware.Context
+	Handler PutConfigHandler
+}
+
+func (o *PutConfig) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPutConfigParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
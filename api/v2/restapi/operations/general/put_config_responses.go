@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package general
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// PutConfigOKCode is the HTTP code returned for type PutConfigOK
+const PutConfigOKCode int = 200
+
+/*
+PutConfigOK The configuration was applied, or is valid for a dry run
+
+swagger:response putConfigOK
+*/
+type PutConfigOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ConfigVersion `json:"body,omitempty"`
+}
+
+// NewPutConfigOK creates PutConfigOK with default headers values
+func NewPutConfigOK() *PutConfigOK {
+
+	return &PutConfigOK{}
+}
+
+// WithPayload adds the payload to the put config o k response
+func (o *PutConfigOK) WithPayload(payload *models.ConfigVersion) *PutConfigOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put config o k response
+func (o *PutConfigOK) SetPayload(payload *models.ConfigVersion) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutConfigOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PutConfigBadRequestCode is the HTTP code returned for type PutConfigBadRequest
+const PutConfigBadRequestCode int = 400
+
+/*
+PutConfigBadRequest Bad request
+
+swagger:response putConfigBadRequest
+*/
+type PutConfigBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewPutConfigBadRequest creates PutConfigBadRequest with default headers values
+func NewPutConfigBadRequest() *PutConfigBadRequest {
+
+	return &PutConfigBadRequest{}
+}
+
+// WithPayload adds the payload to the put config bad request response
+func (o *PutConfigBadRequest) WithPayload(payload string) *PutConfigBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put config bad request response
+func (o *PutConfigBadRequest) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutConfigBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// PutConfigForbiddenCode is the HTTP code returned for type PutConfigForbidden
+const PutConfigForbiddenCode int = 403
+
+/*
+PutConfigForbidden Forbidden
+
+swagger:response putConfigForbidden
+*/
+type PutConfigForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewPutConfigForbidden creates PutConfigForbidden with default headers values
+func NewPutConfigForbidden() *PutConfigForbidden {
+
+	return &PutConfigForbidden{}
+}
+
+// WithPayload adds the payload to the put config forbidden response
+func (o *PutConfigForbidden) WithPayload(payload string) *PutConfigForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put config forbidden response
+func (o *PutConfigForbidden) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutConfigForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// PutConfigInternalServerErrorCode is the HTTP code returned for type PutConfigInternalServerError
+const PutConfigInternalServerErrorCode int = 500
+
+/*
+PutConfigInternalServerError Internal server error
+
+swagger:response putConfigInternalServerError
+*/
+type PutConfigInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewPutConfigInternalServerError creates PutConfigInternalServerError with default headers values
+func NewPutConfigInternalServerError() *PutConfigInternalServerError {
+
+	return &PutConfigInternalServerError{}
+}
+
+// WithPayload adds the payload to the put config internal server error response
+func (o *PutConfigInternalServerError) WithPayload(payload string) *PutConfigInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put config internal server error response
+func (o *PutConfigInternalServerError) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutConfigInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
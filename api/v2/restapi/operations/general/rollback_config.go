@@ -0,0 +1,70 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package general
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// RollbackConfigHandlerFunc turns a function with the right signature into a rollback config handler
+type RollbackConfigHandlerFunc func(RollbackConfigParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RollbackConfigHandlerFunc) Handle(params RollbackConfigParams) middleware.Responder {
+	return fn(params)
+}
+
+// RollbackConfigHandler interface for that can handle valid rollback config params
+type RollbackConfigHandler interface {
+	Handle(RollbackConfigParams) middleware.Responder
+}
+
+// NewRollbackConfig creates a new http.Handler for the rollback config operation
+func NewRollbackConfig(ctx *middleware.Context, handler RollbackConfigHandler) *RollbackConfig {
+	return &RollbackConfig{Context: ctx, Handler: handler}
+}
+
+/*
+	RollbackConfig swagger:route POST /config/versions/{versionID}/rollback general rollbackConfig
+
+Apply again the configuration of a previous version
+*/
+type RollbackConfig struct {
+	Context *middleware.Context
+	Handler RollbackConfigHandler
+}
+
+func (o *RollbackConfig) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRollbackConfigParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
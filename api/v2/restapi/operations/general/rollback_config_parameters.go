@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package general
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewRollbackConfigParams creates a new RollbackConfigParams object
+// with the default values initialized.
+func NewRollbackConfigParams() RollbackConfigParams {
+
+	var (
+		// initialize parameters with default values
+
+		persistDefault = bool(false)
+	)
+
+	return RollbackConfigParams{
+		Persist: &persistDefault,
+	}
+}
+
+// RollbackConfigParams contains all the bound params for the rollback config operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters rollbackConfig
+type RollbackConfigParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Whether to also write the configuration to the configuration file
+	  In: query
+	  Default: false
+	*/
+	Persist *bool
+	/*ID of the configuration version to roll back to
+	  Required: true
+	  In: path
+	*/
+	VersionID int64
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewRollbackConfigParams() beforehand.
+func (o *RollbackConfigParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qPersist, qhkPersist, _ := qs.GetOK("persist")
+	if err := o.bindPersist(qPersist, qhkPersist, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rVersionID, rhkVersionID, _ := route.Params.GetOK("versionID")
+	if err := o.bindVersionID(rVersionID, rhkVersionID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindPersist binds and validates parameter Persist from query.
+func (o *RollbackConfigParams) bindPersist(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewRollbackConfigParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("persist", "query", "bool", raw)
+	}
+	o.Persist = &value
+
+	return nil
+}
+
+// bindVersionID binds and validates parameter VersionID from path.
+func (o *RollbackConfigParams) bindVersionID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("versionID", "path", "int64", raw)
+	}
+	o.VersionID = value
+
+	return nil
+}
@@ -0,0 +1,227 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package general
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// RollbackConfigOKCode is the HTTP code returned for type RollbackConfigOK
+const RollbackConfigOKCode int = 200
+
+/*
+RollbackConfigOK The configuration was applied
+
+swagger:response rollbackConfigOK
+*/
+type RollbackConfigOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ConfigVersion `json:"body,omitempty"`
+}
+
+// NewRollbackConfigOK creates RollbackConfigOK with default headers values
+func NewRollbackConfigOK() *RollbackConfigOK {
+
+	return &RollbackConfigOK{}
+}
+
+// WithPayload adds the payload to the rollback config o k response
+func (o *RollbackConfigOK) WithPayload(payload *models.ConfigVersion) *RollbackConfigOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the rollback config o k response
+func (o *RollbackConfigOK) SetPayload(payload *models.ConfigVersion) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RollbackConfigOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RollbackConfigBadRequestCode is the HTTP code returned for type RollbackConfigBadRequest
+const RollbackConfigBadRequestCode int = 400
+
+/*
+RollbackConfigBadRequest Bad request
+
+swagger:response rollbackConfigBadRequest
+*/
+type RollbackConfigBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewRollbackConfigBadRequest creates RollbackConfigBadRequest with default headers values
+func NewRollbackConfigBadRequest() *RollbackConfigBadRequest {
+
+	return &RollbackConfigBadRequest{}
+}
+
+// WithPayload adds the payload to the rollback config bad request response
+func (o *RollbackConfigBadRequest) WithPayload(payload string) *RollbackConfigBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the rollback config bad request response
+func (o *RollbackConfigBadRequest) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RollbackConfigBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// RollbackConfigForbiddenCode is the HTTP code returned for type RollbackConfigForbidden
+const RollbackConfigForbiddenCode int = 403
+
+/*
+RollbackConfigForbidden Forbidden
+
+swagger:response rollbackConfigForbidden
+*/
+type RollbackConfigForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewRollbackConfigForbidden creates RollbackConfigForbidden with default headers values
+func NewRollbackConfigForbidden() *RollbackConfigForbidden {
+
+	return &RollbackConfigForbidden{}
+}
+
+// WithPayload adds the payload to the rollback config forbidden response
+func (o *RollbackConfigForbidden) WithPayload(payload string) *RollbackConfigForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the rollback config forbidden response
+func (o *RollbackConfigForbidden) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RollbackConfigForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// RollbackConfigNotFoundCode is the HTTP code returned for type RollbackConfigNotFound
+const RollbackConfigNotFoundCode int = 404
+
+/*
+RollbackConfigNotFound The configuration version was not found
+
+swagger:response rollbackConfigNotFound
+*/
+type RollbackConfigNotFound struct {
+}
+
+// NewRollbackConfigNotFound creates RollbackConfigNotFound with default headers values
+func NewRollbackConfigNotFound() *RollbackConfigNotFound {
+
+	return &RollbackConfigNotFound{}
+}
+
+// WriteResponse to the client
+func (o *RollbackConfigNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// RollbackConfigInternalServerErrorCode is the HTTP code returned for type RollbackConfigInternalServerError
+const RollbackConfigInternalServerErrorCode int = 500
+
+/*
+RollbackConfigInternalServerError Internal server error
+
+swagger:response rollbackConfigInternalServerError
+*/
+type RollbackConfigInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewRollbackConfigInternalServerError creates RollbackConfigInternalServerError with default headers values
+func NewRollbackConfigInternalServerError() *RollbackConfigInternalServerError {
+
+	return &RollbackConfigInternalServerError{}
+}
+
+// WithPayload adds the payload to the rollback config internal server error response
+func (o *RollbackConfigInternalServerError) WithPayload(payload string) *RollbackConfigInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the rollback config internal server error response
+func (o *RollbackConfigInternalServerError) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RollbackConfigInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
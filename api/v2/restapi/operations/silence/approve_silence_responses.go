@@ -0,0 +1,205 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+)
+
+// ApproveSilenceOKCode is the HTTP code returned for type ApproveSilenceOK
+const ApproveSilenceOKCode int = 200
+
+/*
+ApproveSilenceOK Approve silence response
+
+swagger:response approveSilenceOK
+*/
+type ApproveSilenceOK struct {
+}
+
+// NewApproveSilenceOK creates ApproveSilenceOK with default headers values
+func NewApproveSilenceOK() *ApproveSilenceOK {
+
+	return &ApproveSilenceOK{}
+}
+
+// WriteResponse to the client
+func (o *ApproveSilenceOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// ApproveSilenceBadRequestCode is the HTTP code returned for type ApproveSilenceBadRequest
+const ApproveSilenceBadRequestCode int = 400
+
+/*
+ApproveSilenceBadRequest Bad request
+
+swagger:response approveSilenceBadRequest
+*/
+type ApproveSilenceBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewApproveSilenceBadRequest creates ApproveSilenceBadRequest with default headers values
+func NewApproveSilenceBadRequest() *ApproveSilenceBadRequest {
+
+	return &ApproveSilenceBadRequest{}
+}
+
+// WithPayload adds the payload to the approve silence bad request response
+func (o *ApproveSilenceBadRequest) WithPayload(payload string) *ApproveSilenceBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the approve silence bad request response
+func (o *ApproveSilenceBadRequest) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApproveSilenceBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ApproveSilenceForbiddenCode is the HTTP code returned for type ApproveSilenceForbidden
+const ApproveSilenceForbiddenCode int = 403
+
+/*
+ApproveSilenceForbidden Forbidden
+
+swagger:response approveSilenceForbidden
+*/
+type ApproveSilenceForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewApproveSilenceForbidden creates ApproveSilenceForbidden with default headers values
+func NewApproveSilenceForbidden() *ApproveSilenceForbidden {
+
+	return &ApproveSilenceForbidden{}
+}
+
+// WithPayload adds the payload to the approve silence forbidden response
+func (o *ApproveSilenceForbidden) WithPayload(payload string) *ApproveSilenceForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the approve silence forbidden response
+func (o *ApproveSilenceForbidden) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApproveSilenceForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ApproveSilenceNotFoundCode is the HTTP code returned for type ApproveSilenceNotFound
+const ApproveSilenceNotFoundCode int = 404
+
+/*
+ApproveSilenceNotFound A silence with the specified ID was not found
+
+swagger:response approveSilenceNotFound
+*/
+type ApproveSilenceNotFound struct {
+}
+
+// NewApproveSilenceNotFound creates ApproveSilenceNotFound with default headers values
+func NewApproveSilenceNotFound() *ApproveSilenceNotFound {
+
+	return &ApproveSilenceNotFound{}
+}
+
+// WriteResponse to the client
+func (o *ApproveSilenceNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// ApproveSilenceInternalServerErrorCode is the HTTP code returned for type ApproveSilenceInternalServerError
+const ApproveSilenceInternalServerErrorCode int = 500
+
+/*
+ApproveSilenceInternalServerError Internal server error
+
+swagger:response approveSilenceInternalServerError
+*/
+type ApproveSilenceInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewApproveSilenceInternalServerError creates ApproveSilenceInternalServerError with default headers values
+func NewApproveSilenceInternalServerError() *ApproveSilenceInternalServerError {
+
+	return &ApproveSilenceInternalServerError{}
+}
+
+// WithPayload adds the payload to the approve silence internal server error response
+func (o *ApproveSilenceInternalServerError) WithPayload(payload string) *ApproveSilenceInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the approve silence internal server error response
+func (o *ApproveSilenceInternalServerError) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApproveSilenceInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
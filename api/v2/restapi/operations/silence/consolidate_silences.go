@@ -0,0 +1,110 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ConsolidateSilencesHandlerFunc turns a function with the right signature into a consolidate silences handler
+type ConsolidateSilencesHandlerFunc func(ConsolidateSilencesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ConsolidateSilencesHandlerFunc) Handle(params ConsolidateSilencesParams) middleware.Responder {
+	return fn(params)
+}
+
+// ConsolidateSilencesHandler interface for that can handle valid consolidate silences params
+type ConsolidateSilencesHandler interface {
+	Handle(ConsolidateSilencesParams) middleware.Responder
+}
+
+// NewConsolidateSilences creates a new http.Handler for the consolidate silences operation
+func NewConsolidateSilences(ctx *middleware.Context, handler ConsolidateSilencesHandler) *ConsolidateSilences {
+	return &ConsolidateSilences{Context: ctx, Handler: handler}
+}
+
+/*
+	ConsolidateSilences swagger:route POST /silences/consolidate silence consolidateSilences
+
+Replace silences with a single silence muting all of their alerts
+*/
+type ConsolidateSilences struct {
+	Context *middleware.Context
+	Handler ConsolidateSilencesHandler
+}
+
+func (o *ConsolidateSilences) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewConsolidateSilencesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// ConsolidateSilencesOKBody consolidate silences o k body
+//
+// swagger:model ConsolidateSilencesOKBody
+type ConsolidateSilencesOKBody struct {
+
+	// silence ID
+	SilenceID string `json:"silenceID,omitempty"`
+}
+
+// Validate validates this consolidate silences o k body
+func (o *ConsolidateSilencesOKBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this consolidate silences o k body based on context it is used
+func (o *ConsolidateSilencesOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ConsolidateSilencesOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ConsolidateSilencesOKBody) UnmarshalBinary(b []byte) error {
+	var res ConsolidateSilencesOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
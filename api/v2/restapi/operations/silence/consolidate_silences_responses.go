@@ -0,0 +1,243 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+)
+
+// ConsolidateSilencesOKCode is the HTTP code returned for type ConsolidateSilencesOK
+const ConsolidateSilencesOKCode int = 200
+
+/*
+ConsolidateSilencesOK Consolidate silences response
+
+swagger:response consolidateSilencesOK
+*/
+type ConsolidateSilencesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *ConsolidateSilencesOKBody `json:"body,omitempty"`
+}
+
+// NewConsolidateSilencesOK creates ConsolidateSilencesOK with default headers values
+func NewConsolidateSilencesOK() *ConsolidateSilencesOK {
+
+	return &ConsolidateSilencesOK{}
+}
+
+// WithPayload adds the payload to the consolidate silences o k response
+func (o *ConsolidateSilencesOK) WithPayload(payload *ConsolidateSilencesOKBody) *ConsolidateSilencesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the consolidate silences o k response
+func (o *ConsolidateSilencesOK) SetPayload(payload *ConsolidateSilencesOKBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ConsolidateSilencesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ConsolidateSilencesBadRequestCode is the HTTP code returned for type ConsolidateSilencesBadRequest
+const ConsolidateSilencesBadRequestCode int = 400
+
+/*
+ConsolidateSilencesBadRequest Bad request
+
+swagger:response consolidateSilencesBadRequest
+*/
+type ConsolidateSilencesBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewConsolidateSilencesBadRequest creates ConsolidateSilencesBadRequest with default headers values
+func NewConsolidateSilencesBadRequest() *ConsolidateSilencesBadRequest {
+
+	return &ConsolidateSilencesBadRequest{}
+}
+
+// WithPayload adds the payload to the consolidate silences bad request response
+func (o *ConsolidateSilencesBadRequest) WithPayload(payload string) *ConsolidateSilencesBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the consolidate silences bad request response
+func (o *ConsolidateSilencesBadRequest) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ConsolidateSilencesBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ConsolidateSilencesForbiddenCode is the HTTP code returned for type ConsolidateSilencesForbidden
+const ConsolidateSilencesForbiddenCode int = 403
+
+/*
+ConsolidateSilencesForbidden Forbidden
+
+swagger:response consolidateSilencesForbidden
+*/
+type ConsolidateSilencesForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewConsolidateSilencesForbidden creates ConsolidateSilencesForbidden with default headers values
+func NewConsolidateSilencesForbidden() *ConsolidateSilencesForbidden {
+
+	return &ConsolidateSilencesForbidden{}
+}
+
+// WithPayload adds the payload to the consolidate silences forbidden response
+func (o *ConsolidateSilencesForbidden) WithPayload(payload string) *ConsolidateSilencesForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the consolidate silences forbidden response
+func (o *ConsolidateSilencesForbidden) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ConsolidateSilencesForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ConsolidateSilencesNotFoundCode is the HTTP code returned for type ConsolidateSilencesNotFound
+const ConsolidateSilencesNotFoundCode int = 404
+
+/*
+ConsolidateSilencesNotFound A silence with one of the specified IDs was not found
+
+swagger:response consolidateSilencesNotFound
+*/
+type ConsolidateSilencesNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewConsolidateSilencesNotFound creates ConsolidateSilencesNotFound with default headers values
+func NewConsolidateSilencesNotFound() *ConsolidateSilencesNotFound {
+
+	return &ConsolidateSilencesNotFound{}
+}
+
+// WithPayload adds the payload to the consolidate silences not found response
+func (o *ConsolidateSilencesNotFound) WithPayload(payload string) *ConsolidateSilencesNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the consolidate silences not found response
+func (o *ConsolidateSilencesNotFound) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ConsolidateSilencesNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ConsolidateSilencesInternalServerErrorCode is the HTTP code returned for type ConsolidateSilencesInternalServerError
+const ConsolidateSilencesInternalServerErrorCode int = 500
+
+/*
+ConsolidateSilencesInternalServerError Internal server error
+
+swagger:response consolidateSilencesInternalServerError
+*/
+type ConsolidateSilencesInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewConsolidateSilencesInternalServerError creates ConsolidateSilencesInternalServerError with default headers values
+func NewConsolidateSilencesInternalServerError() *ConsolidateSilencesInternalServerError {
+
+	return &ConsolidateSilencesInternalServerError{}
+}
+
+// WithPayload adds the payload to the consolidate silences internal server error response
+func (o *ConsolidateSilencesInternalServerError) WithPayload(payload string) *ConsolidateSilencesInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the consolidate silences internal server error response
+func (o *ConsolidateSilencesInternalServerError) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ConsolidateSilencesInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
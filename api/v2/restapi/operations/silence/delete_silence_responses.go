@@ -50,6 +50,49 @@ func (o *DeleteSilenceOK) WriteResponse(rw http.ResponseWriter, producer runtime
 	rw.WriteHeader(200)
 }
 
+// DeleteSilenceForbiddenCode is the HTTP code returned for type DeleteSilenceForbidden
+const DeleteSilenceForbiddenCode int = 403
+
+/*
+DeleteSilenceForbidden Forbidden
+
+swagger:response deleteSilenceForbidden
+*/
+type DeleteSilenceForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewDeleteSilenceForbidden creates DeleteSilenceForbidden with default headers values
+func NewDeleteSilenceForbidden() *DeleteSilenceForbidden {
+
+	return &DeleteSilenceForbidden{}
+}
+
+// WithPayload adds the payload to the delete silence forbidden response
+func (o *DeleteSilenceForbidden) WithPayload(payload string) *DeleteSilenceForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete silence forbidden response
+func (o *DeleteSilenceForbidden) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteSilenceForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
 // DeleteSilenceNotFoundCode is the HTTP code returned for type DeleteSilenceNotFound
 const DeleteSilenceNotFoundCode int = 404
 
@@ -0,0 +1,70 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetSilenceHistoryHandlerFunc turns a function with the right signature into a get silence history handler
+type GetSilenceHistoryHandlerFunc func(GetSilenceHistoryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetSilenceHistoryHandlerFunc) Handle(params GetSilenceHistoryParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetSilenceHistoryHandler interface for that can handle valid get silence history params
+type GetSilenceHistoryHandler interface {
+	Handle(GetSilenceHistoryParams) middleware.Responder
+}
+
+// NewGetSilenceHistory creates a new http.Handler for the get silence history operation
+func NewGetSilenceHistory(ctx *middleware.Context, handler GetSilenceHistoryHandler) *GetSilenceHistory {
+	return &GetSilenceHistory{Context: ctx, Handler: handler}
+}
+
+/*
+	GetSilenceHistory swagger:route GET /silence/{silenceID}/history silence getSilenceHistory
+
+Get the revisions of a silence, oldest first. The last revision is the current state of the silence.
+*/
+type GetSilenceHistory struct {
+	Context *middleware.Context
+	Handler GetSilenceHistoryHandler
+}
+
+func (o *GetSilenceHistory) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetSilenceHistoryParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,144 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// GetSilenceHistoryOKCode is the HTTP code returned for type GetSilenceHistoryOK
+const GetSilenceHistoryOKCode int = 200
+
+/*
+GetSilenceHistoryOK Get silence history response
+
+swagger:response getSilenceHistoryOK
+*/
+type GetSilenceHistoryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.SilenceHistory `json:"body,omitempty"`
+}
+
+// NewGetSilenceHistoryOK creates GetSilenceHistoryOK with default headers values
+func NewGetSilenceHistoryOK() *GetSilenceHistoryOK {
+
+	return &GetSilenceHistoryOK{}
+}
+
+// WithPayload adds the payload to the get silence history o k response
+func (o *GetSilenceHistoryOK) WithPayload(payload models.SilenceHistory) *GetSilenceHistoryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get silence history o k response
+func (o *GetSilenceHistoryOK) SetPayload(payload models.SilenceHistory) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetSilenceHistoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.SilenceHistory{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// GetSilenceHistoryNotFoundCode is the HTTP code returned for type GetSilenceHistoryNotFound
+const GetSilenceHistoryNotFoundCode int = 404
+
+/*
+GetSilenceHistoryNotFound A silence with the specified ID was not found
+
+swagger:response getSilenceHistoryNotFound
+*/
+type GetSilenceHistoryNotFound struct {
+}
+
+// NewGetSilenceHistoryNotFound creates GetSilenceHistoryNotFound with default headers values
+func NewGetSilenceHistoryNotFound() *GetSilenceHistoryNotFound {
+
+	return &GetSilenceHistoryNotFound{}
+}
+
+// WriteResponse to the client
+func (o *GetSilenceHistoryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// GetSilenceHistoryInternalServerErrorCode is the HTTP code returned for type GetSilenceHistoryInternalServerError
+const GetSilenceHistoryInternalServerErrorCode int = 500
+
+/*
+GetSilenceHistoryInternalServerError Internal server error
+
+swagger:response getSilenceHistoryInternalServerError
+*/
+type GetSilenceHistoryInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewGetSilenceHistoryInternalServerError creates GetSilenceHistoryInternalServerError with default headers values
+func NewGetSilenceHistoryInternalServerError() *GetSilenceHistoryInternalServerError {
+
+	return &GetSilenceHistoryInternalServerError{}
+}
+
+// WithPayload adds the payload to the get silence history internal server error response
+func (o *GetSilenceHistoryInternalServerError) WithPayload(payload string) *GetSilenceHistoryInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get silence history internal server error response
+func (o *GetSilenceHistoryInternalServerError) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetSilenceHistoryInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
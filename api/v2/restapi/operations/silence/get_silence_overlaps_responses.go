@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// GetSilenceOverlapsOKCode is the HTTP code returned for type GetSilenceOverlapsOK
+const GetSilenceOverlapsOKCode int = 200
+
+/*
+GetSilenceOverlapsOK Get silence overlaps response
+
+swagger:response getSilenceOverlapsOK
+*/
+type GetSilenceOverlapsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.SilenceOverlaps `json:"body,omitempty"`
+}
+
+// NewGetSilenceOverlapsOK creates GetSilenceOverlapsOK with default headers values
+func NewGetSilenceOverlapsOK() *GetSilenceOverlapsOK {
+
+	return &GetSilenceOverlapsOK{}
+}
+
+// WithPayload adds the payload to the get silence overlaps o k response
+func (o *GetSilenceOverlapsOK) WithPayload(payload models.SilenceOverlaps) *GetSilenceOverlapsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get silence overlaps o k response
+func (o *GetSilenceOverlapsOK) SetPayload(payload models.SilenceOverlaps) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetSilenceOverlapsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.SilenceOverlaps{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// GetSilenceOverlapsInternalServerErrorCode is the HTTP code returned for type GetSilenceOverlapsInternalServerError
+const GetSilenceOverlapsInternalServerErrorCode int = 500
+
+/*
+GetSilenceOverlapsInternalServerError Internal server error
+
+swagger:response getSilenceOverlapsInternalServerError
+*/
+type GetSilenceOverlapsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewGetSilenceOverlapsInternalServerError creates GetSilenceOverlapsInternalServerError with default headers values
+func NewGetSilenceOverlapsInternalServerError() *GetSilenceOverlapsInternalServerError {
+
+	return &GetSilenceOverlapsInternalServerError{}
+}
+
+// WithPayload adds the payload to the get silence overlaps internal server error response
+func (o *GetSilenceOverlapsInternalServerError) WithPayload(payload string) *GetSilenceOverlapsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get silence overlaps internal server error response
+func (o *GetSilenceOverlapsInternalServerError) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetSilenceOverlapsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
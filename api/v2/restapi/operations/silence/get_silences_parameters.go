@@ -26,6 +26,8 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // NewGetSilencesParams creates a new GetSilencesParams object
@@ -45,11 +47,34 @@ type GetSilencesParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*The cursor returned in the X-Next-Cursor header of the previous page
+	  In: query
+	*/
+	Cursor *string
+	/*The fields to return for each item, as dot-separated paths such as
+	labels or status.state. All fields are returned by default.
+
+	  In: query
+	  Collection Format: multi
+	*/
+	Fields []string
 	/*A list of matchers to filter silences by
 	  In: query
 	  Collection Format: multi
 	*/
 	Filter []string
+	/*The maximum number of items to return
+	  Minimum: 1
+	  In: query
+	*/
+	Limit *int64
+	/*The key to sort silences by, one of startsAt, endsAt and id, prefixed
+	with - to sort in descending order. By default silences are sorted by
+	state and by the time they start or end.
+
+	  In: query
+	*/
+	Sort *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -63,16 +88,76 @@ func (o *GetSilencesParams) BindRequest(r *http.Request, route *middleware.Match
 
 	qs := runtime.Values(r.URL.Query())
 
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qFields, qhkFields, _ := qs.GetOK("fields")
+	if err := o.bindFields(qFields, qhkFields, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qFilter, qhkFilter, _ := qs.GetOK("filter")
 	if err := o.bindFilter(qFilter, qhkFilter, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSort, qhkSort, _ := qs.GetOK("sort")
+	if err := o.bindSort(qSort, qhkSort, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindCursor binds and validates parameter Cursor from query.
+func (o *GetSilencesParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Cursor = &raw
+
+	return nil
+}
+
+// bindFields binds and validates array parameter Fields from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *GetSilencesParams) bindFields(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	fieldsIC := rawData
+	if len(fieldsIC) == 0 {
+		return nil
+	}
+
+	var fieldsIR []string
+	for _, fieldsIV := range fieldsIC {
+		fieldsI := fieldsIV
+
+		fieldsIR = append(fieldsIR, fieldsI)
+	}
+
+	o.Fields = fieldsIR
+
+	return nil
+}
+
 // bindFilter binds and validates array parameter Filter from query.
 //
 // Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
@@ -94,3 +179,58 @@ func (o *GetSilencesParams) bindFilter(rawData []string, hasKey bool, formats st
 
 	return nil
 }
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *GetSilencesParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *GetSilencesParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSort binds and validates parameter Sort from query.
+func (o *GetSilencesParams) bindSort(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Sort = &raw
+
+	return nil
+}
@@ -36,6 +36,10 @@ GetSilencesOK Get silences response
 swagger:response getSilencesOK
 */
 type GetSilencesOK struct {
+	/*The cursor of the next page, if there are more items
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
 
 	/*
 	  In: Body
@@ -49,6 +53,17 @@ func NewGetSilencesOK() *GetSilencesOK {
 	return &GetSilencesOK{}
 }
 
+// WithXNextCursor adds the xNextCursor to the get silences o k response
+func (o *GetSilencesOK) WithXNextCursor(xNextCursor string) *GetSilencesOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the get silences o k response
+func (o *GetSilencesOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
 // WithPayload adds the payload to the get silences o k response
 func (o *GetSilencesOK) WithPayload(payload models.GettableSilences) *GetSilencesOK {
 	o.Payload = payload
@@ -63,6 +78,13 @@ func (o *GetSilencesOK) SetPayload(payload models.GettableSilences) {
 // WriteResponse to the client
 func (o *GetSilencesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -29,7 +29,11 @@ import (
 
 // GetSilencesURL generates an URL for the get silences operation
 type GetSilencesURL struct {
+	Cursor *string
+	Fields []string
 	Filter []string
+	Limit  *int64
+	Sort   *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -65,6 +69,28 @@ func (o *GetSilencesURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var cursorQ string
+	if o.Cursor != nil {
+		cursorQ = *o.Cursor
+	}
+	if cursorQ != "" {
+		qs.Set("cursor", cursorQ)
+	}
+
+	var fieldsIR []string
+	for _, fieldsI := range o.Fields {
+		fieldsIS := fieldsI
+		if fieldsIS != "" {
+			fieldsIR = append(fieldsIR, fieldsIS)
+		}
+	}
+
+	fields := swag.JoinByFormat(fieldsIR, "multi")
+
+	for _, qsv := range fields {
+		qs.Add("fields", qsv)
+	}
+
 	var filterIR []string
 	for _, filterI := range o.Filter {
 		filterIS := filterI
@@ -79,6 +105,22 @@ func (o *GetSilencesURL) Build() (*url.URL, error) {
 		qs.Add("filter", qsv)
 	}
 
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt64(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
+	var sortQ string
+	if o.Sort != nil {
+		sortQ = *o.Sort
+	}
+	if sortQ != "" {
+		qs.Set("sort", sortQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
@@ -0,0 +1,70 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PreviewSilenceHandlerFunc turns a function with the right signature into a preview silence handler
+type PreviewSilenceHandlerFunc func(PreviewSilenceParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PreviewSilenceHandlerFunc) Handle(params PreviewSilenceParams) middleware.Responder {
+	return fn(params)
+}
+
+// PreviewSilenceHandler interface for that can handle valid preview silence params
+type PreviewSilenceHandler interface {
+	Handle(PreviewSilenceParams) middleware.Responder
+}
+
+// NewPreviewSilence creates a new http.Handler for the preview silence operation
+func NewPreviewSilence(ctx *middleware.Context, handler PreviewSilenceHandler) *PreviewSilence {
+	return &PreviewSilence{Context: ctx, Handler: handler}
+}
+
+/*
+	PreviewSilence swagger:route POST /silences/preview silence previewSilence
+
+Preview the alerts a new or updated silence would affect without saving it
+*/
+type PreviewSilence struct {
+	Context *middleware.Context
+	Handler PreviewSilenceHandler
+}
+
+func (o *PreviewSilence) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPreviewSilenceParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
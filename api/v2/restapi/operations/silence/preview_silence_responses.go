@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// PreviewSilenceOKCode is the HTTP code returned for type PreviewSilenceOK
+const PreviewSilenceOKCode int = 200
+
+/*
+PreviewSilenceOK Preview silence response
+
+swagger:response previewSilenceOK
+*/
+type PreviewSilenceOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.SilencePreview `json:"body,omitempty"`
+}
+
+// NewPreviewSilenceOK creates PreviewSilenceOK with default headers values
+func NewPreviewSilenceOK() *PreviewSilenceOK {
+
+	return &PreviewSilenceOK{}
+}
+
+// WithPayload adds the payload to the preview silence o k response
+func (o *PreviewSilenceOK) WithPayload(payload *models.SilencePreview) *PreviewSilenceOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the preview silence o k response
+func (o *PreviewSilenceOK) SetPayload(payload *models.SilencePreview) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PreviewSilenceOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PreviewSilenceBadRequestCode is the HTTP code returned for type PreviewSilenceBadRequest
+const PreviewSilenceBadRequestCode int = 400
+
+/*
+PreviewSilenceBadRequest Bad request
+
+swagger:response previewSilenceBadRequest
+*/
+type PreviewSilenceBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewPreviewSilenceBadRequest creates PreviewSilenceBadRequest with default headers values
+func NewPreviewSilenceBadRequest() *PreviewSilenceBadRequest {
+
+	return &PreviewSilenceBadRequest{}
+}
+
+// WithPayload adds the payload to the preview silence bad request response
+func (o *PreviewSilenceBadRequest) WithPayload(payload string) *PreviewSilenceBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the preview silence bad request response
+func (o *PreviewSilenceBadRequest) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PreviewSilenceBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// PreviewSilenceNotFoundCode is the HTTP code returned for type PreviewSilenceNotFound
+const PreviewSilenceNotFoundCode int = 404
+
+/*
+PreviewSilenceNotFound A silence with the specified ID was not found
+
+swagger:response previewSilenceNotFound
+*/
+type PreviewSilenceNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewPreviewSilenceNotFound creates PreviewSilenceNotFound with default headers values
+func NewPreviewSilenceNotFound() *PreviewSilenceNotFound {
+
+	return &PreviewSilenceNotFound{}
+}
+
+// WithPayload adds the payload to the preview silence not found response
+func (o *PreviewSilenceNotFound) WithPayload(payload string) *PreviewSilenceNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the preview silence not found response
+func (o *PreviewSilenceNotFound) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PreviewSilenceNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// PreviewSilenceInternalServerErrorCode is the HTTP code returned for type PreviewSilenceInternalServerError
+const PreviewSilenceInternalServerErrorCode int = 500
+
+/*
+PreviewSilenceInternalServerError Internal server error
+
+swagger:response previewSilenceInternalServerError
+*/
+type PreviewSilenceInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewPreviewSilenceInternalServerError creates PreviewSilenceInternalServerError with default headers values
+func NewPreviewSilenceInternalServerError() *PreviewSilenceInternalServerError {
+
+	return &PreviewSilenceInternalServerError{}
+}
+
+// WithPayload adds the payload to the preview silence internal server error response
+func (o *PreviewSilenceInternalServerError) WithPayload(payload string) *PreviewSilenceInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the preview silence internal server error response
+func (o *PreviewSilenceInternalServerError) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PreviewSilenceInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
@@ -0,0 +1,205 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package silence
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+)
+
+// RejectSilenceOKCode is the HTTP code returned for type RejectSilenceOK
+const RejectSilenceOKCode int = 200
+
+/*
+RejectSilenceOK Reject silence response
+
+swagger:response rejectSilenceOK
+*/
+type RejectSilenceOK struct {
+}
+
+// NewRejectSilenceOK creates RejectSilenceOK with default headers values
+func NewRejectSilenceOK() *RejectSilenceOK {
+
+	return &RejectSilenceOK{}
+}
+
+// WriteResponse to the client
+func (o *RejectSilenceOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// RejectSilenceBadRequestCode is the HTTP code returned for type RejectSilenceBadRequest
+const RejectSilenceBadRequestCode int = 400
+
+/*
+RejectSilenceBadRequest Bad request
+
+swagger:response rejectSilenceBadRequest
+*/
+type RejectSilenceBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewRejectSilenceBadRequest creates RejectSilenceBadRequest with default headers values
+func NewRejectSilenceBadRequest() *RejectSilenceBadRequest {
+
+	return &RejectSilenceBadRequest{}
+}
+
+// WithPayload adds the payload to the reject silence bad request response
+func (o *RejectSilenceBadRequest) WithPayload(payload string) *RejectSilenceBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reject silence bad request response
+func (o *RejectSilenceBadRequest) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RejectSilenceBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// RejectSilenceForbiddenCode is the HTTP code returned for type RejectSilenceForbidden
+const RejectSilenceForbiddenCode int = 403
+
+/*
+RejectSilenceForbidden Forbidden
+
+swagger:response rejectSilenceForbidden
+*/
+type RejectSilenceForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewRejectSilenceForbidden creates RejectSilenceForbidden with default headers values
+func NewRejectSilenceForbidden() *RejectSilenceForbidden {
+
+	return &RejectSilenceForbidden{}
+}
+
+// WithPayload adds the payload to the reject silence forbidden response
+func (o *RejectSilenceForbidden) WithPayload(payload string) *RejectSilenceForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reject silence forbidden response
+func (o *RejectSilenceForbidden) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RejectSilenceForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// RejectSilenceNotFoundCode is the HTTP code returned for type RejectSilenceNotFound
+const RejectSilenceNotFoundCode int = 404
+
+/*
+RejectSilenceNotFound A silence with the specified ID was not found
+
+swagger:response rejectSilenceNotFound
+*/
+type RejectSilenceNotFound struct {
+}
+
+// NewRejectSilenceNotFound creates RejectSilenceNotFound with default headers values
+func NewRejectSilenceNotFound() *RejectSilenceNotFound {
+
+	return &RejectSilenceNotFound{}
+}
+
+// WriteResponse to the client
+func (o *RejectSilenceNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// RejectSilenceInternalServerErrorCode is the HTTP code returned for type RejectSilenceInternalServerError
+const RejectSilenceInternalServerErrorCode int = 500
+
+/*
+RejectSilenceInternalServerError Internal server error
+
+swagger:response rejectSilenceInternalServerError
+*/
+type RejectSilenceInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewRejectSilenceInternalServerError creates RejectSilenceInternalServerError with default headers values
+func NewRejectSilenceInternalServerError() *RejectSilenceInternalServerError {
+
+	return &RejectSilenceInternalServerError{}
+}
+
+// WithPayload adds the payload to the reject silence internal server error response
+func (o *RejectSilenceInternalServerError) WithPayload(payload string) *RejectSilenceInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reject silence internal server error response
+func (o *RejectSilenceInternalServerError) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RejectSilenceInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
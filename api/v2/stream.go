@@ -0,0 +1,439 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log/level"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	prometheus_model "github.com/prometheus/common/model"
+
+	open_api_models "github.com/prometheus/alertmanager/api/v2/models"
+	alert_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/alert"
+	"github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// Types of the events of the alert stream.
+const (
+	AlertEventCreated     = "created"
+	AlertEventUpdated     = "updated"
+	AlertEventResolved    = "resolved"
+	AlertEventSilenced    = "silenced"
+	AlertEventUnsilenced  = "unsilenced"
+	AlertEventInhibited   = "inhibited"
+	AlertEventUninhibited = "uninhibited"
+)
+
+const (
+	// alertStreamHistory is the number of events kept to resume streams
+	// from.
+	alertStreamHistory = 1024
+	// alertStreamInterval is the interval at which the status of alerts is
+	// checked for changes made by silences and inhibitions, and at which
+	// idle streams are kept alive.
+	alertStreamInterval = 5 * time.Second
+)
+
+type alertEvent struct {
+	seq   uint64
+	typ   string
+	alert *open_api_models.GettableAlert
+}
+
+type trackedAlert struct {
+	alert     *types.Alert
+	gettable  *open_api_models.GettableAlert
+	silenced  bool
+	inhibited bool
+}
+
+// alertStream turns the changes to alerts into events. It only follows the
+// alerts while streams are open and keeps the latest events so that streams
+// can be resumed. Events cannot be resumed across periods without open
+// streams, as changes are missed in between.
+type alertStream struct {
+	api *API
+	mtx sync.Mutex
+	// epoch distinguishes the event IDs of different processes and of
+	// different periods of following the alerts.
+	epoch   int64
+	seq     uint64
+	history []alertEvent
+	tracked map[prometheus_model.Fingerprint]*trackedAlert
+	// changed is closed and replaced whenever events are added.
+	changed chan struct{}
+	streams int
+	cancel  context.CancelFunc
+	// done is closed once the last run has exited.
+	done chan struct{}
+}
+
+func newAlertStream(api *API) *alertStream {
+	return &alertStream{
+		api:     api,
+		epoch:   time.Now().UnixNano(),
+		tracked: map[prometheus_model.Fingerprint]*trackedAlert{},
+		changed: make(chan struct{}),
+	}
+}
+
+// open registers a new stream, starting to follow the alerts if it is the
+// first one. It returns the events the stream starts with and the sequence
+// number to continue from.
+func (s *alertStream) open(lastEventID string) ([]alertEvent, uint64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.streams++
+	if s.streams == 1 {
+		s.restart()
+	}
+
+	if seq, ok := s.parseID(lastEventID); ok && seq <= s.seq && (len(s.history) == 0 || seq+1 >= s.history[0].seq) {
+		return nil, seq
+	}
+
+	// The stream cannot be resumed, start with the current alerts.
+	events := make([]alertEvent, 0, len(s.tracked))
+	for _, t := range s.tracked {
+		events = append(events, alertEvent{seq: s.seq, typ: AlertEventCreated, alert: t.gettable})
+	}
+	return events, s.seq
+}
+
+// restart starts following the alerts from scratch. The previous run is
+// canceled at this point and doesn't change the state anymore, but the new
+// run only starts observing once it has exited. It must be called with s.mtx
+// held.
+func (s *alertStream) restart() {
+	epoch := time.Now().UnixNano()
+	if epoch <= s.epoch {
+		epoch = s.epoch + 1
+	}
+	s.epoch = epoch
+	s.seq = 0
+	s.history = nil
+	s.tracked = map[prometheus_model.Fingerprint]*trackedAlert{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	prev, done := s.done, make(chan struct{})
+	s.cancel, s.done = cancel, done
+	go func() {
+		defer close(done)
+		if prev != nil {
+			<-prev
+		}
+		s.run(ctx)
+	}()
+}
+
+// close unregisters a stream, stopping to follow the alerts if it was the
+// last one.
+func (s *alertStream) close() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.streams--
+	if s.streams == 0 {
+		s.cancel()
+	}
+}
+
+// next returns the events after the given sequence number and a channel
+// that is closed once there are further events. It returns an error if the
+// events are not available anymore.
+func (s *alertStream) next(seq uint64) ([]alertEvent, <-chan struct{}, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if len(s.history) > 0 && seq+1 < s.history[0].seq {
+		return nil, nil, errors.New("stream fell behind")
+	}
+	var events []alertEvent
+	for _, e := range s.history {
+		if e.seq > seq {
+			events = append(events, e)
+		}
+	}
+	return events, s.changed, nil
+}
+
+func (s *alertStream) run(ctx context.Context) {
+	alerts := s.api.alerts.Subscribe()
+	defer alerts.Close()
+
+	t := time.NewTicker(alertStreamInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case a, ok := <-alerts.Next():
+			if !ok {
+				if err := alerts.Err(); err != nil {
+					level.Error(s.api.logger).Log("msg", "Error iterating alerts for the alert stream", "err", err)
+				}
+				return
+			}
+			s.mtx.Lock()
+			if ctx.Err() == nil {
+				s.observe(a, time.Now())
+			}
+			s.mtx.Unlock()
+		case <-t.C:
+			s.refresh(ctx)
+		}
+	}
+}
+
+// refresh checks the tracked alerts for resolution and status changes.
+func (s *alertStream) refresh(ctx context.Context) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if ctx.Err() != nil {
+		return
+	}
+	now := time.Now()
+	for fp, t := range s.tracked {
+		a, err := s.api.alerts.Get(fp)
+		if err != nil {
+			// The alert was garbage collected after resolving.
+			delete(s.tracked, fp)
+			s.emit(AlertEventResolved, t.gettable)
+			continue
+		}
+		s.observe(a, now)
+	}
+}
+
+// observe emits the events for the changes to the given alert since it was
+// last observed. It must be called with s.mtx held.
+func (s *alertStream) observe(a *types.Alert, now time.Time) {
+	fp := a.Fingerprint()
+	prev, seen := s.tracked[fp]
+	if !seen && a.ResolvedAt(now) {
+		return
+	}
+
+	cur := s.track(a)
+	if !seen {
+		s.tracked[fp] = cur
+		s.emit(AlertEventCreated, cur.gettable)
+		return
+	}
+	if a.ResolvedAt(now) {
+		delete(s.tracked, fp)
+		s.emit(AlertEventResolved, cur.gettable)
+		return
+	}
+	s.tracked[fp] = cur
+
+	if !a.StartsAt.Equal(prev.alert.StartsAt) || a.GeneratorURL != prev.alert.GeneratorURL || !reflect.DeepEqual(a.Annotations, prev.alert.Annotations) {
+		s.emit(AlertEventUpdated, cur.gettable)
+	}
+	switch {
+	case cur.silenced && !prev.silenced:
+		s.emit(AlertEventSilenced, cur.gettable)
+	case !cur.silenced && prev.silenced:
+		s.emit(AlertEventUnsilenced, cur.gettable)
+	}
+	switch {
+	case cur.inhibited && !prev.inhibited:
+		s.emit(AlertEventInhibited, cur.gettable)
+	case !cur.inhibited && prev.inhibited:
+		s.emit(AlertEventUninhibited, cur.gettable)
+	}
+}
+
+func (s *alertStream) track(a *types.Alert) *trackedAlert {
+	s.api.mtx.RLock()
+	defer s.api.mtx.RUnlock()
+
+	s.api.setAlertStatus(a)
+	status := s.api.getAlertStatus(a.Fingerprint())
+
+	var receivers []string
+	if s.api.route != nil {
+		for _, r := range s.api.route.Match(a.Labels) {
+			receivers = append(receivers, r.RouteOpts.Receiver)
+		}
+	}
+	return &trackedAlert{
+		alert:     a,
+		gettable:  AlertToOpenAPIAlert(a, status, receivers),
+		silenced:  len(status.SilencedBy) > 0,
+		inhibited: len(status.InhibitedBy) > 0,
+	}
+}
+
+// emit must be called with s.mtx held.
+func (s *alertStream) emit(typ string, alert *open_api_models.GettableAlert) {
+	s.seq++
+	s.history = append(s.history, alertEvent{seq: s.seq, typ: typ, alert: alert})
+	if len(s.history) > alertStreamHistory {
+		s.history = s.history[len(s.history)-alertStreamHistory:]
+	}
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+func (s *alertStream) id(seq uint64) string {
+	return fmt.Sprintf("%x-%d", s.epoch, seq)
+}
+
+func (s *alertStream) parseID(id string) (uint64, bool) {
+	epoch, seq, ok := strings.Cut(id, "-")
+	if !ok || epoch != strconv.FormatInt(s.epoch, 16) {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(seq, 10, 64)
+	return n, err == nil
+}
+
+func (api *API) streamAlertsHandler(params alert_ops.StreamAlertsParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	matchers, err := parseFilter(params.Filter)
+	if err != nil {
+		level.Debug(logger).Log("msg", "Failed to parse matchers", "err", err)
+		return alert_ops.NewStreamAlertsBadRequest().WithPayload(err.Error())
+	}
+
+	var receiverFilter *regexp.Regexp
+	if params.Receiver != nil {
+		receiverFilter, err = regexp.Compile("^(?:" + *params.Receiver + ")$")
+		if err != nil {
+			level.Debug(logger).Log("msg", "Failed to compile receiver regex", "err", err)
+			return alert_ops.NewStreamAlertsBadRequest().WithPayload(
+				fmt.Sprintf("failed to parse receiver param: %v", err.Error()),
+			)
+		}
+	}
+
+	var lastEventID string
+	if params.LastEventID != nil {
+		lastEventID = *params.LastEventID
+	}
+
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+
+		s := &eventWriter{
+			w:              w,
+			stream:         api.stream,
+			matchers:       matchers,
+			receiverFilter: receiverFilter,
+		}
+		if err := s.run(params.HTTPRequest.Context(), lastEventID, flusher); err != nil {
+			level.Debug(logger).Log("msg", "Alert stream ended", "err", err)
+		}
+	})
+}
+
+// eventWriter writes the events of an alert stream to a client.
+type eventWriter struct {
+	w              http.ResponseWriter
+	stream         *alertStream
+	matchers       []*labels.Matcher
+	receiverFilter *regexp.Regexp
+}
+
+func (e *eventWriter) run(ctx context.Context, lastEventID string, flusher http.Flusher) error {
+	events, seq := e.stream.open(lastEventID)
+	defer e.stream.close()
+
+	t := time.NewTicker(alertStreamInterval)
+	defer t.Stop()
+
+	for {
+		for _, ev := range events {
+			if err := e.write(ev); err != nil {
+				return err
+			}
+			seq = ev.seq
+		}
+		flusher.Flush()
+
+		var (
+			changed <-chan struct{}
+			err     error
+		)
+		events, changed, err = e.stream.next(seq)
+		if err != nil {
+			return err
+		}
+		if len(events) > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		case <-t.C:
+			// Comments keep the connection alive.
+			if _, err := fmt.Fprint(e.w, ": keepalive\n\n"); err != nil {
+				return err
+			}
+		}
+		events, _, err = e.stream.next(seq)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (e *eventWriter) write(ev alertEvent) error {
+	if !matchFilterLabels(e.matchers, ev.alert.Labels) {
+		return nil
+	}
+	if e.receiverFilter != nil {
+		receivers := make([]string, 0, len(ev.alert.Receivers))
+		for _, r := range ev.alert.Receivers {
+			receivers = append(receivers, *r.Name)
+		}
+		if !receiversMatchFilter(receivers, e.receiverFilter) {
+			return nil
+		}
+	}
+
+	b, err := json.Marshal(ev.alert)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(e.w, "id: %s\nevent: %s\ndata: %s\n\n", e.stream.id(ev.seq), ev.typ, b)
+	return err
+}
@@ -0,0 +1,250 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/ack"
+	open_api_models "github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/dispatch"
+	"github.com/prometheus/alertmanager/provider/mem"
+	"github.com/prometheus/alertmanager/silence"
+	"github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+type testEvent struct {
+	id, typ string
+	alert   open_api_models.GettableAlert
+}
+
+// readEvents reads events from an event stream, skipping comments.
+func readEvents(t *testing.T, r *bufio.Reader, n int) []testEvent {
+	var (
+		events []testEvent
+		cur    testEvent
+	)
+	for len(events) < n {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			if cur.typ != "" {
+				events = append(events, cur)
+			}
+			cur = testEvent{}
+		case strings.HasPrefix(line, "id: "):
+			cur.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			cur.typ = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &cur.alert))
+		}
+	}
+	return events
+}
+
+func TestStreamAlerts(t *testing.T) {
+	cfg, err := config.Load(`
+route:
+  receiver: team-X
+receivers:
+- name: team-X
+`)
+	require.NoError(t, err)
+
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, nil, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	defer alerts.Close()
+	silences, err := silence.New(silence.Options{})
+	require.NoError(t, err)
+	acks, err := ack.New(ack.Options{})
+	require.NoError(t, err)
+	silencer := silence.NewSilencer(silences, marker, log.NewNopLogger())
+
+	api, err := NewAPI(alerts, func(func(*dispatch.Route) bool, func(*types.Alert, time.Time) bool) (dispatch.AlertGroups, map[model.Fingerprint][]string) {
+		return nil, nil
+	}, marker.Status, silences, acks, nil, nil, false, nil, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	api.Update(cfg, func(a *types.Alert) { silencer.Mutes(a.Labels) })
+
+	srv := httptest.NewServer(api.Handler)
+	defer srv.Close()
+
+	now := time.Now()
+	put := func(lset model.LabelSet, endsAt time.Time, annotations model.LabelSet) {
+		require.NoError(t, alerts.Put(&types.Alert{
+			Alert:     model.Alert{Labels: lset, Annotations: annotations, StartsAt: now.Add(-time.Minute), EndsAt: endsAt},
+			UpdatedAt: time.Now(),
+		}))
+	}
+	put(model.LabelSet{"alertname": "a"}, now.Add(time.Hour), nil)
+
+	open := func(lastEventID string, filter ...string) (*bufio.Reader, func()) {
+		req, err := http.NewRequest("GET", srv.URL+"/api/v2/alerts/stream", nil)
+		require.NoError(t, err)
+		q := req.URL.Query()
+		for _, f := range filter {
+			q.Add("filter", f)
+		}
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set("Accept", "text/event-stream")
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+		return bufio.NewReader(res.Body), func() { res.Body.Close() }
+	}
+
+	// The first stream starts following the alerts and receives them as
+	// they are observed.
+	r, closeStream := open("", `alertname=~"a|b"`)
+	events := readEvents(t, r, 1)
+	require.Equal(t, AlertEventCreated, events[0].typ)
+	require.Equal(t, open_api_models.LabelSet{"alertname": "a"}, events[0].alert.Labels)
+
+	// Alerts not matching the filter are left out.
+	put(model.LabelSet{"alertname": "c"}, now.Add(time.Hour), nil)
+	put(model.LabelSet{"alertname": "b"}, now.Add(time.Hour), nil)
+	put(model.LabelSet{"alertname": "a"}, now.Add(time.Hour), model.LabelSet{"summary": "changed"})
+	events = readEvents(t, r, 2)
+	require.Equal(t, AlertEventCreated, events[0].typ)
+	require.Equal(t, open_api_models.LabelSet{"alertname": "b"}, events[0].alert.Labels)
+	require.Equal(t, AlertEventUpdated, events[1].typ)
+	require.Equal(t, open_api_models.LabelSet{"summary": "changed"}, events[1].alert.Annotations)
+	lastID := events[1].id
+
+	// A new stream starts with the firing alerts.
+	r2, closeStream2 := open("")
+	events = readEvents(t, r2, 3)
+	for _, e := range events {
+		require.Equal(t, AlertEventCreated, e.typ)
+	}
+
+	put(model.LabelSet{"alertname": "b"}, now.Add(-time.Second), nil)
+	events = readEvents(t, r, 1)
+	require.Equal(t, AlertEventResolved, events[0].typ)
+	require.Equal(t, open_api_models.LabelSet{"alertname": "b"}, events[0].alert.Labels)
+	closeStream()
+
+	// Streams resume after the last event received.
+	r, closeStream = open(lastID)
+	defer closeStream()
+	events = readEvents(t, r, 1)
+	require.Equal(t, AlertEventResolved, events[0].typ)
+	closeStream2()
+
+	// Status changes are picked up by the periodic checks.
+	_, err = silences.Set(&silencepb.Silence{
+		Matchers: []*silencepb.Matcher{{Type: silencepb.Matcher_EQUAL, Name: "alertname", Pattern: "a"}},
+		StartsAt: now,
+		EndsAt:   now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	events = readEvents(t, r, 1)
+	require.Equal(t, AlertEventSilenced, events[0].typ)
+	require.Equal(t, []string{}, events[0].alert.Status.InhibitedBy)
+	require.Len(t, events[0].alert.Status.SilencedBy, 1)
+}
+
+func TestAlertStreamRestart(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, nil, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	defer alerts.Close()
+	require.NoError(t, alerts.Put(&types.Alert{
+		Alert:     model.Alert{Labels: model.LabelSet{"alertname": "a"}, StartsAt: time.Now(), EndsAt: time.Now().Add(time.Hour)},
+		UpdatedAt: time.Now(),
+	}))
+
+	api, err := NewAPI(alerts, nil, marker.Status, nil, nil, nil, nil, false, nil, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	api.Update(&config.Config{Route: &config.Route{Receiver: "team-X"}}, func(*types.Alert) {})
+	s := api.stream
+
+	_, seq := s.open("")
+	require.Eventually(t, func() bool {
+		events, _, err := s.next(seq)
+		return err == nil && len(events) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	lastID := s.id(seq + 1)
+	s.close()
+
+	// Changes are missed while no stream is open, the events from before
+	// cannot be resumed from.
+	events, _ := s.open(lastID)
+	defer s.close()
+	require.Empty(t, events)
+	_, ok := s.parseID(lastID)
+	require.False(t, ok)
+	require.Eventually(t, func() bool {
+		events, _, err := s.next(0)
+		return err == nil && len(events) == 1 && events[0].typ == AlertEventCreated
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestAlertStreamRefreshGarbageCollected(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, nil, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	defer alerts.Close()
+	api, err := NewAPI(alerts, nil, marker.Status, nil, nil, nil, nil, false, nil, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	s := api.stream
+
+	// The alert isn't known to the provider anymore.
+	gettable := &open_api_models.GettableAlert{Alert: open_api_models.Alert{Labels: open_api_models.LabelSet{"alertname": "a"}}}
+	s.tracked[model.LabelSet{"alertname": "a"}.Fingerprint()] = &trackedAlert{gettable: gettable}
+	s.refresh(context.Background())
+
+	require.Empty(t, s.tracked)
+	events, _, err := s.next(0)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, AlertEventResolved, events[0].typ)
+	require.Equal(t, gettable, events[0].alert)
+}
+
+func TestAlertStreamIDs(t *testing.T) {
+	s := newAlertStream(&API{})
+	seq, ok := s.parseID(s.id(42))
+	require.True(t, ok)
+	require.Equal(t, uint64(42), seq)
+
+	other := newAlertStream(&API{})
+	other.epoch++
+	_, ok = s.parseID(other.id(42))
+	require.False(t, ok)
+	_, ok = s.parseID("invalid")
+	require.False(t, ok)
+}
@@ -39,7 +39,7 @@ var static http.FileSystem = filter.Keep(
 var templates http.FileSystem = filter.Keep(
 	http.Dir("../template"),
 	func(path string, fi os.FileInfo) bool {
-		return path == "/" || path == "/default.tmpl" || path == "/email.tmpl"
+		return path == "/" || path == "/default.tmpl" || path == "/email.tmpl" || path == "/translations.yml"
 	},
 )
 
@@ -18,7 +18,8 @@ import (
 )
 
 func configureAlertCmd(app *kingpin.Application) {
-	alertCmd := app.Command("alert", "Add or query alerts.").PreAction(requireAlertManagerURL)
+	alertCmd := app.Command("alert", "Add, query or acknowledge alerts.").PreAction(requireAlertManagerURL)
 	configureQueryAlertsCmd(alertCmd)
 	configureAddAlertCmd(alertCmd)
+	configureAckAlertCmd(alertCmd)
 }
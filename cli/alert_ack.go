@@ -0,0 +1,103 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/alecthomas/kingpin/v2"
+
+	"github.com/prometheus/alertmanager/api/v2/client/alert"
+	"github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/prometheus/alertmanager/matchers/compat"
+)
+
+type alertAckCmd struct {
+	author        string
+	comment       string
+	withdraw      bool
+	matcherGroups []string
+}
+
+const alertAckHelp = `Acknowledge firing alerts.
+
+Acknowledging an alert records that someone is looking into it. The alert keeps
+firing and is not silenced, but routes with suppress_acknowledged_repeats set
+do not repeat notifications for groups whose firing alerts have all been
+acknowledged. Acknowledgements end when the alert resolves.
+
+amtool alert ack alertname=foo node=bar -c "looking into it"
+
+	Acknowledge all firing alerts with the alertname=foo and node=bar label
+	value pairs set. The matchers follow the syntax of amtool alert query.
+
+amtool alert ack --withdraw alertname=foo
+
+	Withdraw the acknowledgements of the matching alerts.
+`
+
+func configureAckAlertCmd(cc *kingpin.CmdClause) {
+	var (
+		a      = &alertAckCmd{}
+		ackCmd = cc.Command("ack", alertAckHelp)
+	)
+	ackCmd.Flag("author", "Username to record as acknowledging the alerts").Short('a').Default(username()).StringVar(&a.author)
+	ackCmd.Flag("comment", "A comment to help describe the acknowledgement").Short('c').StringVar(&a.comment)
+	ackCmd.Flag("withdraw", "Withdraw the acknowledgements instead").BoolVar(&a.withdraw)
+	ackCmd.Arg("matcher-groups", "Alerts to acknowledge").Required().StringsVar(&a.matcherGroups)
+	ackCmd.Action(execWithTimeout(a.ack))
+}
+
+func (a *alertAckCmd) ack(ctx context.Context, _ *kingpin.ParseContext) error {
+	// Assume alertname=<arg> if the first argument is not a matcher, as
+	// amtool alert query does.
+	if _, err := compat.Matcher(a.matcherGroups[0], "cli"); err != nil {
+		a.matcherGroups[0] = fmt.Sprintf("alertname=%s", strconv.Quote(a.matcherGroups[0]))
+	}
+	if !a.withdraw && a.author == "" {
+		return errors.New("missing author")
+	}
+
+	amclient := NewAlertmanagerClient(alertmanagerURL)
+
+	getOk, err := amclient.Alert.GetAlerts(alert.NewGetAlertsParams().WithContext(ctx).WithFilter(a.matcherGroups))
+	if err != nil {
+		return err
+	}
+	if len(getOk.Payload) == 0 {
+		return errors.New("no alerts match")
+	}
+
+	for _, al := range getOk.Payload {
+		fp := *al.Fingerprint
+		if a.withdraw {
+			if al.Status.Acknowledgement == nil {
+				continue
+			}
+			if _, err := amclient.Alert.UnacknowledgeAlert(alert.NewUnacknowledgeAlertParams().WithContext(ctx).WithFingerprint(fp)); err != nil {
+				return err
+			}
+			continue
+		}
+		params := alert.NewAcknowledgeAlertParams().WithContext(ctx).WithFingerprint(fp).
+			WithAcknowledgement(&models.PostableAcknowledgement{AcknowledgedBy: &a.author, Comment: a.comment})
+		if _, err := amclient.Alert.AcknowledgeAlert(params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
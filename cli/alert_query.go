@@ -17,7 +17,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
 
 	"github.com/alecthomas/kingpin/v2"
 
@@ -28,7 +31,10 @@ import (
 
 type alertQueryCmd struct {
 	inhibited, silenced, active, unprocessed bool
+	watch                                    bool
 	receiver                                 string
+	sort                                     string
+	limit                                    int64
 	matcherGroups                            []string
 }
 
@@ -58,6 +64,18 @@ amtool alert query 'alertname=~foo.*'
 Amtool supports several flags for filtering the returned alerts by state
 (inhibited, silenced, active, unprocessed). If none of these flags is given,
 only active alerts are returned.
+
+amtool alert query --sort=-startsAt --limit=10
+
+	Alerts are sorted by fingerprint unless "--sort" names another key:
+	startsAt or severity, prefixed with - for descending order. "--limit"
+	shows only the first alerts.
+
+amtool alert query --watch alertname=foo
+
+	Instead of listing the alerts once, print the changes to the matching
+	alerts as they happen: alerts being created, updated, resolved, silenced,
+	unsilenced, inhibited or uninhibited. The state flags do not apply.
 `
 
 func configureQueryAlertsCmd(cc *kingpin.CmdClause) {
@@ -70,11 +88,23 @@ func configureQueryAlertsCmd(cc *kingpin.CmdClause) {
 	queryCmd.Flag("active", "Show active alerts").Short('a').BoolVar(&a.active)
 	queryCmd.Flag("unprocessed", "Show unprocessed alerts").Short('u').BoolVar(&a.unprocessed)
 	queryCmd.Flag("receiver", "Show alerts matching receiver (Supports regex syntax)").Short('r').StringVar(&a.receiver)
+	queryCmd.Flag("sort", "Sort alerts by fingerprint, startsAt or severity, prefix with - for descending order").StringVar(&a.sort)
+	queryCmd.Flag("limit", "Show at most this many alerts").Int64Var(&a.limit)
+	queryCmd.Flag("watch", "Stream changes to the matching alerts").Short('w').BoolVar(&a.watch)
 	queryCmd.Arg("matcher-groups", "Query filter").StringsVar(&a.matcherGroups)
-	queryCmd.Action(execWithTimeout(a.queryAlerts))
+	queryCmd.Action(func(pc *kingpin.ParseContext) error {
+		if a.watch {
+			// Watching runs until interrupted, regardless of the timeout.
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			a.parseMatcherGroups()
+			return a.watchAlerts(ctx)
+		}
+		return execWithTimeout(a.queryAlerts)(pc)
+	})
 }
 
-func (a *alertQueryCmd) queryAlerts(ctx context.Context, _ *kingpin.ParseContext) error {
+func (a *alertQueryCmd) parseMatcherGroups() {
 	if len(a.matcherGroups) > 0 {
 		// Attempt to parse the first argument. If the parser fails
 		// then we likely don't have a (=|=~|!=|!~) so lets assume that
@@ -86,6 +116,10 @@ func (a *alertQueryCmd) queryAlerts(ctx context.Context, _ *kingpin.ParseContext
 			a.matcherGroups[0] = fmt.Sprintf("alertname=%s", strconv.Quote(m))
 		}
 	}
+}
+
+func (a *alertQueryCmd) queryAlerts(ctx context.Context, _ *kingpin.ParseContext) error {
+	a.parseMatcherGroups()
 
 	// If no selector was passed, default to showing active alerts.
 	if !a.silenced && !a.inhibited && !a.active && !a.unprocessed {
@@ -99,6 +133,12 @@ func (a *alertQueryCmd) queryAlerts(ctx context.Context, _ *kingpin.ParseContext
 		WithUnprocessed(&a.unprocessed).
 		WithReceiver(&a.receiver).
 		WithFilter(a.matcherGroups)
+	if a.sort != "" {
+		alertParams.SetSort(&a.sort)
+	}
+	if a.limit > 0 {
+		alertParams.SetLimit(&a.limit)
+	}
 
 	amclient := NewAlertmanagerClient(alertmanagerURL)
 
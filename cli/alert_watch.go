@@ -0,0 +1,173 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	promconfig "github.com/prometheus/common/config"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+// watchRetryInterval is how long to wait before reconnecting to the alert
+// stream after it ended.
+const watchRetryInterval = 5 * time.Second
+
+// alertEvent is an event of the alert stream.
+type alertEvent struct {
+	ID    string                `json:"id"`
+	Event string                `json:"event"`
+	Alert *models.GettableAlert `json:"alert"`
+}
+
+// watchAlerts prints the events of the alert stream matching the query until
+// the context is canceled, reconnecting whenever the stream ends.
+func (a *alertQueryCmd) watchAlerts(ctx context.Context) error {
+	httpClient := http.DefaultClient
+	if httpConfigFile != "" {
+		httpConfig, _, err := promconfig.LoadHTTPConfigFile(httpConfigFile)
+		if err != nil {
+			return fmt.Errorf("failed to load HTTP config file: %w", err)
+		}
+		if httpClient, err = promconfig.NewClientFromConfig(*httpConfig, "amtool"); err != nil {
+			return fmt.Errorf("failed to create a new HTTP client: %w", err)
+		}
+	}
+
+	u := *alertmanagerURL
+	if u.Scheme == "" {
+		u.Scheme = "http"
+	}
+	if u.Host == "" {
+		u.Host = defaultAmHost + ":" + defaultAmPort
+	}
+	u.Path = path.Join(u.Path, defaultAmApiv2path, "alerts/stream")
+	q := url.Values{"filter": a.matcherGroups}
+	if a.receiver != "" {
+		q.Set("receiver", a.receiver)
+	}
+	u.RawQuery = q.Encode()
+
+	var lastEventID string
+	for {
+		err := streamAlertEvents(ctx, httpClient, u.String(), lastEventID, func(e alertEvent) error {
+			lastEventID = e.ID
+			return printAlertEvent(e)
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+		var statusErr alertStreamStatusError
+		if errors.As(err, &statusErr) && statusErr < 500 {
+			return err
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Alert stream ended: %v, reconnecting in %s\n", err, watchRetryInterval)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchRetryInterval):
+		}
+	}
+}
+
+type alertStreamStatusError int
+
+func (e alertStreamStatusError) Error() string {
+	return fmt.Sprintf("unexpected status code %d", int(e))
+}
+
+// streamAlertEvents reads the events of the alert stream at the given URL
+// and passes them to fn until the stream ends.
+func streamAlertEvents(ctx context.Context, c *http.Client, u, lastEventID string, fn func(alertEvent) error) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, res.Body)
+		return alertStreamStatusError(res.StatusCode)
+	}
+
+	var (
+		scanner = bufio.NewScanner(res.Body)
+		event   alertEvent
+		data    strings.Builder
+	)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line != "" {
+			field, value, _ := strings.Cut(line, ":")
+			value = strings.TrimPrefix(value, " ")
+			switch field {
+			case "id":
+				event.ID = value
+			case "event":
+				event.Event = value
+			case "data":
+				data.WriteString(value)
+			}
+			continue
+		}
+
+		// An empty line dispatches the event.
+		if data.Len() > 0 {
+			event.Alert = &models.GettableAlert{}
+			if err := json.Unmarshal([]byte(data.String()), event.Alert); err != nil {
+				return err
+			}
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+		event.Event, event.Alert = "", nil
+		data.Reset()
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return io.ErrUnexpectedEOF
+}
+
+func printAlertEvent(e alertEvent) error {
+	if output == "json" {
+		return json.NewEncoder(os.Stdout).Encode(e)
+	}
+	_, err := fmt.Printf("%s %-11s %s\n", time.Now().Format(time.RFC3339), e.Event, convertClientToCommonLabelSet(e.Alert.Labels))
+	return err
+}
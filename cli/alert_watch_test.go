@@ -0,0 +1,58 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+func TestStreamAlertEvents(t *testing.T) {
+	var lastEventID string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastEventID = r.Header.Get("Last-Event-ID")
+		if r.URL.Query().Get("filter") == "invalid" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, ": keepalive\n\n")
+		fmt.Fprint(w, "id: 1-1\nevent: created\ndata: {\"labels\":{\"alertname\":\"a\"}}\n\n")
+		fmt.Fprint(w, "id: 1-2\nevent: resolved\ndata: {\"labels\":{\"alertname\":\"b\"}}\n\n")
+	}))
+	defer srv.Close()
+
+	var events []alertEvent
+	err := streamAlertEvents(context.Background(), srv.Client(), srv.URL, "1-0", func(e alertEvent) error {
+		events = append(events, e)
+		return nil
+	})
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+	require.Equal(t, "1-0", lastEventID)
+	require.Equal(t, []alertEvent{
+		{ID: "1-1", Event: "created", Alert: &models.GettableAlert{Alert: models.Alert{Labels: models.LabelSet{"alertname": "a"}}}},
+		{ID: "1-2", Event: "resolved", Alert: &models.GettableAlert{Alert: models.Alert{Labels: models.LabelSet{"alertname": "b"}}}},
+	}, events)
+
+	err = streamAlertEvents(context.Background(), srv.Client(), srv.URL+"?filter=invalid", "", func(alertEvent) error { return nil })
+	require.Equal(t, alertStreamStatusError(http.StatusBadRequest), err)
+}
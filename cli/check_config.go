@@ -20,6 +20,7 @@ import (
 	"github.com/alecthomas/kingpin/v2"
 
 	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/config/receiver"
 	"github.com/prometheus/alertmanager/template"
 )
 
@@ -81,10 +82,13 @@ func CheckConfig(args []string) error {
 				fmt.Println(" - route")
 			}
 			fmt.Printf(" - %d inhibit rules\n", len(cfg.InhibitRules))
+			if cfg.Topology != nil {
+				fmt.Printf(" - %d topology services (%d derived inhibit rules)\n", len(cfg.Topology.Services), len(cfg.Topology.InhibitRules()))
+			}
 			fmt.Printf(" - %d receivers\n", len(cfg.Receivers))
 			fmt.Printf(" - %d templates\n", len(cfg.Templates))
+			tmpl, err := template.FromGlobs(cfg.Templates)
 			if len(cfg.Templates) > 0 {
-				_, err = template.FromGlobs(cfg.Templates)
 				if err != nil {
 					fmt.Printf("  FAILED: %s\n", err)
 					failed++
@@ -92,6 +96,26 @@ func CheckConfig(args []string) error {
 					fmt.Printf("  SUCCESS\n")
 				}
 			}
+			if err == nil && len(cfg.Translations) > 0 {
+				fmt.Printf(" - %d translations\n", len(cfg.Translations))
+				if err = tmpl.LoadTranslations(cfg.Translations...); err != nil {
+					fmt.Printf("  FAILED: %s\n", err)
+					failed++
+				} else {
+					fmt.Printf("  SUCCESS\n")
+				}
+			}
+			if err == nil {
+				fmt.Println("Rendering receiver templates")
+				if terrs := receiver.ValidateTemplates(cfg.Receivers, tmpl); len(terrs) > 0 {
+					for _, terr := range terrs {
+						fmt.Printf("  FAILED: %s\n", terr)
+					}
+					failed++
+				} else {
+					fmt.Printf("  SUCCESS\n")
+				}
+			}
 		}
 		fmt.Printf("\n")
 	}
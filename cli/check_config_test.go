@@ -27,4 +27,9 @@ func TestCheckConfig(t *testing.T) {
 	if err == nil {
 		t.Fatalf("failed to detect invalid file.")
 	}
+
+	err = CheckConfig([]string{"testdata/conf.bad-template.yml"})
+	if err == nil {
+		t.Fatalf("failed to detect invalid receiver template.")
+	}
 }
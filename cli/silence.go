@@ -19,10 +19,13 @@ import (
 
 // silenceCmd represents the silence command
 func configureSilenceCmd(app *kingpin.Application) {
-	silenceCmd := app.Command("silence", "Add, expire or view silences. For more information and additional flags see query help").PreAction(requireAlertManagerURL)
+	silenceCmd := app.Command("silence", "Add, expire, approve or view silences. For more information and additional flags see query help").PreAction(requireAlertManagerURL)
 	configureSilenceAddCmd(silenceCmd)
+	configureSilenceApproveCmd(silenceCmd)
 	configureSilenceExpireCmd(silenceCmd)
 	configureSilenceImportCmd(silenceCmd)
+	configureSilenceLintCmd(silenceCmd)
 	configureSilenceQueryCmd(silenceCmd)
+	configureSilenceRejectCmd(silenceCmd)
 	configureSilenceUpdateCmd(silenceCmd)
 }
@@ -15,10 +15,13 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"os/user"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/alecthomas/kingpin/v2"
@@ -27,6 +30,7 @@ import (
 
 	"github.com/prometheus/alertmanager/api/v2/client/silence"
 	"github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/prometheus/alertmanager/cli/format"
 	"github.com/prometheus/alertmanager/matchers/compat"
 	"github.com/prometheus/alertmanager/pkg/labels"
 )
@@ -47,6 +51,7 @@ type silenceAddCmd struct {
 	end            string
 	comment        string
 	matchers       []string
+	dryRun         bool
 }
 
 const silenceAddHelp = `Add a new alertmanager silence
@@ -71,6 +76,11 @@ const silenceAddHelp = `Add a new alertmanager silence
 	As well as direct equality, regex matching is also supported. The '=~' syntax
 	(similar to Prometheus) is used to represent a regex match. Regex matching
 	can be used in combination with a direct match.
+
+  amtool silence add --dry-run alertname=foo
+
+	Show the alerts, receivers and aggregation groups the silence would affect
+	without adding it.
 `
 
 func configureSilenceAddCmd(cc *kingpin.CmdClause) {
@@ -84,6 +94,7 @@ func configureSilenceAddCmd(cc *kingpin.CmdClause) {
 	addCmd.Flag("start", "Set when the silence should start. RFC3339 format 2006-01-02T15:04:05-07:00").StringVar(&c.start)
 	addCmd.Flag("end", "Set when the silence should end (overwrites duration). RFC3339 format 2006-01-02T15:04:05-07:00").StringVar(&c.end)
 	addCmd.Flag("comment", "A comment to help describe the silence").Short('c').StringVar(&c.comment)
+	addCmd.Flag("dry-run", "Show the alerts the silence would mute without adding it").BoolVar(&c.dryRun)
 	addCmd.Arg("matcher-groups", "Query filter").StringsVar(&c.matchers)
 	addCmd.Action(execWithTimeout(c.add))
 }
@@ -160,11 +171,21 @@ func (c *silenceAddCmd) add(ctx context.Context, _ *kingpin.ParseContext) error
 			Comment:   &c.comment,
 		},
 	}
+	amclient := NewAlertmanagerClient(alertmanagerURL)
+
+	if c.dryRun {
+		previewParams := silence.NewPreviewSilenceParams().WithContext(ctx).
+			WithSilence(ps)
+		previewOk, err := amclient.Silence.PreviewSilence(previewParams)
+		if err != nil {
+			return err
+		}
+		return formatSilencePreview(previewOk.Payload)
+	}
+
 	silenceParams := silence.NewPostSilencesParams().WithContext(ctx).
 		WithSilence(ps)
 
-	amclient := NewAlertmanagerClient(alertmanagerURL)
-
 	postOk, err := amclient.Silence.PostSilences(silenceParams)
 	if err != nil {
 		return err
@@ -172,3 +193,40 @@ func (c *silenceAddCmd) add(ctx context.Context, _ *kingpin.ParseContext) error
 	_, err = fmt.Println(postOk.Payload.SilenceID)
 	return err
 }
+
+// formatSilencePreview prints the alerts, receivers and aggregation groups a
+// silence would affect.
+func formatSilencePreview(preview *models.SilencePreview) error {
+	if output == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(preview)
+	}
+
+	formatter, found := format.Formatters[output]
+	if !found {
+		return errors.New("unknown output formatter")
+	}
+
+	fmt.Println("Alerts the silence would mute:")
+	if err := formatter.FormatAlerts(preview.Alerts); err != nil {
+		return err
+	}
+	if len(preview.UnmutedAlerts) > 0 {
+		fmt.Println("\nAlerts the silence would no longer mute:")
+		if err := formatter.FormatAlerts(preview.UnmutedAlerts); err != nil {
+			return err
+		}
+	}
+
+	receivers := make([]string, 0, len(preview.Receivers))
+	for _, r := range preview.Receivers {
+		receivers = append(receivers, *r.Name)
+	}
+	fmt.Printf("\nReceivers: %s\n", strings.Join(receivers, ", "))
+	fmt.Println("Aggregation groups:")
+	for _, g := range preview.Groups {
+		fmt.Printf("  %s %s: %d alert(s)\n", *g.Receiver.Name, convertClientToCommonLabelSet(g.Labels), len(g.Alerts))
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/prometheus/alertmanager/api/v2/client/silence"
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+type silenceApproveCmd struct {
+	approver string
+	comment  string
+	ids      []string
+}
+
+const approveSilenceHelp = `Approve alertmanager silences pending approval.
+
+Silences matching a silence approval rule of the configuration only mute alerts
+once they have been approved by someone other than their creator. Silences
+pending approval can be listed with:
+
+amtool silence query --pending-approval
+`
+
+func configureSilenceApproveCmd(cc *kingpin.CmdClause) {
+	var (
+		c          = &silenceApproveCmd{}
+		approveCmd = cc.Command("approve", approveSilenceHelp)
+	)
+	approveCmd.Flag("approver", "Username recorded as the approver").Short('a').Default(username()).StringVar(&c.approver)
+	approveCmd.Flag("comment", "A comment to help describe the decision").Short('c').StringVar(&c.comment)
+	approveCmd.Arg("silence-ids", "Ids of silences to approve").StringsVar(&c.ids)
+	approveCmd.Action(execWithTimeout(c.approve))
+}
+
+func (c *silenceApproveCmd) approve(ctx context.Context, _ *kingpin.ParseContext) error {
+	if len(c.ids) < 1 {
+		return errors.New("no silence IDs specified")
+	}
+
+	amclient := NewAlertmanagerClient(alertmanagerURL)
+
+	for _, id := range c.ids {
+		params := silence.NewApproveSilenceParams().WithContext(ctx)
+		params.SilenceID = strfmt.UUID(id)
+		params.Decision = &models.SilenceApprovalDecision{DecidedBy: &c.approver, Comment: c.comment}
+		if _, err := amclient.Silence.ApproveSilence(params); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type silenceRejectCmd struct {
+	approver string
+	comment  string
+	ids      []string
+}
+
+func configureSilenceRejectCmd(cc *kingpin.CmdClause) {
+	var (
+		c         = &silenceRejectCmd{}
+		rejectCmd = cc.Command("reject", "reject alertmanager silences pending approval, expiring them")
+	)
+	rejectCmd.Flag("approver", "Username recorded as the approver").Short('a').Default(username()).StringVar(&c.approver)
+	rejectCmd.Flag("comment", "A comment to help describe the decision").Short('c').StringVar(&c.comment)
+	rejectCmd.Arg("silence-ids", "Ids of silences to reject").StringsVar(&c.ids)
+	rejectCmd.Action(execWithTimeout(c.reject))
+}
+
+func (c *silenceRejectCmd) reject(ctx context.Context, _ *kingpin.ParseContext) error {
+	if len(c.ids) < 1 {
+		return errors.New("no silence IDs specified")
+	}
+
+	amclient := NewAlertmanagerClient(alertmanagerURL)
+
+	for _, id := range c.ids {
+		params := silence.NewRejectSilenceParams().WithContext(ctx)
+		params.SilenceID = strfmt.UUID(id)
+		params.Decision = &models.SilenceApprovalDecision{DecidedBy: &c.approver, Comment: c.comment}
+		if _, err := amclient.Silence.RejectSilence(params); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
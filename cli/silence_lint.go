@@ -0,0 +1,121 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/alecthomas/kingpin/v2"
+
+	"github.com/prometheus/alertmanager/api/v2/client/silence"
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+type silenceLintCmd struct {
+	consolidate bool
+	author      string
+}
+
+const silenceLintHelp = `Find overlapping alertmanager silences.
+
+Reports pairs of silences that have not expired yet, whose time ranges overlap
+and one of which mutes all alerts muted by the other. "duplicate" silences mute
+the same alerts, while a silence that "subsumes" another one mutes at least the
+alerts muted by the other one.
+
+  amtool silence lint --consolidate
+
+	Replace every silence subsuming others and the silences it subsumes with
+	a single silence. The new silence has the matchers of the broadest
+	silence, spans the time ranges of all of them and combines their comments.
+`
+
+func configureSilenceLintCmd(cc *kingpin.CmdClause) {
+	var (
+		c       = &silenceLintCmd{}
+		lintCmd = cc.Command("lint", silenceLintHelp)
+	)
+	lintCmd.Flag("consolidate", "Consolidate the overlapping silences").BoolVar(&c.consolidate)
+	lintCmd.Flag("author", "Username for CreatedBy field of consolidated silences").Short('a').Default(username()).StringVar(&c.author)
+	lintCmd.Action(execWithTimeout(c.lint))
+}
+
+func (c *silenceLintCmd) lint(ctx context.Context, _ *kingpin.ParseContext) error {
+	amclient := NewAlertmanagerClient(alertmanagerURL)
+
+	getOk, err := amclient.Silence.GetSilenceOverlaps(silence.NewGetSilenceOverlapsParams().WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	overlaps := getOk.Payload
+
+	if !c.consolidate {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "Silence\tKind\tOther Silence\t")
+		for _, o := range overlaps {
+			fmt.Fprintf(w, "%s\t%s\t%s\t\n", *o.SilenceID, *o.Kind, *o.OtherSilenceID)
+		}
+		return w.Flush()
+	}
+
+	// Group the silences subsumed by each silence, in order of appearance.
+	var (
+		order  []string
+		groups = map[string][]string{}
+	)
+	for _, o := range overlaps {
+		if _, ok := groups[*o.SilenceID]; !ok {
+			order = append(order, *o.SilenceID)
+		}
+		groups[*o.SilenceID] = append(groups[*o.SilenceID], *o.OtherSilenceID)
+	}
+
+	consolidated := map[string]struct{}{}
+	for _, id := range order {
+		if _, ok := consolidated[id]; ok {
+			continue
+		}
+		ids := []string{id}
+		for _, other := range groups[id] {
+			if _, ok := consolidated[other]; !ok {
+				ids = append(ids, other)
+			}
+		}
+		if len(ids) < 2 {
+			continue
+		}
+
+		params := silence.NewConsolidateSilencesParams().WithContext(ctx).
+			WithConsolidation(&models.SilenceConsolidation{SilenceIDs: ids, CreatedBy: &c.author})
+		postOk, err := amclient.Silence.ConsolidateSilences(params)
+		if err != nil {
+			var badRequest *silence.ConsolidateSilencesBadRequest
+			if errors.As(err, &badRequest) {
+				fmt.Printf("Skipped %s: %s\n", strings.Join(ids, ", "), badRequest.Payload)
+				continue
+			}
+			return err
+		}
+		for _, id := range ids {
+			consolidated[id] = struct{}{}
+		}
+		fmt.Printf("Consolidated %s into %s\n", strings.Join(ids, ", "), postOk.Payload.SilenceID)
+	}
+	return nil
+}
@@ -29,12 +29,15 @@ import (
 )
 
 type silenceQueryCmd struct {
-	expired   bool
-	quiet     bool
-	createdBy string
-	ID        string
-	matchers  []string
-	within    time.Duration
+	expired         bool
+	pendingApproval bool
+	quiet           bool
+	createdBy       string
+	ID              string
+	sort            string
+	limit           int64
+	matchers        []string
+	within          time.Duration
 }
 
 const querySilenceHelp = `Query Alertmanager silences.
@@ -77,6 +80,15 @@ preceding duration.
 amtool silence query --within 2h --expired
 
 returns all silences that expired within the preceding 2 hours.
+
+The "--pending-approval" parameter returns only the silences waiting to be
+approved, see "amtool silence approve".
+
+amtool silence query --sort=-startsAt --limit=10
+
+	Silences are sorted by state and by the time they start or end unless
+	"--sort" names another key: startsAt, endsAt or id, prefixed with - for
+	descending order. "--limit" shows only the first silences.
 `
 
 func configureSilenceQueryCmd(cc *kingpin.CmdClause) {
@@ -86,9 +98,12 @@ func configureSilenceQueryCmd(cc *kingpin.CmdClause) {
 	)
 
 	queryCmd.Flag("expired", "Show expired silences instead of active").BoolVar(&c.expired)
+	queryCmd.Flag("pending-approval", "Show only silences pending approval").BoolVar(&c.pendingApproval)
 	queryCmd.Flag("quiet", "Only show silence ids").Short('q').BoolVar(&c.quiet)
 	queryCmd.Flag("created-by", "Show silences that belong to this creator").StringVar(&c.createdBy)
 	queryCmd.Flag("id", "Get a single silence by its ID").StringVar(&c.ID)
+	queryCmd.Flag("sort", "Sort silences by startsAt, endsAt or id, prefix with - for descending order").StringVar(&c.sort)
+	queryCmd.Flag("limit", "Show at most this many silences").Int64Var(&c.limit)
 	queryCmd.Arg("matcher-groups", "Query filter").StringsVar(&c.matchers)
 	queryCmd.Flag("within", "Show silences that will expire or have expired within a duration").DurationVar(&c.within)
 	queryCmd.Action(execWithTimeout(c.query))
@@ -106,16 +121,54 @@ func (c *silenceQueryCmd) query(ctx context.Context, _ *kingpin.ParseContext) er
 	}
 
 	silenceParams := silence.NewGetSilencesParams().WithContext(ctx).WithFilter(c.matchers)
+	if c.sort != "" {
+		silenceParams.SetSort(&c.sort)
+	}
+	if c.limit > 0 {
+		silenceParams.SetLimit(&c.limit)
+	}
 
 	amclient := NewAlertmanagerClient(alertmanagerURL)
 
-	getOk, err := amclient.Silence.GetSilences(silenceParams)
-	if err != nil {
-		return err
+	displaySilences := []models.GettableSilence{}
+	for {
+		getOk, err := amclient.Silence.GetSilences(silenceParams)
+		if err != nil {
+			return err
+		}
+		displaySilences = append(displaySilences, c.filter(getOk.Payload)...)
+
+		// Silences are filtered after they are paged through, get further
+		// pages until there are enough of them.
+		if c.limit <= 0 || int64(len(displaySilences)) >= c.limit || getOk.XNextCursor == "" {
+			break
+		}
+		silenceParams.SetCursor(&getOk.XNextCursor)
+	}
+	if c.limit > 0 && int64(len(displaySilences)) > c.limit {
+		displaySilences = displaySilences[:c.limit]
+	}
+
+	if c.quiet {
+		for _, silence := range displaySilences {
+			fmt.Println(*silence.ID)
+		}
+	} else {
+		formatter, found := format.Formatters[output]
+		if !found {
+			return errors.New("unknown output formatter")
+		}
+		if err := formatter.FormatSilences(displaySilences); err != nil {
+			return fmt.Errorf("error formatting silences: %w", err)
+		}
 	}
+	return nil
+}
 
+// filter returns the silences matching the flags of the query.
+func (c *silenceQueryCmd) filter(silences models.GettableSilences) []models.GettableSilence {
 	displaySilences := []models.GettableSilence{}
-	for _, silence := range getOk.Payload {
+	for _, silence := range silences {
 		// skip expired silences if --expired is not set
 		if !c.expired && time.Time(*silence.EndsAt).Before(time.Now()) {
 			continue
@@ -132,6 +185,10 @@ func (c *silenceQueryCmd) query(ctx context.Context, _ *kingpin.ParseContext) er
 		if c.expired && int64(c.within) > 0 && time.Time(*silence.EndsAt).Before(time.Now().UTC().Add(-c.within)) {
 			continue
 		}
+		// Skip silences not pending approval if --pending-approval is set.
+		if c.pendingApproval && *silence.Status.State != models.SilenceStatusStatePendingApproval {
+			continue
+		}
 		// Skip silences if the author doesn't match.
 		if c.createdBy != "" && *silence.CreatedBy != c.createdBy {
 			continue
@@ -143,19 +200,5 @@ func (c *silenceQueryCmd) query(ctx context.Context, _ *kingpin.ParseContext) er
 
 		displaySilences = append(displaySilences, *silence)
 	}
-
-	if c.quiet {
-		for _, silence := range displaySilences {
-			fmt.Println(*silence.ID)
-		}
-	} else {
-		formatter, found := format.Formatters[output]
-		if !found {
-			return errors.New("unknown output formatter")
-		}
-		if err := formatter.FormatSilences(displaySilences); err != nil {
-			return fmt.Errorf("error formatting silences: %w", err)
-		}
-	}
-	return nil
+	return displaySilences
 }
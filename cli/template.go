@@ -19,6 +19,7 @@ import (
 
 // configureTemplateCmd represents the template command.
 func configureTemplateCmd(app *kingpin.Application) {
-	templateCmd := app.Command("template", "Render template files.")
+	templateCmd := app.Command("template", "Render and test template files.")
 	configureTemplateRenderCmd(templateCmd)
+	configureTemplateTestCmd(templateCmd)
 }
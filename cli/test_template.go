@@ -0,0 +1,374 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/prometheus/common/model"
+	"gopkg.in/yaml.v2"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/config/receiver"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+const templateTestHelp = `Unit test notification templates
+
+Renders templates against the alert groups of test specification files and
+compares the results with the expected outputs. Expected outputs are either
+inline or stored in golden files, which --update rewrites with the rendered
+outputs. With a configuration file, the fields of its receivers can be
+rendered as configured.
+
+Example:
+
+./amtool template test templates_test.yml
+`
+
+// templateTestTime is the default start time of the alerts of template
+// tests, so that rendered times are stable.
+var templateTestTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type templateTestCmd struct {
+	files  []string
+	update bool
+}
+
+// templateTestSpec is the content of a template test specification file.
+type templateTestSpec struct {
+	// ConfigFile is the Alertmanager configuration whose receiver fields
+	// are tested, relative to the specification file. Its templates and
+	// translations are loaded along with the ones of the specification.
+	ConfigFile string `yaml:"config_file"`
+	// Templates are the globs of the template files, relative to the
+	// specification file.
+	Templates []string `yaml:"templates"`
+	// Translations are the globs of the translation files, relative to the
+	// specification file.
+	Translations []string           `yaml:"translations"`
+	Tests        []templateTestCase `yaml:"tests"`
+}
+
+// templateTestCase renders templates against an alert group. The locale
+// defaults to the one of the receiver in the configuration file.
+type templateTestCase struct {
+	Name        string                    `yaml:"name"`
+	Receiver    string                    `yaml:"receiver"`
+	Locale      string                    `yaml:"locale"`
+	ExternalURL string                    `yaml:"external_url"`
+	GroupLabels map[string]string         `yaml:"group_labels"`
+	Alerts      []templateTestAlert       `yaml:"alerts"`
+	Expect      []templateTestExpectation `yaml:"expect"`
+}
+
+// templateTestAlert is an alert of the group.
+type templateTestAlert struct {
+	Labels       map[string]string `yaml:"labels"`
+	Annotations  map[string]string `yaml:"annotations"`
+	Status       string            `yaml:"status"`
+	StartsAt     string            `yaml:"starts_at"`
+	EndsAt       string            `yaml:"ends_at"`
+	GeneratorURL string            `yaml:"generator_url"`
+}
+
+// templateTestExpectation is the expected output of a named template, of an
+// inline template or of a field of the receiver in the configuration file,
+// such as slack_configs[0].title.
+type templateTestExpectation struct {
+	Template   string `yaml:"template"`
+	Text       string `yaml:"text"`
+	Field      string `yaml:"field"`
+	Type       string `yaml:"type"`
+	Output     string `yaml:"output"`
+	OutputFile string `yaml:"output_file"`
+}
+
+func configureTemplateTestCmd(cc *kingpin.CmdClause) {
+	var (
+		c       = &templateTestCmd{}
+		testCmd = cc.Command("test", templateTestHelp)
+	)
+	testCmd.Flag("update", "Rewrite the golden files with the rendered outputs.").BoolVar(&c.update)
+	testCmd.Arg("spec-files", "Template test specification files.").Required().ExistingFilesVar(&c.files)
+	testCmd.Action(c.test)
+}
+
+func (c *templateTestCmd) test(_ *kingpin.ParseContext) error {
+	return RunTemplateTests(c.files, c.update)
+}
+
+// RunTemplateTests runs the template tests of the specification files and
+// prints the results. With update, golden files are rewritten instead of
+// compared.
+func RunTemplateTests(files []string, update bool) error {
+	failed := 0
+	for _, f := range files {
+		fmt.Printf("Testing '%s'\n", f)
+		n, err := testTemplateSpec(f, update)
+		if err != nil {
+			fmt.Printf("  FAILED: %s\n", err)
+			failed++
+		}
+		failed += n
+		fmt.Printf("\n")
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d template test(s) failed", failed)
+	}
+	return nil
+}
+
+// testTemplateSpec runs the tests of a specification file and returns the
+// number of failed expectations.
+func testTemplateSpec(file string, update bool) (int, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return 0, err
+	}
+	var spec templateTestSpec
+	if err := yaml.UnmarshalStrict(content, &spec); err != nil {
+		return 0, err
+	}
+
+	dir := filepath.Dir(file)
+	var cfg *config.Config
+	if spec.ConfigFile != "" {
+		if cfg, err = config.LoadFile(joinDir(dir, spec.ConfigFile)); err != nil {
+			return 0, err
+		}
+	}
+
+	globs := make([]string, 0, len(spec.Templates))
+	for _, g := range spec.Templates {
+		globs = append(globs, joinDir(dir, g))
+	}
+	translations := make([]string, 0, len(spec.Translations))
+	for _, g := range spec.Translations {
+		translations = append(translations, joinDir(dir, g))
+	}
+	if cfg != nil {
+		globs = append(globs, cfg.Templates...)
+		translations = append(translations, cfg.Translations...)
+	}
+	tmpl, err := template.FromGlobs(globs)
+	if err != nil {
+		return 0, err
+	}
+	if err := tmpl.LoadTranslations(translations...); err != nil {
+		return 0, err
+	}
+
+	failed := 0
+	for _, tc := range spec.Tests {
+		data, err := tc.data(tmpl)
+		if err != nil {
+			return failed, fmt.Errorf("test %q: %w", tc.Name, err)
+		}
+		rcv := tc.receiver(cfg)
+		locale := tc.Locale
+		if locale == "" && rcv != nil {
+			locale = rcv.Locale
+		}
+		ttmpl := tmpl
+		if locale != "" {
+			if ttmpl, err = tmpl.WithLocale(locale); err != nil {
+				return failed, fmt.Errorf("test %q: %w", tc.Name, err)
+			}
+		}
+		for _, e := range tc.Expect {
+			name := e.Template
+			switch {
+			case e.Field != "":
+				name = e.Field
+			case name == "":
+				name = e.Text
+			}
+			diff, err := e.check(ttmpl, data, rcv, dir, update)
+			switch {
+			case err != nil:
+				fmt.Printf("  %s [%s]: FAILED: %s\n", tc.Name, name, err)
+				failed++
+			case diff != "":
+				fmt.Printf("  %s [%s]: FAILED: rendered output differs from the expected output\n%s", tc.Name, name, diff)
+				failed++
+			default:
+				fmt.Printf("  %s [%s]: SUCCESS\n", tc.Name, name)
+			}
+		}
+	}
+	return failed, nil
+}
+
+// receiver returns the receiver of the test in the configuration, or nil.
+func (tc *templateTestCase) receiver(cfg *config.Config) *config.Receiver {
+	if cfg == nil {
+		return nil
+	}
+	for i := range cfg.Receivers {
+		if cfg.Receivers[i].Name == tc.Receiver {
+			return &cfg.Receivers[i]
+		}
+	}
+	return nil
+}
+
+// data returns the template data of the alert group of the test.
+func (tc *templateTestCase) data(tmpl *template.Template) (*template.Data, error) {
+	externalURL := tc.ExternalURL
+	if externalURL == "" {
+		externalURL = "http://localhost:9093"
+	}
+	u, err := url.Parse(externalURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse external URL: %w", err)
+	}
+	tmpl.ExternalURL = u
+
+	alerts := make([]*types.Alert, 0, len(tc.Alerts))
+	for i, a := range tc.Alerts {
+		alert := &types.Alert{Alert: model.Alert{
+			Labels:       model.LabelSet{},
+			Annotations:  model.LabelSet{},
+			StartsAt:     templateTestTime,
+			GeneratorURL: a.GeneratorURL,
+		}}
+		for k, v := range a.Labels {
+			alert.Labels[model.LabelName(k)] = model.LabelValue(v)
+		}
+		for k, v := range a.Annotations {
+			alert.Annotations[model.LabelName(k)] = model.LabelValue(v)
+		}
+		if a.StartsAt != "" {
+			if alert.StartsAt, err = time.Parse(time.RFC3339, a.StartsAt); err != nil {
+				return nil, fmt.Errorf("alert %d: parse starts_at: %w", i, err)
+			}
+		}
+		switch a.Status {
+		case "", string(model.AlertFiring):
+		case string(model.AlertResolved):
+			alert.EndsAt = alert.StartsAt.Add(time.Hour)
+		default:
+			return nil, fmt.Errorf("alert %d: invalid status %q, must be firing or resolved", i, a.Status)
+		}
+		if a.EndsAt != "" {
+			if alert.EndsAt, err = time.Parse(time.RFC3339, a.EndsAt); err != nil {
+				return nil, fmt.Errorf("alert %d: parse ends_at: %w", i, err)
+			}
+		}
+		alerts = append(alerts, alert)
+	}
+
+	groupLabels := model.LabelSet{}
+	for k, v := range tc.GroupLabels {
+		groupLabels[model.LabelName(k)] = model.LabelValue(v)
+	}
+	return tmpl.Data(tc.Receiver, groupLabels, alerts...), nil
+}
+
+// check renders the template and compares it with the expected output. It
+// returns a unified diff on mismatch. With update, the golden file is
+// rewritten instead.
+func (e *templateTestExpectation) check(tmpl *template.Template, data *template.Data, rcv *config.Receiver, dir string, update bool) (string, error) {
+	set := 0
+	for _, v := range []string{e.Template, e.Text, e.Field} {
+		if v != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return "", errors.New("exactly one of template, text or field must be set")
+	}
+	if (e.Output != "") && (e.OutputFile != "") {
+		return "", errors.New("at most one of output or output_file must be set")
+	}
+
+	var (
+		text = e.Text
+		typ  = e.Type
+	)
+	switch {
+	case e.Template != "":
+		text = fmt.Sprintf(`{{ template %q . }}`, e.Template)
+	case e.Field != "":
+		if rcv == nil {
+			return "", errors.New("field requires config_file and a receiver of the configuration")
+		}
+		var (
+			html bool
+			err  error
+		)
+		if text, html, err = receiver.TemplateField(*rcv, e.Field); err != nil {
+			return "", err
+		}
+		if typ == "" && html {
+			typ = "html"
+		}
+	}
+
+	var (
+		got string
+		err error
+	)
+	switch typ {
+	case "", "text":
+		got, err = tmpl.ExecuteTextString(text, data)
+	case "html":
+		got, err = tmpl.ExecuteHTMLString(text, data)
+	default:
+		return "", fmt.Errorf("invalid type %q, must be text or html", typ)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	expected := e.Output
+	if e.OutputFile != "" {
+		path := joinDir(dir, e.OutputFile)
+		if update {
+			return "", os.WriteFile(path, []byte(got), 0o644)
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		expected = string(b)
+	}
+	if got == expected {
+		return "", nil
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(expected),
+		B:        difflib.SplitLines(got),
+		FromFile: "expected",
+		ToFile:   "rendered",
+		Context:  3,
+	})
+}
+
+func joinDir(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunTemplateTests(t *testing.T) {
+	require.NoError(t, RunTemplateTests([]string{"testdata/templates.test.yml"}, false))
+
+	require.NoError(t, RunTemplateTests([]string{"testdata/templates.config.test.yml"}, false))
+
+	err := RunTemplateTests([]string{"testdata/templates.test-failing.yml"}, false)
+	require.EqualError(t, err, "3 template test(s) failed")
+
+	err = RunTemplateTests([]string{"testdata/missing.yml"}, false)
+	require.EqualError(t, err, "1 template test(s) failed")
+}
+
+func TestRunTemplateTestsUpdate(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "templates"), 0o755))
+	for _, f := range []string{"templates.test.yml", "templates/custom.tmpl", "templates/translations.yml"} {
+		b, err := os.ReadFile(filepath.Join("testdata", f))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, f), b, 0o644))
+	}
+	golden := filepath.Join(dir, "templates", "custom.title.golden")
+	require.NoError(t, os.WriteFile(golden, []byte("outdated"), 0o644))
+
+	spec := []string{filepath.Join(dir, "templates.test.yml")}
+	require.Error(t, RunTemplateTests(spec, false))
+	require.NoError(t, RunTemplateTests(spec, true))
+
+	b, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	require.Equal(t, "[FIRING:2] HighLatency", string(b))
+	require.NoError(t, RunTemplateTests(spec, false))
+}
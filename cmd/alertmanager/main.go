@@ -42,7 +42,10 @@ import (
 	"github.com/prometheus/exporter-toolkit/web"
 	webflag "github.com/prometheus/exporter-toolkit/web/kingpinflag"
 
+	"github.com/prometheus/alertmanager/ack"
+	"github.com/prometheus/alertmanager/alerthistory"
 	"github.com/prometheus/alertmanager/api"
+	"github.com/prometheus/alertmanager/api/auth"
 	"github.com/prometheus/alertmanager/blobstore"
 	"github.com/prometheus/alertmanager/cluster"
 	"github.com/prometheus/alertmanager/config"
@@ -140,16 +143,20 @@ func run() int {
 
 	var (
 		configFile          = kingpin.Flag("config.file", "Alertmanager configuration file name.").Default("alertmanager.yml").String()
+		configVersions      = kingpin.Flag("config.versions", "Number of applied configurations to keep for rollbacks through the API.").Default("10").Int()
+		strictTemplates     = kingpin.Flag("config.strict-templates", "Refuse configurations with receiver templates failing to render against synthetic alerts. Otherwise the failures are only logged.").Bool()
 		dataDir             = kingpin.Flag("storage.path", "Base path for data storage.").Default("data/").String()
 		retention           = kingpin.Flag("data.retention", "How long to keep data for.").Default("120h").Duration()
-		maintenanceInterval = kingpin.Flag("data.maintenance-interval", "Interval between garbage collection and snapshotting to disk of the silences and the notification logs.").Default("15m").Duration()
+		maintenanceInterval = kingpin.Flag("data.maintenance-interval", "Interval between garbage collection and snapshotting to disk of the silences, the acknowledgements and the notification logs.").Default("15m").Duration()
 		alertGCInterval     = kingpin.Flag("alerts.gc-interval", "Interval between alert GC.").Default("30m").Duration()
 
-		webConfig      = webflag.AddFlags(kingpin.CommandLine, ":9093")
-		externalURL    = kingpin.Flag("web.external-url", "The URL under which Alertmanager is externally reachable (for example, if Alertmanager is served via a reverse proxy). Used for generating relative and absolute links back to Alertmanager itself. If the URL has a path portion, it will be used to prefix all HTTP endpoints served by Alertmanager. If omitted, relevant URL components will be derived automatically.").String()
-		routePrefix    = kingpin.Flag("web.route-prefix", "Prefix for the internal routes of web endpoints. Defaults to path of --web.external-url.").String()
-		getConcurrency = kingpin.Flag("web.get-concurrency", "Maximum number of GET requests processed concurrently. If negative or zero, the limit is GOMAXPROC or 8, whichever is larger.").Default("0").Int()
-		httpTimeout    = kingpin.Flag("web.timeout", "Timeout for HTTP requests. If negative or zero, no timeout is set.").Default("0").Duration()
+		webConfig       = webflag.AddFlags(kingpin.CommandLine, ":9093")
+		externalURL     = kingpin.Flag("web.external-url", "The URL under which Alertmanager is externally reachable (for example, if Alertmanager is served via a reverse proxy). Used for generating relative and absolute links back to Alertmanager itself. If the URL has a path portion, it will be used to prefix all HTTP endpoints served by Alertmanager. If omitted, relevant URL components will be derived automatically.").String()
+		routePrefix     = kingpin.Flag("web.route-prefix", "Prefix for the internal routes of web endpoints. Defaults to path of --web.external-url.").String()
+		getConcurrency  = kingpin.Flag("web.get-concurrency", "Maximum number of GET requests processed concurrently. If negative or zero, the limit is GOMAXPROC or 8, whichever is larger.").Default("0").Int()
+		httpTimeout     = kingpin.Flag("web.timeout", "Timeout for HTTP requests. If negative or zero, no timeout is set.").Default("0").Duration()
+		authFile        = kingpin.Flag("web.auth-file", "Path to the file configuring the tokens and roles of API clients. If omitted, the API does not authenticate requests. The file is reloaded along with the configuration.").Default("").String()
+		enableConfigAPI = kingpin.Flag("web.enable-config-api", "Enable applying and rolling back configurations through the API. Requires --web.auth-file to grant the admin role to a token and not to anonymous requests.").Default("false").Bool()
 
 		clusterBindAddr = kingpin.Flag("cluster.listen-address", "Listen address for cluster. Set to empty string to disable HA mode.").
 				Default(defaultClusterAddr).String()
@@ -189,6 +196,11 @@ func run() int {
 	}
 	compat.InitFromFlags(logger, ff)
 
+	if *enableConfigAPI && *authFile == "" {
+		level.Error(logger).Log("msg", "--web.enable-config-api requires --web.auth-file to be set")
+		return 1
+	}
+
 	err = os.MkdirAll(*dataDir, 0o777)
 	if err != nil {
 		level.Error(logger).Log("msg", "Unable to create data directory", "err", err)
@@ -257,7 +269,23 @@ func run() int {
 		wg.Done()
 	}()
 
-	marker := types.NewMarker(prometheus.DefaultRegisterer)
+	history, err := alerthistory.New(alerthistory.Options{
+		SnapshotFile: filepath.Join(*dataDir, "alert_history"),
+		Retention:    *retention,
+		Logger:       log.With(logger, "component", "alert_history"),
+		Metrics:      prometheus.DefaultRegisterer,
+	})
+	if err != nil {
+		level.Error(logger).Log("err", err)
+		return 1
+	}
+	wg.Add(1)
+	go func() {
+		history.Maintenance(*maintenanceInterval, filepath.Join(*dataDir, "alert_history"), stopc, nil)
+		wg.Done()
+	}()
+
+	marker := alerthistory.NewMarker(types.NewMarker(prometheus.DefaultRegisterer), history)
 
 	silenceOpts := silence.Options{
 		SnapshotFile: filepath.Join(*dataDir, "silences"),
@@ -276,12 +304,33 @@ func run() int {
 		silences.SetBroadcast(c.Broadcast)
 	}
 
+	acks, err := ack.New(ack.Options{
+		SnapshotFile: filepath.Join(*dataDir, "acks"),
+		Retention:    *retention,
+		Logger:       log.With(logger, "component", "acks"),
+		Metrics:      prometheus.DefaultRegisterer,
+	})
+	if err != nil {
+		level.Error(logger).Log("err", err)
+		return 1
+	}
+	if peer != nil {
+		c := peer.AddState("ack", acks, prometheus.DefaultRegisterer)
+		acks.SetBroadcast(c.Broadcast)
+	}
+	acknowledger := ack.NewAcknowledger(acks, marker)
+
 	// Start providers before router potentially sends updates.
 	wg.Add(1)
 	go func() {
 		silences.Maintenance(*maintenanceInterval, filepath.Join(*dataDir, "silences"), stopc, nil)
 		wg.Done()
 	}()
+	wg.Add(1)
+	go func() {
+		acks.Maintenance(*maintenanceInterval, filepath.Join(*dataDir, "acks"), stopc, nil)
+		wg.Done()
+	}()
 
 	defer func() {
 		close(stopc)
@@ -313,6 +362,7 @@ func run() int {
 		return 1
 	}
 	defer alerts.Close()
+	go alerthistory.NewRecorder(alerts, history, log.With(logger, "component", "alert_history")).Run(stopc)
 
 	var disp *dispatch.Dispatcher
 	defer func() {
@@ -331,16 +381,30 @@ func run() int {
 		clusterPeer = peer
 	}
 
+	configLogger := log.With(logger, "component", "configuration")
+	configCoordinator := config.NewCoordinator(
+		*configFile,
+		prometheus.DefaultRegisterer,
+		configLogger,
+	)
+	configCoordinator.SetMaxVersions(*configVersions)
+
+	authenticator := auth.NewAuthenticator(log.With(logger, "component", "auth"))
 	api, err := api.New(api.Options{
-		Alerts:      alerts,
-		Silences:    silences,
-		StatusFunc:  marker.Status,
-		Peer:        clusterPeer,
-		Timeout:     *httpTimeout,
-		Concurrency: *getConcurrency,
-		Logger:      log.With(logger, "component", "api"),
-		Registry:    prometheus.DefaultRegisterer,
-		GroupFunc:   groupFn,
+		Alerts:             alerts,
+		Silences:           silences,
+		Acknowledgements:   acks,
+		History:            history,
+		Configs:            configCoordinator,
+		EnableConfigWrites: *enableConfigAPI,
+		StatusFunc:         marker.Status,
+		Peer:               clusterPeer,
+		Timeout:            *httpTimeout,
+		Concurrency:        *getConcurrency,
+		Logger:             log.With(logger, "component", "api"),
+		Registry:           prometheus.DefaultRegisterer,
+		GroupFunc:          groupFn,
+		Authenticator:      authenticator,
 	})
 	if err != nil {
 		level.Error(logger).Log("err", fmt.Errorf("failed to create API: %w", err))
@@ -366,48 +430,64 @@ func run() int {
 	}
 
 	var (
-		inhibitor *inhibit.Inhibitor
-		tmpl      *template.Template
+		inhibitor      *inhibit.Inhibitor
+		expiryNotifier *silence.ExpiryNotifier
+		tmpl           *template.Template
 	)
 
 	dispMetrics := dispatch.NewDispatcherMetrics(false, prometheus.DefaultRegisterer)
 	pipelineBuilder := notify.NewPipelineBuilder(prometheus.DefaultRegisterer, ff)
-	configLogger := log.With(logger, "component", "configuration")
-	configCoordinator := config.NewCoordinator(
-		*configFile,
-		prometheus.DefaultRegisterer,
-		configLogger,
-	)
+	// Templates and receivers are built before the configuration is applied,
+	// so that failing to build them leaves the running configuration as is.
+	configCoordinator.AddValidators(func(conf *config.Config) error {
+		tmpl, err := template.FromGlobs(conf.Templates)
+		if err != nil {
+			return fmt.Errorf("failed to parse templates: %w", err)
+		}
+		if err := tmpl.LoadTranslations(conf.Translations...); err != nil {
+			return fmt.Errorf("failed to load translations: %w", err)
+		}
+		tmpl.ExternalURL = amURL
+		if _, _, err = buildReceivers(conf, tmpl, logger, log.NewNopLogger()); err != nil {
+			return err
+		}
+
+		terrs := receiver.ValidateTemplates(conf.Receivers, tmpl)
+		for _, terr := range terrs {
+			level.Warn(configLogger).Log("msg", "Failed to render receiver template", "receiver", terr.Receiver, "field", terr.Field, "err", terr.Err)
+		}
+		if *strictTemplates && len(terrs) > 0 {
+			return fmt.Errorf("%d receiver template(s) failed to render, first: %w", len(terrs), terrs[0])
+		}
+		return nil
+	})
 	configCoordinator.Subscribe(func(conf *config.Config) error {
+		if *authFile != "" {
+			authConf, err := auth.LoadFile(*authFile)
+			if err != nil {
+				return fmt.Errorf("failed to load auth file: %w", err)
+			}
+			if *enableConfigAPI {
+				if err := authConf.RestrictsAdmin(); err != nil {
+					return fmt.Errorf("invalid auth file for --web.enable-config-api: %w", err)
+				}
+			}
+			authenticator.Update(authConf)
+		}
+
 		tmpl, err = template.FromGlobs(conf.Templates)
 		if err != nil {
 			return fmt.Errorf("failed to parse templates: %w", err)
 		}
+		if err := tmpl.LoadTranslations(conf.Translations...); err != nil {
+			return fmt.Errorf("failed to load translations: %w", err)
+		}
 		tmpl.ExternalURL = amURL
 
-		// Build the routing tree and record which receivers are used.
 		routes := dispatch.NewRoute(conf.Route, nil)
-		activeReceivers := make(map[string]struct{})
-		routes.Walk(func(r *dispatch.Route) {
-			activeReceivers[r.RouteOpts.Receiver] = struct{}{}
-		})
-
-		// Build the map of receiver to integrations.
-		receivers := make(map[string][]notify.Integration, len(activeReceivers))
-		var integrationsNum int
-		for _, rcv := range conf.Receivers {
-			if _, found := activeReceivers[rcv.Name]; !found {
-				// No need to build a receiver if no route is using it.
-				level.Info(configLogger).Log("msg", "skipping creation of receiver not referenced by any route", "receiver", rcv.Name)
-				continue
-			}
-			integrations, err := receiver.BuildReceiverIntegrations(rcv, tmpl, logger)
-			if err != nil {
-				return err
-			}
-			// rcv.Name is guaranteed to be unique across all receivers.
-			receivers[rcv.Name] = integrations
-			integrationsNum += len(integrations)
+		receivers, integrationsNum, err := buildReceivers(conf, tmpl, logger, configLogger)
+		if err != nil {
+			return err
 		}
 
 		// Build the map of time interval names to time interval definitions.
@@ -423,9 +503,13 @@ func run() int {
 		intervener := timeinterval.NewIntervener(timeIntervals)
 
 		inhibitor.Stop()
+		expiryNotifier.Stop()
 		disp.Stop()
 
-		inhibitor = inhibit.NewInhibitor(alerts, conf.InhibitRules, marker, logger)
+		// Rules derived from the service topology are evaluated after the
+		// explicitly configured ones.
+		inhibitRules := append(append([]config.InhibitRule{}, conf.InhibitRules...), conf.Topology.InhibitRules()...)
+		inhibitor = inhibit.NewInhibitor(alerts, inhibitRules, marker, logger)
 		silencer := silence.NewSilencer(silences, marker, logger)
 
 		// An interface value that holds a nil concrete value is non-nil.
@@ -441,18 +525,21 @@ func run() int {
 			waitFunc,
 			inhibitor,
 			silencer,
+			acknowledger,
 			intervener,
 			notificationLog,
+			history,
 			pipelinePeer,
 		)
 
-		configuredReceivers.Set(float64(len(activeReceivers)))
+		configuredReceivers.Set(float64(len(receivers)))
 		configuredIntegrations.Set(float64(integrationsNum))
-		configuredInhibitionRules.Set(float64(len(conf.InhibitRules)))
+		configuredInhibitionRules.Set(float64(len(inhibitRules)))
 
-		api.Update(conf, func(labels model.LabelSet) {
-			inhibitor.Mutes(labels)
-			silencer.Mutes(labels)
+		api.Update(conf, func(a *types.Alert) {
+			inhibitor.Mutes(a.Labels)
+			silencer.Mutes(a.Labels)
+			acknowledger.Acknowledged(a)
 		})
 
 		disp = dispatch.NewDispatcher(alerts, routes, pipeline, marker, timeoutFunc, nil, logger, dispMetrics)
@@ -487,6 +574,12 @@ func run() int {
 		go disp.Run()
 		go inhibitor.Run()
 
+		expiryNotifier = nil
+		if n := conf.SilenceExpiryNotification; n != nil {
+			expiryNotifier = silence.NewExpiryNotifier(silences, alerts, time.Duration(n.NotifyBefore), n.Labels, log.With(logger, "component", "silences"))
+			go expiryNotifier.Run()
+		}
+
 		return nil
 	})
 
@@ -555,6 +648,35 @@ func run() int {
 	}
 }
 
+// buildReceivers builds the integrations of the receivers used by the routes of
+// the configuration, and returns them along with their number.
+func buildReceivers(conf *config.Config, tmpl *template.Template, logger, configLogger log.Logger) (map[string][]notify.Integration, int, error) {
+	// Record which receivers are used by the routing tree.
+	activeReceivers := make(map[string]struct{})
+	dispatch.NewRoute(conf.Route, nil).Walk(func(r *dispatch.Route) {
+		activeReceivers[r.RouteOpts.Receiver] = struct{}{}
+	})
+
+	// Build the map of receiver to integrations.
+	receivers := make(map[string][]notify.Integration, len(activeReceivers))
+	var integrationsNum int
+	for _, rcv := range conf.Receivers {
+		if _, found := activeReceivers[rcv.Name]; !found {
+			// No need to build a receiver if no route is using it.
+			level.Info(configLogger).Log("msg", "skipping creation of receiver not referenced by any route", "receiver", rcv.Name)
+			continue
+		}
+		integrations, err := receiver.BuildReceiverIntegrations(rcv, tmpl, logger)
+		if err != nil {
+			return nil, 0, err
+		}
+		// rcv.Name is guaranteed to be unique across all receivers.
+		receivers[rcv.Name] = integrations
+		integrationsNum += len(integrations)
+	}
+	return receivers, integrationsNum, nil
+}
+
 // clusterWait returns a function that inspects the current peer state and returns
 // a duration of one base timeout for each peer with a higher ID than ourselves.
 func clusterWait(p *cluster.Peer, timeout time.Duration) func() time.Duration {
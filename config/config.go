@@ -28,6 +28,7 @@ import (
 
 	commoncfg "github.com/prometheus/common/config"
 	"github.com/prometheus/common/model"
+	"golang.org/x/text/language"
 	"gopkg.in/yaml.v2"
 
 	"github.com/prometheus/alertmanager/matchers/compat"
@@ -219,9 +220,20 @@ func resolveFilepaths(baseDir string, cfg *Config) {
 	for i, tf := range cfg.Templates {
 		cfg.Templates[i] = join(tf)
 	}
+	for i, tf := range cfg.Translations {
+		cfg.Translations[i] = join(tf)
+	}
 
 	cfg.Global.HTTPConfig.SetDirectory(baseDir)
+	cfg.Global.SMTPDKIMPrivateKeyFile = join(cfg.Global.SMTPDKIMPrivateKeyFile)
 	for _, receiver := range cfg.Receivers {
+		for _, cfg := range receiver.EmailConfigs {
+			cfg.HTTPConfig.SetDirectory(baseDir)
+			cfg.DKIMPrivateKeyFile = join(cfg.DKIMPrivateKeyFile)
+			for _, a := range cfg.Attachments {
+				a.File = join(a.File)
+			}
+		}
 		for _, cfg := range receiver.OpsGenieConfigs {
 			cfg.HTTPConfig.SetDirectory(baseDir)
 		}
@@ -239,6 +251,10 @@ func resolveFilepaths(baseDir string, cfg *Config) {
 		}
 		for _, cfg := range receiver.WebhookConfigs {
 			cfg.HTTPConfig.SetDirectory(baseDir)
+			cfg.SigningSecretFile = join(cfg.SigningSecretFile)
+		}
+		for _, cfg := range receiver.HTTPRequestConfigs {
+			cfg.HTTPConfig.SetDirectory(baseDir)
 		}
 		for _, cfg := range receiver.WechatConfigs {
 			cfg.HTTPConfig.SetDirectory(baseDir)
@@ -302,8 +318,20 @@ type Config struct {
 	Global       *GlobalConfig `yaml:"global,omitempty" json:"global,omitempty"`
 	Route        *Route        `yaml:"route,omitempty" json:"route,omitempty"`
 	InhibitRules []InhibitRule `yaml:"inhibit_rules,omitempty" json:"inhibit_rules,omitempty"`
+	Topology     *Topology     `yaml:"topology,omitempty" json:"topology,omitempty"`
 	Receivers    []Receiver    `yaml:"receivers,omitempty" json:"receivers,omitempty"`
 	Templates    []string      `yaml:"templates" json:"templates"`
+	// Translations are the files of messages translated by the tr template
+	// function, in addition to the built-in translations.
+	Translations []string `yaml:"translations,omitempty" json:"translations,omitempty"`
+	// SilenceApprovalRules declare which silences must be approved before
+	// they mute alerts.
+	SilenceApprovalRules []SilenceApprovalRule `yaml:"silence_approval_rules,omitempty" json:"silence_approval_rules,omitempty"`
+	// SilencePolicy restricts the silences that can be created or updated.
+	SilencePolicy *SilencePolicy `yaml:"silence_policy,omitempty" json:"silence_policy,omitempty"`
+	// SilenceExpiryNotification configures alerts about silences that are
+	// about to expire.
+	SilenceExpiryNotification *SilenceExpiryNotification `yaml:"silence_expiry_notification,omitempty" json:"silence_expiry_notification,omitempty"`
 	// Deprecated. Remove before v1.0 release.
 	MuteTimeIntervals []MuteTimeInterval `yaml:"mute_time_intervals,omitempty" json:"mute_time_intervals,omitempty"`
 	TimeIntervals     []TimeInterval     `yaml:"time_intervals,omitempty" json:"time_intervals,omitempty"`
@@ -364,7 +392,15 @@ func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 				wh.HTTPConfig = c.Global.HTTPConfig
 			}
 		}
+		for _, hr := range rcv.HTTPRequestConfigs {
+			if hr.HTTPConfig == nil {
+				hr.HTTPConfig = c.Global.HTTPConfig
+			}
+		}
 		for _, ec := range rcv.EmailConfigs {
+			if ec.HTTPConfig == nil {
+				ec.HTTPConfig = c.Global.HTTPConfig
+			}
 			if ec.Smarthost.String() == "" {
 				if c.Global.SMTPSmarthost.String() == "" {
 					return fmt.Errorf("no global SMTP smarthost set")
@@ -397,6 +433,14 @@ func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 				ec.RequireTLS = new(bool)
 				*ec.RequireTLS = c.Global.SMTPRequireTLS
 			}
+			if ec.DKIMDomain == "" && ec.DKIMSelector == "" && ec.DKIMPrivateKeyFile == "" {
+				ec.DKIMDomain = c.Global.SMTPDKIMDomain
+				ec.DKIMSelector = c.Global.SMTPDKIMSelector
+				ec.DKIMPrivateKeyFile = c.Global.SMTPDKIMPrivateKeyFile
+			}
+			if (ec.DKIMDomain == "") != (ec.DKIMSelector == "") || (ec.DKIMDomain == "") != (ec.DKIMPrivateKeyFile == "") {
+				return fmt.Errorf("dkim_domain, dkim_selector and dkim_private_key_file must be configured together")
+			}
 		}
 		for _, sc := range rcv.SlackConfigs {
 			if sc.HTTPConfig == nil {
@@ -742,29 +786,32 @@ type GlobalConfig struct {
 
 	HTTPConfig *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
 
-	SMTPFrom             string     `yaml:"smtp_from,omitempty" json:"smtp_from,omitempty"`
-	SMTPHello            string     `yaml:"smtp_hello,omitempty" json:"smtp_hello,omitempty"`
-	SMTPSmarthost        HostPort   `yaml:"smtp_smarthost,omitempty" json:"smtp_smarthost,omitempty"`
-	SMTPAuthUsername     string     `yaml:"smtp_auth_username,omitempty" json:"smtp_auth_username,omitempty"`
-	SMTPAuthPassword     Secret     `yaml:"smtp_auth_password,omitempty" json:"smtp_auth_password,omitempty"`
-	SMTPAuthPasswordFile string     `yaml:"smtp_auth_password_file,omitempty" json:"smtp_auth_password_file,omitempty"`
-	SMTPAuthSecret       Secret     `yaml:"smtp_auth_secret,omitempty" json:"smtp_auth_secret,omitempty"`
-	SMTPAuthIdentity     string     `yaml:"smtp_auth_identity,omitempty" json:"smtp_auth_identity,omitempty"`
-	SMTPRequireTLS       bool       `yaml:"smtp_require_tls" json:"smtp_require_tls,omitempty"`
-	SlackAPIURL          *SecretURL `yaml:"slack_api_url,omitempty" json:"slack_api_url,omitempty"`
-	SlackAPIURLFile      string     `yaml:"slack_api_url_file,omitempty" json:"slack_api_url_file,omitempty"`
-	PagerdutyURL         *URL       `yaml:"pagerduty_url,omitempty" json:"pagerduty_url,omitempty"`
-	OpsGenieAPIURL       *URL       `yaml:"opsgenie_api_url,omitempty" json:"opsgenie_api_url,omitempty"`
-	OpsGenieAPIKey       Secret     `yaml:"opsgenie_api_key,omitempty" json:"opsgenie_api_key,omitempty"`
-	OpsGenieAPIKeyFile   string     `yaml:"opsgenie_api_key_file,omitempty" json:"opsgenie_api_key_file,omitempty"`
-	WeChatAPIURL         *URL       `yaml:"wechat_api_url,omitempty" json:"wechat_api_url,omitempty"`
-	WeChatAPISecret      Secret     `yaml:"wechat_api_secret,omitempty" json:"wechat_api_secret,omitempty"`
-	WeChatAPICorpID      string     `yaml:"wechat_api_corp_id,omitempty" json:"wechat_api_corp_id,omitempty"`
-	VictorOpsAPIURL      *URL       `yaml:"victorops_api_url,omitempty" json:"victorops_api_url,omitempty"`
-	VictorOpsAPIKey      Secret     `yaml:"victorops_api_key,omitempty" json:"victorops_api_key,omitempty"`
-	VictorOpsAPIKeyFile  string     `yaml:"victorops_api_key_file,omitempty" json:"victorops_api_key_file,omitempty"`
-	TelegramAPIUrl       *URL       `yaml:"telegram_api_url,omitempty" json:"telegram_api_url,omitempty"`
-	WebexAPIURL          *URL       `yaml:"webex_api_url,omitempty" json:"webex_api_url,omitempty"`
+	SMTPFrom               string     `yaml:"smtp_from,omitempty" json:"smtp_from,omitempty"`
+	SMTPHello              string     `yaml:"smtp_hello,omitempty" json:"smtp_hello,omitempty"`
+	SMTPSmarthost          HostPort   `yaml:"smtp_smarthost,omitempty" json:"smtp_smarthost,omitempty"`
+	SMTPAuthUsername       string     `yaml:"smtp_auth_username,omitempty" json:"smtp_auth_username,omitempty"`
+	SMTPAuthPassword       Secret     `yaml:"smtp_auth_password,omitempty" json:"smtp_auth_password,omitempty"`
+	SMTPAuthPasswordFile   string     `yaml:"smtp_auth_password_file,omitempty" json:"smtp_auth_password_file,omitempty"`
+	SMTPAuthSecret         Secret     `yaml:"smtp_auth_secret,omitempty" json:"smtp_auth_secret,omitempty"`
+	SMTPAuthIdentity       string     `yaml:"smtp_auth_identity,omitempty" json:"smtp_auth_identity,omitempty"`
+	SMTPRequireTLS         bool       `yaml:"smtp_require_tls" json:"smtp_require_tls,omitempty"`
+	SMTPDKIMDomain         string     `yaml:"smtp_dkim_domain,omitempty" json:"smtp_dkim_domain,omitempty"`
+	SMTPDKIMSelector       string     `yaml:"smtp_dkim_selector,omitempty" json:"smtp_dkim_selector,omitempty"`
+	SMTPDKIMPrivateKeyFile string     `yaml:"smtp_dkim_private_key_file,omitempty" json:"smtp_dkim_private_key_file,omitempty"`
+	SlackAPIURL            *SecretURL `yaml:"slack_api_url,omitempty" json:"slack_api_url,omitempty"`
+	SlackAPIURLFile        string     `yaml:"slack_api_url_file,omitempty" json:"slack_api_url_file,omitempty"`
+	PagerdutyURL           *URL       `yaml:"pagerduty_url,omitempty" json:"pagerduty_url,omitempty"`
+	OpsGenieAPIURL         *URL       `yaml:"opsgenie_api_url,omitempty" json:"opsgenie_api_url,omitempty"`
+	OpsGenieAPIKey         Secret     `yaml:"opsgenie_api_key,omitempty" json:"opsgenie_api_key,omitempty"`
+	OpsGenieAPIKeyFile     string     `yaml:"opsgenie_api_key_file,omitempty" json:"opsgenie_api_key_file,omitempty"`
+	WeChatAPIURL           *URL       `yaml:"wechat_api_url,omitempty" json:"wechat_api_url,omitempty"`
+	WeChatAPISecret        Secret     `yaml:"wechat_api_secret,omitempty" json:"wechat_api_secret,omitempty"`
+	WeChatAPICorpID        string     `yaml:"wechat_api_corp_id,omitempty" json:"wechat_api_corp_id,omitempty"`
+	VictorOpsAPIURL        *URL       `yaml:"victorops_api_url,omitempty" json:"victorops_api_url,omitempty"`
+	VictorOpsAPIKey        Secret     `yaml:"victorops_api_key,omitempty" json:"victorops_api_key,omitempty"`
+	VictorOpsAPIKeyFile    string     `yaml:"victorops_api_key_file,omitempty" json:"victorops_api_key_file,omitempty"`
+	TelegramAPIUrl         *URL       `yaml:"telegram_api_url,omitempty" json:"telegram_api_url,omitempty"`
+	WebexAPIURL            *URL       `yaml:"webex_api_url,omitempty" json:"webex_api_url,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface for GlobalConfig.
@@ -794,6 +841,10 @@ type Route struct {
 	GroupWait      *model.Duration `yaml:"group_wait,omitempty" json:"group_wait,omitempty"`
 	GroupInterval  *model.Duration `yaml:"group_interval,omitempty" json:"group_interval,omitempty"`
 	RepeatInterval *model.Duration `yaml:"repeat_interval,omitempty" json:"repeat_interval,omitempty"`
+
+	// SuppressAcknowledgedRepeats suppresses repeat notifications for groups
+	// whose firing alerts have all been acknowledged.
+	SuppressAcknowledgedRepeats *bool `yaml:"suppress_acknowledged_repeats,omitempty" json:"suppress_acknowledged_repeats,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface for Route.
@@ -895,22 +946,28 @@ func (r *InhibitRule) UnmarshalYAML(unmarshal func(interface{}) error) error {
 type Receiver struct {
 	// A unique identifier for this receiver.
 	Name string `yaml:"name" json:"name"`
-
-	DiscordConfigs   []*DiscordConfig   `yaml:"discord_configs,omitempty" json:"discord_configs,omitempty"`
-	EmailConfigs     []*EmailConfig     `yaml:"email_configs,omitempty" json:"email_configs,omitempty"`
-	PagerdutyConfigs []*PagerdutyConfig `yaml:"pagerduty_configs,omitempty" json:"pagerduty_configs,omitempty"`
-	SlackConfigs     []*SlackConfig     `yaml:"slack_configs,omitempty" json:"slack_configs,omitempty"`
-	WebhookConfigs   []*WebhookConfig   `yaml:"webhook_configs,omitempty" json:"webhook_configs,omitempty"`
-	OpsGenieConfigs  []*OpsGenieConfig  `yaml:"opsgenie_configs,omitempty" json:"opsgenie_configs,omitempty"`
-	WechatConfigs    []*WechatConfig    `yaml:"wechat_configs,omitempty" json:"wechat_configs,omitempty"`
-	PushoverConfigs  []*PushoverConfig  `yaml:"pushover_configs,omitempty" json:"pushover_configs,omitempty"`
-	VictorOpsConfigs []*VictorOpsConfig `yaml:"victorops_configs,omitempty" json:"victorops_configs,omitempty"`
-	SNSConfigs       []*SNSConfig       `yaml:"sns_configs,omitempty" json:"sns_configs,omitempty"`
-	TelegramConfigs  []*TelegramConfig  `yaml:"telegram_configs,omitempty" json:"telegram_configs,omitempty"`
-	WebexConfigs     []*WebexConfig     `yaml:"webex_configs,omitempty" json:"webex_configs,omitempty"`
-	MSTeamsConfigs   []*MSTeamsConfig   `yaml:"msteams_configs,omitempty" json:"msteams_configs,omitempty"`
-	TwilioConfigs    []*TwilioConfig    `yaml:"twilio_configs,omitempty" json:"twilio_configs,omitempty"`
-	SlackConfigV2    []*SlackConfigV2   `yaml:"slackV2_configs,omitempty" json:"slackV2_configs,omitempty"`
+	// The locale the templates of the receiver are rendered in, e.g. fr or pt-BR.
+	Locale string `yaml:"locale,omitempty" json:"locale,omitempty"`
+	// The default markup language of the text of the integrations of the
+	// receiver that support it.
+	Markup string `yaml:"markup,omitempty" json:"markup,omitempty"`
+
+	DiscordConfigs     []*DiscordConfig     `yaml:"discord_configs,omitempty" json:"discord_configs,omitempty"`
+	EmailConfigs       []*EmailConfig       `yaml:"email_configs,omitempty" json:"email_configs,omitempty"`
+	PagerdutyConfigs   []*PagerdutyConfig   `yaml:"pagerduty_configs,omitempty" json:"pagerduty_configs,omitempty"`
+	SlackConfigs       []*SlackConfig       `yaml:"slack_configs,omitempty" json:"slack_configs,omitempty"`
+	WebhookConfigs     []*WebhookConfig     `yaml:"webhook_configs,omitempty" json:"webhook_configs,omitempty"`
+	HTTPRequestConfigs []*HTTPRequestConfig `yaml:"http_configs,omitempty" json:"http_configs,omitempty"`
+	OpsGenieConfigs    []*OpsGenieConfig    `yaml:"opsgenie_configs,omitempty" json:"opsgenie_configs,omitempty"`
+	WechatConfigs      []*WechatConfig      `yaml:"wechat_configs,omitempty" json:"wechat_configs,omitempty"`
+	PushoverConfigs    []*PushoverConfig    `yaml:"pushover_configs,omitempty" json:"pushover_configs,omitempty"`
+	VictorOpsConfigs   []*VictorOpsConfig   `yaml:"victorops_configs,omitempty" json:"victorops_configs,omitempty"`
+	SNSConfigs         []*SNSConfig         `yaml:"sns_configs,omitempty" json:"sns_configs,omitempty"`
+	TelegramConfigs    []*TelegramConfig    `yaml:"telegram_configs,omitempty" json:"telegram_configs,omitempty"`
+	WebexConfigs       []*WebexConfig       `yaml:"webex_configs,omitempty" json:"webex_configs,omitempty"`
+	MSTeamsConfigs     []*MSTeamsConfig     `yaml:"msteams_configs,omitempty" json:"msteams_configs,omitempty"`
+	TwilioConfigs      []*TwilioConfig      `yaml:"twilio_configs,omitempty" json:"twilio_configs,omitempty"`
+	SlackConfigV2      []*SlackConfigV2     `yaml:"slackV2_configs,omitempty" json:"slackV2_configs,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface for Receiver.
@@ -922,6 +979,46 @@ func (c *Receiver) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	if c.Name == "" {
 		return fmt.Errorf("missing name in receiver")
 	}
+	if c.Locale != "" {
+		if _, err := language.Parse(c.Locale); err != nil {
+			return fmt.Errorf("invalid locale %q in receiver %q: %w", c.Locale, c.Name, err)
+		}
+	}
+	if err := validateMarkup(c.Markup); err != nil {
+		return fmt.Errorf("receiver %q: %w", c.Name, err)
+	}
+	if c.Markup != "" {
+		for _, ec := range c.EmailConfigs {
+			if ec.Markup == "" {
+				ec.Markup = c.Markup
+			}
+		}
+		for _, sc := range c.SlackConfigs {
+			if sc.Markup == "" {
+				sc.Markup = c.Markup
+			}
+		}
+		for _, tc := range c.TelegramConfigs {
+			if tc.Markup == "" {
+				tc.Markup = c.Markup
+			}
+		}
+		for _, dc := range c.DiscordConfigs {
+			if dc.Markup == "" {
+				dc.Markup = c.Markup
+			}
+		}
+		for _, mc := range c.MSTeamsConfigs {
+			if mc.Markup == "" {
+				mc.Markup = c.Markup
+			}
+		}
+	}
+	for _, ec := range c.EmailConfigs {
+		if ec.Markup == MarkupMarkdown && ec.Text == "" {
+			return fmt.Errorf("text must be configured in email config of receiver %q with markdown markup", c.Name)
+		}
+	}
 	return nil
 }
 
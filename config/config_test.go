@@ -147,6 +147,106 @@ receivers:
 	}
 }
 
+func TestReceiverLocale(t *testing.T) {
+	in := `
+route:
+    receiver: team-X
+
+receivers:
+- name: 'team-X'
+  locale: 'pt-BR'
+`
+	conf, err := Load(in)
+	require.NoError(t, err)
+	require.Equal(t, "pt-BR", conf.Receivers[0].Locale)
+
+	in = `
+route:
+    receiver: team-X
+
+receivers:
+- name: 'team-X'
+  locale: 'not a locale'
+`
+	_, err = Load(in)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `invalid locale "not a locale" in receiver "team-X"`)
+}
+
+func TestReceiverMarkup(t *testing.T) {
+	in := `
+route:
+    receiver: team-X
+
+receivers:
+- name: 'team-X'
+  markup: markdown
+  slack_configs:
+  - api_url: 'https://slack.example.com/'
+  - api_url: 'https://slack.example.com/'
+    markup: none
+  email_configs:
+  - to: 'team-X@example.com'
+    smarthost: 'localhost:25'
+    from: 'am@example.com'
+    text: '**{{ .Status }}**'
+`
+	conf, err := Load(in)
+	require.NoError(t, err)
+	rcv := conf.Receivers[0]
+	require.Equal(t, MarkupMarkdown, rcv.SlackConfigs[0].Markup)
+	require.Equal(t, MarkupNone, rcv.SlackConfigs[1].Markup)
+	require.Equal(t, MarkupMarkdown, rcv.EmailConfigs[0].Markup)
+
+	for _, tc := range []struct {
+		in  string
+		err string
+	}{
+		{
+			in: `
+route:
+    receiver: team-X
+
+receivers:
+- name: 'team-X'
+  markup: html
+`,
+			err: `receiver "team-X": unknown markup "html", must be markdown or none`,
+		},
+		{
+			in: `
+route:
+    receiver: team-X
+
+receivers:
+- name: 'team-X'
+  discord_configs:
+  - webhook_url: 'https://discord.example.com/'
+    markup: mrkdwn
+`,
+			err: `unknown markup "mrkdwn", must be markdown or none`,
+		},
+		{
+			in: `
+route:
+    receiver: team-X
+
+receivers:
+- name: 'team-X'
+  markup: markdown
+  email_configs:
+  - to: 'team-X@example.com'
+    smarthost: 'localhost:25'
+    from: 'am@example.com'
+`,
+			err: `text must be configured in email config of receiver "team-X" with markdown markup`,
+		},
+	} {
+		_, err := Load(tc.in)
+		require.EqualError(t, err, tc.err)
+	}
+}
+
 func TestMuteTimeExists(t *testing.T) {
 	in := `
 route:
@@ -905,6 +1005,10 @@ func TestEmptyFieldsAndRegex(t *testing.T) {
 						Smarthost:  HostPort{Host: "localhost", Port: "25"},
 						HTML:       "{{ template \"email.default.html\" . }}",
 						RequireTLS: &boolFoo,
+						HTTPConfig: &commoncfg.HTTPClientConfig{
+							FollowRedirects: true,
+							EnableHTTP2:     true,
+						},
 					},
 				},
 			},
@@ -1277,3 +1381,31 @@ func TestNilRegexp(t *testing.T) {
 		})
 	}
 }
+
+func TestGlobalAndLocalSMTPDKIM(t *testing.T) {
+	config, err := LoadFile("testdata/conf.smtp-dkim-global-and-local.yml")
+	require.NoError(t, err)
+
+	ec := config.Receivers[0].EmailConfigs[0]
+	require.Equal(t, "example.org", ec.DKIMDomain)
+	require.Equal(t, "alerts", ec.DKIMSelector)
+	require.Equal(t, "testdata/dkim.pem", ec.DKIMPrivateKeyFile)
+
+	ec = config.Receivers[0].EmailConfigs[1]
+	require.Equal(t, "example.com", ec.DKIMDomain)
+	require.Equal(t, "monitoring", ec.DKIMSelector)
+	require.Equal(t, "/etc/alertmanager/dkim.pem", ec.DKIMPrivateKeyFile)
+}
+
+func TestWebhookSigningSecretFileRelative(t *testing.T) {
+	config, err := LoadFile("testdata/conf.webhook-signing-secret-file.yml")
+	require.NoError(t, err)
+
+	require.Equal(t, "testdata/webhook.secret", config.Receivers[0].WebhookConfigs[0].SigningSecretFile)
+	require.Equal(t, "/etc/alertmanager/webhook.secret", config.Receivers[0].WebhookConfigs[1].SigningSecretFile)
+}
+
+func TestSMTPDKIMIncomplete(t *testing.T) {
+	_, err := LoadFile("testdata/conf.smtp-dkim-incomplete.yml")
+	require.EqualError(t, err, "dkim_domain, dkim_selector and dkim_private_key_file must be configured together")
+}
@@ -16,23 +16,75 @@ package config
 import (
 	"crypto/md5"
 	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+// DefaultMaxVersions is the default number of applied configurations kept by
+// the coordinator.
+const DefaultMaxVersions = 10
+
+// The sources of configuration versions.
+const (
+	VersionSourceFile     = "file"
+	VersionSourceAPI      = "api"
+	VersionSourceRollback = "rollback"
+)
+
+// ErrVersionNotFound is returned when a configuration version isn't kept by
+// the coordinator.
+var ErrVersionNotFound = errors.New("configuration version not found")
+
+// InvalidConfigError is returned when a configuration fails to load or to
+// pass validation. The running configuration is left unchanged.
+type InvalidConfigError struct {
+	Err error
+}
+
+func (e *InvalidConfigError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *InvalidConfigError) Unwrap() error {
+	return e.Err
+}
+
+// Version is a configuration applied by the coordinator.
+type Version struct {
+	ID int
+	// Source is how the configuration was applied, one of the VersionSource
+	// constants.
+	Source    string
+	AppliedAt time.Time
+	Config    *Config
+	// Diff is the unified diff of the configuration from the previous
+	// version, with secrets hidden.
+	Diff string
+}
+
 // Coordinator coordinates Alertmanager configurations beyond the lifetime of a
 // single configuration.
 type Coordinator struct {
 	configFilePath string
 	logger         log.Logger
 
-	// Protects config and subscribers
+	// Protects config, subscribers, validators and versions
 	mutex       sync.Mutex
 	config      *Config
 	subscribers []func(*Config) error
+	validators  []func(*Config) error
+	versions    []*Version
+	lastID      int
+	maxVersions int
 
 	configHashMetric        prometheus.Gauge
 	configSuccessMetric     prometheus.Gauge
@@ -46,6 +98,7 @@ func NewCoordinator(configFilePath string, r prometheus.Registerer, l log.Logger
 	c := &Coordinator{
 		configFilePath: configFilePath,
 		logger:         l,
+		maxVersions:    DefaultMaxVersions,
 	}
 
 	c.registerMetrics(r)
@@ -82,6 +135,25 @@ func (c *Coordinator) Subscribe(ss ...func(*Config) error) {
 	c.subscribers = append(c.subscribers, ss...)
 }
 
+// AddValidators adds checks that configurations must pass before they are
+// applied, such as building the parts that subscribers could fail to build.
+func (c *Coordinator) AddValidators(vs ...func(*Config) error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.validators = append(c.validators, vs...)
+}
+
+// SetMaxVersions sets the number of applied configurations to keep for
+// rollbacks.
+func (c *Coordinator) SetMaxVersions(n int) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.maxVersions = n
+	c.trimVersions()
+}
+
 func (c *Coordinator) notifySubscribers() error {
 	for _, s := range c.subscribers {
 		if err := s(c.config); err != nil {
@@ -92,18 +164,6 @@ func (c *Coordinator) notifySubscribers() error {
 	return nil
 }
 
-// loadFromFile triggers a configuration load, discarding the old configuration.
-func (c *Coordinator) loadFromFile() error {
-	conf, err := LoadFile(c.configFilePath)
-	if err != nil {
-		return err
-	}
-
-	c.config = conf
-
-	return nil
-}
-
 // Reload triggers a configuration reload from file and notifies all
 // configuration change subscribers.
 func (c *Coordinator) Reload() error {
@@ -114,20 +174,120 @@ func (c *Coordinator) Reload() error {
 		"msg", "Loading configuration file",
 		"file", c.configFilePath,
 	)
-	if err := c.loadFromFile(); err != nil {
+	conf, err := LoadFile(c.configFilePath)
+	if err != nil {
 		level.Error(c.logger).Log(
 			"msg", "Loading configuration file failed",
 			"file", c.configFilePath,
 			"err", err,
 		)
 		c.configSuccessMetric.Set(0)
-		return err
+		return &InvalidConfigError{Err: err}
 	}
 	level.Info(c.logger).Log(
 		"msg", "Completed loading of configuration file",
 		"file", c.configFilePath,
 	)
 
+	_, err = c.apply(conf, VersionSourceFile, false)
+	return err
+}
+
+// Validate loads the configuration and runs the validators on it without
+// applying it. The returned version has the ID 0.
+func (c *Coordinator) Validate(content string) (*Version, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	conf, err := c.load(content)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.validate(conf); err != nil {
+		return nil, err
+	}
+	return newVersion(conf, VersionSourceAPI, c.config), nil
+}
+
+// Apply loads, validates and applies the configuration. If persist is true,
+// the configuration file is replaced by it. Failing that, or if any
+// subscriber fails to apply it, the previous configuration is restored.
+func (c *Coordinator) Apply(content string, persist bool) (*Version, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	conf, err := c.load(content)
+	if err != nil {
+		return nil, err
+	}
+	return c.apply(conf, VersionSourceAPI, persist)
+}
+
+// Rollback applies again the configuration of the version with the given ID.
+func (c *Coordinator) Rollback(id int, persist bool) (*Version, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, v := range c.versions {
+		if v.ID == id {
+			return c.apply(v.Config, VersionSourceRollback, persist)
+		}
+	}
+	return nil, ErrVersionNotFound
+}
+
+// Versions returns the kept configuration versions, oldest first. The last
+// version is the running configuration.
+func (c *Coordinator) Versions() []*Version {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return append([]*Version(nil), c.versions...)
+}
+
+// load parses a configuration given as content, resolving relative paths
+// against the directory of the configuration file.
+func (c *Coordinator) load(content string) (*Config, error) {
+	conf, err := Load(content)
+	if err != nil {
+		return nil, &InvalidConfigError{Err: err}
+	}
+	resolveFilepaths(filepath.Dir(c.configFilePath), conf)
+	return conf, nil
+}
+
+func (c *Coordinator) validate(conf *Config) error {
+	for _, v := range c.validators {
+		if err := v(conf); err != nil {
+			return &InvalidConfigError{Err: err}
+		}
+	}
+	return nil
+}
+
+// apply must be called with c.mutex held.
+func (c *Coordinator) apply(conf *Config, source string, persist bool) (*Version, error) {
+	if err := c.validate(conf); err != nil {
+		level.Error(c.logger).Log("msg", "Invalid configuration", "source", source, "err", err)
+		c.configSuccessMetric.Set(0)
+		return nil, err
+	}
+
+	var previousFile []byte
+	if persist {
+		var err error
+		if previousFile, err = os.ReadFile(c.configFilePath); err != nil {
+			c.configSuccessMetric.Set(0)
+			return nil, fmt.Errorf("read configuration file: %w", err)
+		}
+		if err := writeFile(c.configFilePath, []byte(conf.original)); err != nil {
+			c.configSuccessMetric.Set(0)
+			return nil, fmt.Errorf("write configuration file: %w", err)
+		}
+	}
+
+	previous := c.config
+	c.config = conf
 	if err := c.notifySubscribers(); err != nil {
 		c.logger.Log(
 			"msg", "one or more config change subscribers failed to apply new config",
@@ -135,7 +295,8 @@ func (c *Coordinator) Reload() error {
 			"err", err,
 		)
 		c.configSuccessMetric.Set(0)
-		return err
+		c.restore(previous, previousFile)
+		return nil, err
 	}
 
 	c.configSuccessMetric.Set(1)
@@ -143,7 +304,93 @@ func (c *Coordinator) Reload() error {
 	hash := md5HashAsMetricValue([]byte(c.config.original))
 	c.configHashMetric.Set(hash)
 
-	return nil
+	return c.addVersion(conf, source, previous), nil
+}
+
+// restore applies the previous configuration again after a failure to apply
+// a new one.
+func (c *Coordinator) restore(previous *Config, previousFile []byte) {
+	if previousFile != nil {
+		if err := writeFile(c.configFilePath, previousFile); err != nil {
+			level.Error(c.logger).Log("msg", "Failed to restore the configuration file", "file", c.configFilePath, "err", err)
+		}
+	}
+	if previous == nil {
+		return
+	}
+	c.config = previous
+	if err := c.notifySubscribers(); err != nil {
+		level.Error(c.logger).Log("msg", "Failed to restore the previous configuration", "err", err)
+	}
+}
+
+func (c *Coordinator) addVersion(conf *Config, source string, previous *Config) *Version {
+	// Reloading an unchanged file doesn't make a new version.
+	if previous != nil && source == VersionSourceFile && previous.original == conf.original && len(c.versions) > 0 {
+		return c.versions[len(c.versions)-1]
+	}
+
+	v := newVersion(conf, source, previous)
+	c.lastID++
+	v.ID = c.lastID
+	c.versions = append(c.versions, v)
+	c.trimVersions()
+	return v
+}
+
+func newVersion(conf *Config, source string, previous *Config) *Version {
+	var from string
+	if previous != nil {
+		from = previous.String()
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(conf.String()),
+		FromFile: "previous",
+		ToFile:   "current",
+		Context:  3,
+	})
+	if err != nil {
+		diff = ""
+	}
+
+	return &Version{
+		Source:    source,
+		AppliedAt: time.Now().UTC(),
+		Config:    conf,
+		Diff:      diff,
+	}
+}
+
+func (c *Coordinator) trimVersions() {
+	if c.maxVersions > 0 && len(c.versions) > c.maxVersions {
+		c.versions = append([]*Version(nil), c.versions[len(c.versions)-c.maxVersions:]...)
+	}
+}
+
+// writeFile replaces the file atomically.
+func writeFile(filename string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(filename), "."+filepath.Base(filename)+".tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if fi, err := os.Stat(filename); err == nil {
+		if err := os.Chmod(tmp, fi.Mode()); err != nil {
+			os.Remove(tmp)
+			return err
+		}
+	}
+	return os.Rename(tmp, filename)
 }
 
 func md5HashAsMetricValue(data []byte) float64 {
@@ -15,10 +15,13 @@ package config
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/go-kit/log"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
 )
 
 type fakeRegisterer struct {
@@ -81,3 +84,145 @@ func TestCoordinatorFailReloadWhenSubscriberFails(t *testing.T) {
 		t.Fatalf("expected error message %q but got %q", errMessage, err)
 	}
 }
+
+const (
+	testConfigA = `
+route:
+  receiver: a
+receivers:
+- name: a
+`
+	testConfigB = `
+route:
+  receiver: b
+receivers:
+- name: b
+`
+)
+
+func newTestCoordinator(t *testing.T) (*Coordinator, string) {
+	f := filepath.Join(t.TempDir(), "alertmanager.yml")
+	require.NoError(t, os.WriteFile(f, []byte(testConfigA), 0o600))
+	c := NewCoordinator(f, prometheus.NewRegistry(), log.NewNopLogger())
+	return c, f
+}
+
+func TestCoordinatorApply(t *testing.T) {
+	c, f := newTestCoordinator(t)
+	var applied []string
+	c.Subscribe(func(conf *Config) error {
+		applied = append(applied, conf.Route.Receiver)
+		return nil
+	})
+	require.NoError(t, c.Reload())
+	// Reloading an unchanged file applies it again without a new version.
+	require.NoError(t, c.Reload())
+	require.Len(t, c.Versions(), 1)
+
+	v, err := c.Apply(testConfigB, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, v.ID)
+	require.Equal(t, VersionSourceAPI, v.Source)
+	require.Contains(t, v.Diff, "-  receiver: a\n")
+	require.Contains(t, v.Diff, "+  receiver: b\n")
+	require.Equal(t, []string{"a", "a", "b"}, applied)
+
+	// The file is left as is unless the configuration is persisted.
+	b, err := os.ReadFile(f)
+	require.NoError(t, err)
+	require.Equal(t, testConfigA, string(b))
+
+	v, err = c.Rollback(1, true)
+	require.NoError(t, err)
+	require.Equal(t, 3, v.ID)
+	require.Equal(t, VersionSourceRollback, v.Source)
+	require.Equal(t, "a", applied[len(applied)-1])
+	b, err = os.ReadFile(f)
+	require.NoError(t, err)
+	require.Equal(t, testConfigA, string(b))
+
+	_, err = c.Rollback(42, false)
+	require.Equal(t, ErrVersionNotFound, err)
+
+	versions := c.Versions()
+	require.Len(t, versions, 3)
+	for i, v := range versions {
+		require.Equal(t, i+1, v.ID)
+	}
+}
+
+func TestCoordinatorApplyInvalid(t *testing.T) {
+	c, f := newTestCoordinator(t)
+	var applied []string
+	c.Subscribe(func(conf *Config) error {
+		applied = append(applied, conf.Route.Receiver)
+		return nil
+	})
+	c.AddValidators(func(conf *Config) error {
+		if conf.Route.Receiver == "b" {
+			return errors.New("receiver b is broken")
+		}
+		return nil
+	})
+	require.NoError(t, c.Reload())
+
+	var invalid *InvalidConfigError
+	_, err := c.Apply("route: {", true)
+	require.ErrorAs(t, err, &invalid)
+	_, err = c.Apply(testConfigB, true)
+	require.ErrorAs(t, err, &invalid)
+	require.EqualError(t, err, "receiver b is broken")
+	_, err = c.Validate(testConfigB)
+	require.ErrorAs(t, err, &invalid)
+
+	require.Equal(t, []string{"a"}, applied)
+	require.Len(t, c.Versions(), 1)
+	b, err := os.ReadFile(f)
+	require.NoError(t, err)
+	require.Equal(t, testConfigA, string(b))
+
+	v, err := c.Validate(testConfigA)
+	require.NoError(t, err)
+	require.Equal(t, 0, v.ID)
+	require.Empty(t, v.Diff)
+}
+
+func TestCoordinatorRestoresConfigWhenSubscriberFails(t *testing.T) {
+	c, f := newTestCoordinator(t)
+	var applied []string
+	c.Subscribe(func(conf *Config) error {
+		if conf.Route.Receiver == "b" {
+			return errors.New("failed")
+		}
+		applied = append(applied, conf.Route.Receiver)
+		return nil
+	})
+	require.NoError(t, c.Reload())
+
+	_, err := c.Apply(testConfigB, true)
+	require.EqualError(t, err, "failed")
+
+	// The previous configuration and file are restored.
+	require.Equal(t, []string{"a", "a"}, applied)
+	b, err := os.ReadFile(f)
+	require.NoError(t, err)
+	require.Equal(t, testConfigA, string(b))
+	require.Len(t, c.Versions(), 1)
+}
+
+func TestCoordinatorMaxVersions(t *testing.T) {
+	c, _ := newTestCoordinator(t)
+	c.SetMaxVersions(2)
+	require.NoError(t, c.Reload())
+	_, err := c.Apply(testConfigB, false)
+	require.NoError(t, err)
+	_, err = c.Apply(testConfigA, false)
+	require.NoError(t, err)
+
+	versions := c.Versions()
+	require.Len(t, versions, 2)
+	require.Equal(t, 2, versions[0].ID)
+	require.Equal(t, 3, versions[1].ID)
+	_, err = c.Rollback(1, false)
+	require.Equal(t, ErrVersionNotFound, err)
+}
@@ -33,6 +33,16 @@ var (
 		},
 	}
 
+	// DefaultHTTPRequestConfig defines default values for templated HTTP
+	// request configurations.
+	DefaultHTTPRequestConfig = HTTPRequestConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		Method:           "POST",
+		RetryStatusCodes: []string{"5xx"},
+	}
+
 	// DefaultWebexConfig defines default values for Webex configurations.
 	DefaultWebexConfig = WebexConfig{
 		NotifierConfig: NotifierConfig{
@@ -62,6 +72,11 @@ var (
 	// DefaultEmailSubject defines the default Subject header of an Email.
 	DefaultEmailSubject = `{{ template "email.default.subject" . }}`
 
+	// DefaultEmailAttachment defines default values for Email attachments.
+	DefaultEmailAttachment = EmailAttachment{
+		MaxSize: 10 << 20,
+	}
+
 	// DefaultPagerdutyDetails defines the default values for PagerDuty details.
 	DefaultPagerdutyDetails = map[string]string{
 		"firing":       `{{ template "pagerduty.default.instances" .Alerts.Firing }}`,
@@ -199,6 +214,23 @@ func (nc *NotifierConfig) SendResolved() bool {
 	return nc.VSendResolved
 }
 
+// Markup languages of the text of notifications.
+const (
+	// MarkupNone sends the text as rendered by its templates.
+	MarkupNone = "none"
+	// MarkupMarkdown converts text written in markdown to the markup of the
+	// integration.
+	MarkupMarkdown = "markdown"
+)
+
+func validateMarkup(markup string) error {
+	switch markup {
+	case "", MarkupNone, MarkupMarkdown:
+		return nil
+	}
+	return fmt.Errorf("unknown markup %q, must be markdown or none", markup)
+}
+
 // WebexConfig configures notifications via Webex.
 type WebexConfig struct {
 	NotifierConfig `yaml:",inline" json:",inline"`
@@ -238,6 +270,9 @@ type DiscordConfig struct {
 
 	Title   string `yaml:"title,omitempty" json:"title,omitempty"`
 	Message string `yaml:"message,omitempty" json:"message,omitempty"`
+	// Markup is the markup language of the text fields, converted to the
+	// markup of the integration.
+	Markup string `yaml:"markup,omitempty" json:"markup,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface.
@@ -247,6 +282,9 @@ func (c *DiscordConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	if err := unmarshal((*plain)(c)); err != nil {
 		return err
 	}
+	if err := validateMarkup(c.Markup); err != nil {
+		return err
+	}
 
 	if c.WebhookURL == nil && c.WebhookURLFile == "" {
 		return fmt.Errorf("one of webhook_url or webhook_url_file must be configured")
@@ -278,6 +316,17 @@ type EmailConfig struct {
 	Text             string              `yaml:"text,omitempty" json:"text,omitempty"`
 	RequireTLS       *bool               `yaml:"require_tls,omitempty" json:"require_tls,omitempty"`
 	TLSConfig        commoncfg.TLSConfig `yaml:"tls_config,omitempty" json:"tls_config,omitempty"`
+	Attachments      []*EmailAttachment  `yaml:"attachments,omitempty" json:"attachments,omitempty"`
+	// HTTPConfig configures the client fetching the attachments read from
+	// URLs.
+	HTTPConfig *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	// DKIM signing of the messages.
+	DKIMDomain         string `yaml:"dkim_domain,omitempty" json:"dkim_domain,omitempty"`
+	DKIMSelector       string `yaml:"dkim_selector,omitempty" json:"dkim_selector,omitempty"`
+	DKIMPrivateKeyFile string `yaml:"dkim_private_key_file,omitempty" json:"dkim_private_key_file,omitempty"`
+	// Markup is the markup language of the text field, from which both the
+	// text and the HTML parts are rendered when set to markdown.
+	Markup string `yaml:"markup,omitempty" json:"markup,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface.
@@ -290,6 +339,9 @@ func (c *EmailConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	if c.To == "" {
 		return fmt.Errorf("missing to address in email config")
 	}
+	if err := validateMarkup(c.Markup); err != nil {
+		return err
+	}
 	// Header names are case-insensitive, check for collisions.
 	normalizedHeaders := map[string]string{}
 	for h, v := range c.Headers {
@@ -301,6 +353,66 @@ func (c *EmailConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 	c.Headers = normalizedHeaders
 
+	contentIDs := map[string]struct{}{}
+	for _, a := range c.Attachments {
+		if !a.Inline {
+			continue
+		}
+		if _, ok := contentIDs[a.ContentID]; ok {
+			return fmt.Errorf("duplicate content ID %q in email config", a.ContentID)
+		}
+		contentIDs[a.ContentID] = struct{}{}
+	}
+
+	return nil
+}
+
+// EmailAttachment configures a file attached to Email notifications. Its
+// content is either rendered from a template, a dump of the alerts of the
+// group, read from a file or fetched from a URL.
+type EmailAttachment struct {
+	Filename    string `yaml:"filename,omitempty" json:"filename,omitempty"`
+	ContentType string `yaml:"content_type,omitempty" json:"content_type,omitempty"`
+	Content     string `yaml:"content,omitempty" json:"content,omitempty"`
+	Alerts      string `yaml:"alerts,omitempty" json:"alerts,omitempty"`
+	File        string `yaml:"file,omitempty" json:"file,omitempty"`
+	URL         string `yaml:"url,omitempty" json:"url,omitempty"`
+	Inline      bool   `yaml:"inline,omitempty" json:"inline,omitempty"`
+	ContentID   string `yaml:"content_id,omitempty" json:"content_id,omitempty"`
+	MaxSize     int64  `yaml:"max_size,omitempty" json:"max_size,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *EmailAttachment) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultEmailAttachment
+	type plain EmailAttachment
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.Filename == "" {
+		return fmt.Errorf("missing filename in email attachment")
+	}
+	sources := 0
+	for _, s := range []string{c.Content, c.Alerts, c.File, c.URL} {
+		if s != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return fmt.Errorf("exactly one of content, alerts, file or url must be configured in email attachment %q", c.Filename)
+	}
+	if c.Alerts != "" && c.Alerts != "csv" && c.Alerts != "json" {
+		return fmt.Errorf("invalid alerts format %q in email attachment %q, must be csv or json", c.Alerts, c.Filename)
+	}
+	if c.MaxSize <= 0 {
+		return fmt.Errorf("max_size must be positive in email attachment %q", c.Filename)
+	}
+	if c.ContentID != "" && !c.Inline {
+		return fmt.Errorf("content_id requires inline in email attachment %q", c.Filename)
+	}
+	if c.Inline && c.ContentID == "" {
+		c.ContentID = c.Filename
+	}
 	return nil
 }
 
@@ -486,6 +598,9 @@ type SlackConfig struct {
 	LinkNames   bool           `yaml:"link_names" json:"link_names,omitempty"`
 	MrkdwnIn    []string       `yaml:"mrkdwn_in,omitempty" json:"mrkdwn_in,omitempty"`
 	Actions     []*SlackAction `yaml:"actions,omitempty" json:"actions,omitempty"`
+	// Markup is the markup language of the text fields, converted to the
+	// markup of the integration.
+	Markup string `yaml:"markup,omitempty" json:"markup,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface.
@@ -495,6 +610,9 @@ func (c *SlackConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	if err := unmarshal((*plain)(c)); err != nil {
 		return err
 	}
+	if err := validateMarkup(c.Markup); err != nil {
+		return err
+	}
 
 	if c.APIURL != nil && len(c.APIURLFile) > 0 {
 		return fmt.Errorf("at most one of api_url & api_url_file must be configured")
@@ -517,6 +635,11 @@ type WebhookConfig struct {
 	// Alerts exceeding this threshold will be truncated. Setting this to 0
 	// allows an unlimited number of alerts.
 	MaxAlerts uint64 `yaml:"max_alerts" json:"max_alerts"`
+
+	// SigningSecret is the key used to sign the messages with HMAC-SHA256. If
+	// neither it nor SigningSecretFile is set, messages aren't signed.
+	SigningSecret     Secret `yaml:"signing_secret,omitempty" json:"signing_secret,omitempty"`
+	SigningSecretFile string `yaml:"signing_secret_file,omitempty" json:"signing_secret_file,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface.
@@ -532,6 +655,73 @@ func (c *WebhookConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	if c.URL != nil && c.URLFile != "" {
 		return fmt.Errorf("at most one of url & url_file must be configured")
 	}
+	if c.SigningSecret != "" && c.SigningSecretFile != "" {
+		return fmt.Errorf("at most one of signing_secret & signing_secret_file must be configured")
+	}
+	return nil
+}
+
+// HTTPRequestConfig configures notifications via HTTP requests rendered from
+// templates.
+type HTTPRequestConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+
+	HTTPConfig *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	// The method, URL, headers, query parameters and body of the request are
+	// templates. If the body is empty, the request has the JSON payload of
+	// webhooks.
+	Method  string            `yaml:"method,omitempty" json:"method,omitempty"`
+	URL     Secret            `yaml:"url,omitempty" json:"url,omitempty"`
+	Headers map[string]Secret `yaml:"headers,omitempty" json:"headers,omitempty"`
+	Query   map[string]string `yaml:"query,omitempty" json:"query,omitempty"`
+	Body    string            `yaml:"body,omitempty" json:"body,omitempty"`
+
+	// SuccessStatusCodes are the status codes of successful responses. If
+	// empty, 2xx status codes are successful.
+	SuccessStatusCodes []int `yaml:"success_status_codes,omitempty" json:"success_status_codes,omitempty"`
+	// RetryStatusCodes are the status codes, or classes of status codes such
+	// as 5xx, of failed requests to retry.
+	RetryStatusCodes []string `yaml:"retry_status_codes,omitempty" json:"retry_status_codes,omitempty"`
+	// ResponseFields are the dot-separated paths of the fields of JSON
+	// responses to log.
+	ResponseFields []string `yaml:"response_fields,omitempty" json:"response_fields,omitempty"`
+
+	// MaxAlerts is the maximum number of alerts to be rendered per request.
+	// Setting this to 0 allows an unlimited number of alerts.
+	MaxAlerts uint64 `yaml:"max_alerts,omitempty" json:"max_alerts,omitempty"`
+}
+
+var statusCodeRe = regexp.MustCompile(`^[1-5]([0-9]{2}|xx)$`)
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *HTTPRequestConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultHTTPRequestConfig
+	type plain HTTPRequestConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.URL == "" {
+		return fmt.Errorf("missing url in http request config")
+	}
+	if c.Method == "" {
+		return fmt.Errorf("missing method in http request config")
+	}
+	for _, code := range c.SuccessStatusCodes {
+		if code < 100 || code > 599 {
+			return fmt.Errorf("invalid success status code %d", code)
+		}
+	}
+	for _, code := range c.RetryStatusCodes {
+		if !statusCodeRe.MatchString(code) {
+			return fmt.Errorf("invalid retry status code %q, must be a status code or a class such as 5xx", code)
+		}
+	}
+	for _, f := range c.ResponseFields {
+		if f == "" {
+			return fmt.Errorf("empty response field")
+		}
+	}
 	return nil
 }
 
@@ -785,6 +975,9 @@ type TelegramConfig struct {
 	Message              string `yaml:"message,omitempty" json:"message,omitempty"`
 	DisableNotifications bool   `yaml:"disable_notifications,omitempty" json:"disable_notifications,omitempty"`
 	ParseMode            string `yaml:"parse_mode,omitempty" json:"parse_mode,omitempty"`
+	// Markup is the markup language of the message, converted to HTML
+	// regardless of the parse mode.
+	Markup string `yaml:"markup,omitempty" json:"markup,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface.
@@ -809,7 +1002,7 @@ func (c *TelegramConfig) UnmarshalYAML(unmarshal func(interface{}) error) error
 		c.ParseMode != "HTML" {
 		return fmt.Errorf("unknown parse_mode on telegram_config, must be Markdown, MarkdownV2, HTML or empty string")
 	}
-	return nil
+	return validateMarkup(c.Markup)
 }
 
 type MSTeamsConfig struct {
@@ -821,6 +1014,9 @@ type MSTeamsConfig struct {
 	Title   string `yaml:"title,omitempty" json:"title,omitempty"`
 	Summary string `yaml:"summary,omitempty" json:"summary,omitempty"`
 	Text    string `yaml:"text,omitempty" json:"text,omitempty"`
+	// Markup is the markup language of the text fields, converted to the
+	// markup of the integration.
+	Markup string `yaml:"markup,omitempty" json:"markup,omitempty"`
 }
 
 func (c *MSTeamsConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -829,6 +1025,9 @@ func (c *MSTeamsConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	if err := unmarshal((*plain)(c)); err != nil {
 		return err
 	}
+	if err := validateMarkup(c.Markup); err != nil {
+		return err
+	}
 
 	if c.WebhookURL == nil && c.WebhookURLFile == "" {
 		return fmt.Errorf("one of webhook_url or webhook_url_file must be configured")
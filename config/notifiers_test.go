@@ -60,6 +60,64 @@ headers:
 	}
 }
 
+func TestEmailAttachments(t *testing.T) {
+	in := `
+to: 'to@email.com'
+attachments:
+- filename: alerts.csv
+  alerts: csv
+- filename: logo.png
+  file: logo.png
+  inline: true
+  max_size: 1024
+`
+	var cfg EmailConfig
+	err := yaml.UnmarshalStrict([]byte(in), &cfg)
+	require.NoError(t, err)
+	require.Equal(t, []*EmailAttachment{
+		{Filename: "alerts.csv", Alerts: "csv", MaxSize: DefaultEmailAttachment.MaxSize},
+		{Filename: "logo.png", File: "logo.png", Inline: true, ContentID: "logo.png", MaxSize: 1024},
+	}, cfg.Attachments)
+
+	for _, tc := range []struct {
+		in  string
+		err string
+	}{
+		{
+			in:  "- content: foo",
+			err: "missing filename in email attachment",
+		},
+		{
+			in:  "- filename: a.txt",
+			err: `exactly one of content, alerts, file or url must be configured in email attachment "a.txt"`,
+		},
+		{
+			in:  "- {filename: a.txt, content: foo, file: a.txt}",
+			err: `exactly one of content, alerts, file or url must be configured in email attachment "a.txt"`,
+		},
+		{
+			in:  "- {filename: a.xml, alerts: xml}",
+			err: `invalid alerts format "xml" in email attachment "a.xml", must be csv or json`,
+		},
+		{
+			in:  "- {filename: a.txt, content: foo, max_size: -1}",
+			err: `max_size must be positive in email attachment "a.txt"`,
+		},
+		{
+			in:  "- {filename: a.png, file: a.png, content_id: a}",
+			err: `content_id requires inline in email attachment "a.png"`,
+		},
+		{
+			in:  "- {filename: a.png, file: a.png, inline: true}\n- {filename: a.png, file: b.png, inline: true}",
+			err: `duplicate content ID "a.png" in email config`,
+		},
+	} {
+		var cfg EmailConfig
+		err := yaml.UnmarshalStrict([]byte("to: 'to@email.com'\nattachments:\n"+tc.in), &cfg)
+		require.EqualError(t, err, tc.err)
+	}
+}
+
 func TestPagerdutyTestRoutingKey(t *testing.T) {
 	t.Run("error if no routing key or key file", func(t *testing.T) {
 		in := `
@@ -256,6 +314,18 @@ url_file: 'http://example.com'
 	}
 }
 
+func TestWebhookSigningSecretOrSigningSecretFile(t *testing.T) {
+	in := `
+url: 'http://example.com'
+signing_secret: 'secret'
+signing_secret_file: '/etc/alertmanager/secret'
+`
+	var cfg WebhookConfig
+	err := yaml.UnmarshalStrict([]byte(in), &cfg)
+
+	require.EqualError(t, err, "at most one of signing_secret & signing_secret_file must be configured")
+}
+
 func TestWebhookHttpConfigIsValid(t *testing.T) {
 	in := `
 url: 'http://example.com'
@@ -310,6 +380,63 @@ http_config:
 	}
 }
 
+func TestHTTPRequestConfiguration(t *testing.T) {
+	tc := []struct {
+		name string
+
+		in       string
+		expected error
+	}{
+		{
+			name: "with no url - it fails",
+			in: `
+body: xyz
+`,
+			expected: errors.New("missing url in http request config"),
+		},
+		{
+			name: "with an invalid success status code - it fails",
+			in: `
+url: http://example.com
+success_status_codes: [200, 600]
+`,
+			expected: errors.New("invalid success status code 600"),
+		},
+		{
+			name: "with an invalid retry status code - it fails",
+			in: `
+url: http://example.com
+retry_status_codes: [5x]
+`,
+			expected: errors.New(`invalid retry status code "5x", must be a status code or a class such as 5xx`),
+		},
+		{
+			name: "with templates and status codes - it succeeds",
+			in: `
+method: '{{ if eq .Status "firing" }}POST{{ else }}DELETE{{ end }}'
+url: http://example.com/{{ .GroupLabels.alertname }}
+headers:
+  Authorization: Bearer token
+query:
+  team: '{{ .CommonLabels.team }}'
+body: '{{ .Alerts | len }}'
+success_status_codes: [200, 201]
+retry_status_codes: [5xx, 429]
+response_fields: [id]
+`,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg HTTPRequestConfig
+			err := yaml.UnmarshalStrict([]byte(tt.in), &cfg)
+
+			require.Equal(t, tt.expected, err)
+		})
+	}
+}
+
 func TestVictorOpsConfiguration(t *testing.T) {
 	t.Run("valid configuration", func(t *testing.T) {
 		in := `
@@ -22,6 +22,7 @@ import (
 	"github.com/prometheus/alertmanager/notify"
 	"github.com/prometheus/alertmanager/notify/discord"
 	"github.com/prometheus/alertmanager/notify/email"
+	"github.com/prometheus/alertmanager/notify/httprequest"
 	"github.com/prometheus/alertmanager/notify/msteams"
 	"github.com/prometheus/alertmanager/notify/opsgenie"
 	"github.com/prometheus/alertmanager/notify/pagerduty"
@@ -55,11 +56,21 @@ func BuildReceiverIntegrations(nc config.Receiver, tmpl *template.Template, logg
 		}
 	)
 
+	if nc.Locale != "" {
+		var err error
+		if tmpl, err = tmpl.WithLocale(nc.Locale); err != nil {
+			return nil, err
+		}
+	}
+
 	for i, c := range nc.WebhookConfigs {
 		add("webhook", i, c, func(l log.Logger) (notify.Notifier, error) { return webhook.New(c, tmpl, l, httpOpts...) })
 	}
+	for i, c := range nc.HTTPRequestConfigs {
+		add("http", i, c, func(l log.Logger) (notify.Notifier, error) { return httprequest.New(c, tmpl, l, httpOpts...) })
+	}
 	for i, c := range nc.EmailConfigs {
-		add("email", i, c, func(l log.Logger) (notify.Notifier, error) { return email.New(c, tmpl, l), nil })
+		add("email", i, c, func(l log.Logger) (notify.Notifier, error) { return email.New(c, tmpl, l, httpOpts...) })
 	}
 	for i, c := range nc.PagerdutyConfigs {
 		add("pagerduty", i, c, func(l log.Logger) (notify.Notifier, error) { return pagerduty.New(c, tmpl, l, httpOpts...) })
@@ -0,0 +1,191 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receiver
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/template"
+)
+
+// TemplateError is an error rendering a templated field of a receiver.
+type TemplateError struct {
+	Receiver string
+	// Field is the path of the field, e.g. slack_configs[0].title.
+	Field string
+	Err   error
+}
+
+func (e *TemplateError) Error() string {
+	return fmt.Sprintf("receiver %q: %s: %v", e.Receiver, e.Field, e.Err)
+}
+
+func (e *TemplateError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	configPkgPath   = reflect.TypeOf(config.Receiver{}).PkgPath()
+	emailConfigType = reflect.TypeOf(config.EmailConfig{})
+	stringType      = reflect.TypeOf("")
+	secretType      = reflect.TypeOf(config.Secret(""))
+)
+
+// ValidateTemplates renders every templated field of the receivers against
+// synthetic data, so that broken templates are found before an alert fails
+// to notify. It returns an error per field failing to render.
+func ValidateTemplates(receivers []config.Receiver, tmpl *template.Template) []*TemplateError {
+	var errs []*TemplateError
+	for _, rcv := range receivers {
+		rtmpl := tmpl
+		if rcv.Locale != "" {
+			var err error
+			if rtmpl, err = tmpl.WithLocale(rcv.Locale); err != nil {
+				errs = append(errs, &TemplateError{Receiver: rcv.Name, Field: "locale", Err: err})
+				continue
+			}
+		}
+		data := validationData(rcv.Name)
+		walkStrings(reflect.ValueOf(rcv), "", false, func(field, text string, html bool) {
+			if !strings.Contains(text, "{{") {
+				return
+			}
+			var err error
+			if html {
+				_, err = rtmpl.ExecuteHTMLString(text, data)
+			} else {
+				_, err = rtmpl.ExecuteTextString(text, data)
+			}
+			if err != nil {
+				errs = append(errs, &TemplateError{Receiver: rcv.Name, Field: field, Err: err})
+			}
+		})
+	}
+	return errs
+}
+
+// TemplateField returns the value of the field of the receiver at the given
+// path, e.g. slack_configs[0].title, and whether it renders as HTML.
+func TemplateField(rcv config.Receiver, field string) (string, bool, error) {
+	var (
+		text  string
+		html  bool
+		found bool
+	)
+	walkStrings(reflect.ValueOf(rcv), "", false, func(path, t string, h bool) {
+		if path == field {
+			text, html, found = t, h, true
+		}
+	})
+	if !found {
+		return "", false, fmt.Errorf("receiver %q has no field %s", rcv.Name, field)
+	}
+	return text, html, nil
+}
+
+// walkStrings calls f for every string or secret of the configuration value,
+// with the path of the field made of YAML names.
+// Other named string types and types of other packages are skipped.
+func walkStrings(v reflect.Value, path string, html bool, f func(field, text string, html bool)) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			walkStrings(v.Elem(), path, html, f)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		if t.PkgPath() != configPkgPath {
+			return
+		}
+		for i := 0; i < t.NumField(); i++ {
+			ft := t.Field(i)
+			if !ft.IsExported() {
+				continue
+			}
+			name, opts, _ := strings.Cut(ft.Tag.Get("yaml"), ",")
+			if name == "-" {
+				continue
+			}
+			fieldPath := path
+			if !strings.Contains(opts, "inline") {
+				if name == "" {
+					name = strings.ToLower(ft.Name)
+				}
+				fieldPath = joinPath(path, name)
+			}
+			walkStrings(v.Field(i), fieldPath, t == emailConfigType && ft.Name == "HTML", f)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			walkStrings(v.Index(i), fmt.Sprintf("%s[%d]", path, i), html, f)
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, k := range keys {
+			walkStrings(v.MapIndex(k), joinPath(path, k.String()), html, f)
+		}
+	case reflect.String:
+		if v.Type() == stringType || v.Type() == secretType {
+			f(path, v.String(), html)
+		}
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// validationData returns synthetic data with a firing and a resolved alert.
+func validationData(receiver string) *template.Data {
+	now := time.Now()
+	return &template.Data{
+		Receiver: receiver,
+		Status:   "firing",
+		Alerts: template.Alerts{
+			{
+				Status:       "firing",
+				Labels:       template.KV{"alertname": "Validation", "instance": "localhost:9100", "severity": "critical"},
+				Annotations:  template.KV{"summary": "Validation alert", "description": "Synthetic alert validating templates."},
+				StartsAt:     now.Add(-10 * time.Minute),
+				GeneratorURL: "http://localhost:9090/graph",
+				Fingerprint:  "0000000000000001",
+			},
+			{
+				Status:       "resolved",
+				Labels:       template.KV{"alertname": "Validation", "instance": "localhost:9101", "severity": "critical"},
+				Annotations:  template.KV{"summary": "Validation alert", "description": "Synthetic alert validating templates."},
+				StartsAt:     now.Add(-20 * time.Minute),
+				EndsAt:       now.Add(-5 * time.Minute),
+				GeneratorURL: "http://localhost:9090/graph",
+				Fingerprint:  "0000000000000002",
+			},
+		},
+		GroupLabels:       template.KV{"alertname": "Validation"},
+		CommonLabels:      template.KV{"alertname": "Validation", "severity": "critical"},
+		CommonAnnotations: template.KV{"summary": "Validation alert", "description": "Synthetic alert validating templates."},
+		ExternalURL:       "http://localhost:9093",
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receiver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/template"
+)
+
+func TestValidateTemplates(t *testing.T) {
+	tmpl, err := template.New()
+	require.NoError(t, err)
+	require.NoError(t, tmpl.Parse(strings.NewReader(`{{ define "custom.title" }}{{ .CommonLabels.alertname }}{{ end }}`)))
+
+	receivers := []config.Receiver{
+		{
+			Name: "valid",
+			SlackConfigs: []*config.SlackConfig{
+				{
+					Title: `{{ template "custom.title" . }}`,
+					Text:  `{{ range .Alerts.Firing }}{{ .Labels.instance }} since {{ .StartsAt | since | humanizeDuration }}{{ end }}`,
+					Fields: []*config.SlackField{
+						{Title: "Receiver", Value: "{{ .Receiver }}"},
+					},
+				},
+			},
+			EmailConfigs: []*config.EmailConfig{
+				{
+					To:      "{{ .CommonLabels.team }}@example.com",
+					HTML:    `<a href="{{ .ExternalURL }}">{{ len .Alerts }}</a>`,
+					Headers: map[string]string{"Subject": "{{ .Status }}"},
+				},
+			},
+		},
+		{
+			Name: "invalid",
+			SlackConfigs: []*config.SlackConfig{
+				{
+					Title: `{{ template "custom.missing" . }}`,
+					Text:  `{{ .CommonLabels.alertname }}`,
+				},
+			},
+			EmailConfigs: []*config.EmailConfig{
+				{
+					To: "{{ .Alertz }}@example.com",
+					// Valid as text but ends in an attribute, which HTML
+					// templates refuse.
+					HTML:    `<a href="{{ .ExternalURL }}`,
+					Headers: map[string]string{"Subject": "{{ .Status }}", "X-Team": "{{ .CommonLabels.team | missingFunc }}"},
+				},
+			},
+			HTTPRequestConfigs: []*config.HTTPRequestConfig{
+				{
+					URL:     "https://example.com/{{ .GroupLabels.alertname }}",
+					Headers: map[string]config.Secret{"Authorization": "Bearer {{ .Foo.Bar }}"},
+				},
+			},
+		},
+	}
+
+	errs := ValidateTemplates(receivers, tmpl)
+	var fields []string
+	for _, err := range errs {
+		require.Equal(t, "invalid", err.Receiver)
+		require.Error(t, err.Err)
+		require.Contains(t, err.Error(), `receiver "invalid": `+err.Field+": ")
+		fields = append(fields, err.Field)
+	}
+	require.Equal(t, []string{
+		"email_configs[0].to",
+		"email_configs[0].headers.X-Team",
+		"email_configs[0].html",
+		"slack_configs[0].title",
+		"http_configs[0].headers.Authorization",
+	}, fields)
+}
+
+func TestTemplateField(t *testing.T) {
+	rcv := config.Receiver{
+		Name: "team-X",
+		SlackConfigs: []*config.SlackConfig{
+			{Title: `{{ .CommonLabels.alertname }}`, Text: "static"},
+		},
+		EmailConfigs: []*config.EmailConfig{
+			{HTML: `<b>{{ .Status }}</b>`, Headers: map[string]string{"Subject": "{{ .Status }}"}},
+		},
+	}
+
+	for _, tc := range []struct {
+		field string
+		text  string
+		html  bool
+	}{
+		{field: "slack_configs[0].title", text: `{{ .CommonLabels.alertname }}`},
+		{field: "slack_configs[0].text", text: "static"},
+		{field: "email_configs[0].html", text: `<b>{{ .Status }}</b>`, html: true},
+		{field: "email_configs[0].headers.Subject", text: "{{ .Status }}"},
+	} {
+		text, html, err := TemplateField(rcv, tc.field)
+		require.NoError(t, err, tc.field)
+		require.Equal(t, tc.text, text, tc.field)
+		require.Equal(t, tc.html, html, tc.field)
+	}
+
+	_, _, err := TemplateField(rcv, "slack_configs[1].title")
+	require.EqualError(t, err, `receiver "team-X" has no field slack_configs[1].title`)
+}
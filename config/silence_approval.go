@@ -0,0 +1,78 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/prometheus/alertmanager/pkg/labels"
+)
+
+// SilenceApprovalRule requires the silences that may mute alerts matching
+// its matchers to be approved by someone other than their creator before
+// they take effect.
+type SilenceApprovalRule struct {
+	// Matchers select the alerts whose silences require approval.
+	Matchers Matchers `yaml:"matchers" json:"matchers"`
+	// Approvers restricts who may approve or reject the silences. If empty,
+	// anyone but the creator of a silence may approve it.
+	Approvers []string `yaml:"approvers,omitempty" json:"approvers,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for SilenceApprovalRule.
+func (r *SilenceApprovalRule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain SilenceApprovalRule
+	if err := unmarshal((*plain)(r)); err != nil {
+		return err
+	}
+	if len(r.Matchers) == 0 {
+		return fmt.Errorf("missing matchers in silence approval rule")
+	}
+	return nil
+}
+
+// AppliesTo returns true if a silence with the given matchers may mute
+// alerts matched by the rule. A silence is exempt from the rule only if one of
+// its matchers provably contradicts a matcher of the rule on the same label,
+// for instance env="staging" against env="production".
+func (r *SilenceApprovalRule) AppliesTo(silenceMatchers labels.Matchers) bool {
+	for _, rm := range r.Matchers {
+		for _, sm := range silenceMatchers {
+			if sm.Name != rm.Name {
+				continue
+			}
+			if rm.Type == labels.MatchEqual && !sm.Matches(rm.Value) {
+				return false
+			}
+			if sm.Type == labels.MatchEqual && !rm.Matches(sm.Value) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MayApprove returns true if approver is allowed to decide on silences
+// subject to the rule.
+func (r *SilenceApprovalRule) MayApprove(approver string) bool {
+	if len(r.Approvers) == 0 {
+		return true
+	}
+	for _, a := range r.Approvers {
+		if a == approver {
+			return true
+		}
+	}
+	return false
+}
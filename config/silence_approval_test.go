@@ -0,0 +1,93 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/pkg/labels"
+)
+
+func TestSilenceApprovalRule(t *testing.T) {
+	cfg, err := Load(topologyRoute + `
+silence_approval_rules:
+- matchers: ['env="production"', 'team=~"db|storage"']
+  approvers: [alice]
+`)
+	require.NoError(t, err)
+	require.Len(t, cfg.SilenceApprovalRules, 1)
+	r := cfg.SilenceApprovalRules[0]
+
+	require.True(t, r.MayApprove("alice"))
+	require.False(t, r.MayApprove("bob"))
+
+	mustMatcher := func(mt labels.MatchType, n, v string) *labels.Matcher {
+		m, err := labels.NewMatcher(mt, n, v)
+		require.NoError(t, err)
+		return m
+	}
+	for _, tc := range []struct {
+		name     string
+		matchers labels.Matchers
+		applies  bool
+	}{
+		{
+			name:     "matching silence",
+			matchers: labels.Matchers{mustMatcher(labels.MatchEqual, "env", "production"), mustMatcher(labels.MatchEqual, "team", "db")},
+			applies:  true,
+		},
+		{
+			name:     "silence not restricting the labels",
+			matchers: labels.Matchers{mustMatcher(labels.MatchEqual, "alertname", "HighLatency")},
+			applies:  true,
+		},
+		{
+			name:     "regular expression overlapping the rule",
+			matchers: labels.Matchers{mustMatcher(labels.MatchRegexp, "env", "prod.*")},
+			applies:  true,
+		},
+		{
+			name:     "other environment",
+			matchers: labels.Matchers{mustMatcher(labels.MatchEqual, "env", "staging")},
+			applies:  false,
+		},
+		{
+			name:     "regular expression excluding the rule",
+			matchers: labels.Matchers{mustMatcher(labels.MatchRegexp, "env", "staging|dev")},
+			applies:  false,
+		},
+		{
+			name:     "other team",
+			matchers: labels.Matchers{mustMatcher(labels.MatchEqual, "team", "frontend")},
+			applies:  false,
+		},
+		{
+			name:     "negative matcher excluding the rule",
+			matchers: labels.Matchers{mustMatcher(labels.MatchNotEqual, "env", "production")},
+			applies:  false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.applies, r.AppliesTo(tc.matchers))
+		})
+	}
+
+	_, err = Load(topologyRoute + `
+silence_approval_rules:
+- approvers: [alice]
+`)
+	require.EqualError(t, err, "missing matchers in silence approval rule")
+}
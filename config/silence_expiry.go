@@ -0,0 +1,41 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/prometheus/common/model"
+)
+
+// SilenceExpiryNotification configures the alerts emitted for active
+// silences that are about to expire.
+type SilenceExpiryNotification struct {
+	// NotifyBefore is how long before the end of a silence the alert fires.
+	NotifyBefore model.Duration `yaml:"notify_before" json:"notify_before"`
+	// Labels are added to the alerts, e.g. to route them.
+	Labels model.LabelSet `yaml:"labels,omitempty" json:"labels,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for SilenceExpiryNotification.
+func (n *SilenceExpiryNotification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain SilenceExpiryNotification
+	if err := unmarshal((*plain)(n)); err != nil {
+		return err
+	}
+	if n.NotifyBefore <= 0 {
+		return fmt.Errorf("notify_before of silence expiry notification must be greater than 0")
+	}
+	return n.Labels.Validate()
+}
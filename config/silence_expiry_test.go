@@ -0,0 +1,43 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSilenceExpiryNotification(t *testing.T) {
+	cfg, err := Load(topologyRoute + `
+silence_expiry_notification:
+  notify_before: 2h
+  labels:
+    severity: info
+`)
+	require.NoError(t, err)
+	require.Equal(t, &SilenceExpiryNotification{
+		NotifyBefore: model.Duration(2 * time.Hour),
+		Labels:       model.LabelSet{"severity": "info"},
+	}, cfg.SilenceExpiryNotification)
+
+	_, err = Load(topologyRoute + `
+silence_expiry_notification:
+  labels:
+    severity: info
+`)
+	require.EqualError(t, err, "notify_before of silence expiry notification must be greater than 0")
+}
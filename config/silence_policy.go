@@ -0,0 +1,105 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/pkg/labels"
+)
+
+// SilencePolicy restricts the silences that can be created or updated.
+type SilencePolicy struct {
+	// MaxDuration is the longest time a silence may remain in effect from
+	// the moment it is created or updated.
+	MaxDuration model.Duration `yaml:"max_duration,omitempty" json:"max_duration,omitempty"`
+	// RequiredLabelNames requires silences to have a matcher selecting
+	// specific values of at least one of these labels.
+	RequiredLabelNames model.LabelNames `yaml:"required_label_names,omitempty" json:"required_label_names,omitempty"`
+	// CommentPattern is a regular expression the comment of silences must
+	// match, e.g. to reference a ticket.
+	CommentPattern *Regexp `yaml:"comment_pattern,omitempty" json:"comment_pattern,omitempty"`
+	// MaxActivePerCreator is the maximum number of silences that have not
+	// expired yet a single creator may have.
+	MaxActivePerCreator int `yaml:"max_active_per_creator,omitempty" json:"max_active_per_creator,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for SilencePolicy.
+func (p *SilencePolicy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain SilencePolicy
+	if err := unmarshal((*plain)(p)); err != nil {
+		return err
+	}
+	if p.MaxDuration < 0 {
+		return fmt.Errorf("max_duration of silence policy must not be negative")
+	}
+	if p.MaxActivePerCreator < 0 {
+		return fmt.Errorf("max_active_per_creator of silence policy must not be negative")
+	}
+	return nil
+}
+
+// Check returns an error describing the first violation of the policy by a
+// silence with the given matchers, time range and comment at the given time.
+// The quota of silences per creator is not checked.
+func (p *SilencePolicy) Check(matchers labels.Matchers, startsAt, endsAt time.Time, comment string, now time.Time) error {
+	if p == nil {
+		return nil
+	}
+
+	if p.MaxDuration > 0 {
+		if startsAt.Before(now) {
+			startsAt = now
+		}
+		if d := endsAt.Sub(startsAt); d > time.Duration(p.MaxDuration) {
+			return fmt.Errorf("silence duration of %s exceeds the maximum of %s allowed by the silence policy",
+				model.Duration(d.Round(time.Second)), p.MaxDuration)
+		}
+	}
+
+	if len(p.RequiredLabelNames) > 0 && !hasSelectiveMatcher(matchers, p.RequiredLabelNames) {
+		return fmt.Errorf("silence must select specific values of at least one of the labels [%s] required by the silence policy",
+			p.RequiredLabelNames)
+	}
+
+	if p.CommentPattern != nil && !p.CommentPattern.MatchString(comment) {
+		return fmt.Errorf("silence comment must match the pattern %q required by the silence policy",
+			p.CommentPattern.original)
+	}
+
+	return nil
+}
+
+// hasSelectiveMatcher returns true if one of the matchers is an equality or
+// regular expression matcher on one of the label names that does not match
+// the empty string.
+func hasSelectiveMatcher(matchers labels.Matchers, names model.LabelNames) bool {
+	for _, m := range matchers {
+		if m.Type != labels.MatchEqual && m.Type != labels.MatchRegexp {
+			continue
+		}
+		if m.Matches("") {
+			continue
+		}
+		for _, n := range names {
+			if m.Name == string(n) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/pkg/labels"
+)
+
+func TestSilencePolicyCheck(t *testing.T) {
+	cfg, err := Load(topologyRoute + `
+silence_policy:
+  max_duration: 7d
+  required_label_names: [service, alertname]
+  comment_pattern: '.*JIRA-[0-9]+.*'
+  max_active_per_creator: 3
+`)
+	require.NoError(t, err)
+	p := cfg.SilencePolicy
+	require.Equal(t, 3, p.MaxActivePerCreator)
+
+	mustMatcher := func(mt labels.MatchType, n, v string) *labels.Matcher {
+		m, err := labels.NewMatcher(mt, n, v)
+		require.NoError(t, err)
+		return m
+	}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	service := labels.Matchers{mustMatcher(labels.MatchEqual, "service", "api")}
+
+	for _, tc := range []struct {
+		name       string
+		matchers   labels.Matchers
+		start, end time.Time
+		comment    string
+		err        string
+	}{
+		{
+			name:     "valid silence",
+			matchers: service,
+			start:    now,
+			end:      now.Add(7 * 24 * time.Hour),
+			comment:  "Upgrade, see JIRA-123",
+		},
+		{
+			name:     "start time in the past",
+			matchers: service,
+			start:    now.Add(-30 * 24 * time.Hour),
+			end:      now.Add(24 * time.Hour),
+			comment:  "JIRA-123",
+		},
+		{
+			name:     "too long",
+			matchers: service,
+			start:    now.Add(time.Hour),
+			end:      now.Add(30 * 24 * time.Hour),
+			comment:  "JIRA-123",
+			err:      "silence duration of 29d23h exceeds the maximum of 1w allowed by the silence policy",
+		},
+		{
+			name:     "no required label",
+			matchers: labels.Matchers{mustMatcher(labels.MatchEqual, "instance", "a")},
+			start:    now,
+			end:      now.Add(time.Hour),
+			comment:  "JIRA-123",
+			err:      "silence must select specific values of at least one of the labels [service, alertname] required by the silence policy",
+		},
+		{
+			name:     "required label matching the empty string",
+			matchers: labels.Matchers{mustMatcher(labels.MatchRegexp, "service", ".*")},
+			start:    now,
+			end:      now.Add(time.Hour),
+			comment:  "JIRA-123",
+			err:      "silence must select specific values of at least one of the labels [service, alertname] required by the silence policy",
+		},
+		{
+			name:     "negative matcher on required label",
+			matchers: labels.Matchers{mustMatcher(labels.MatchNotEqual, "alertname", "Watchdog")},
+			start:    now,
+			end:      now.Add(time.Hour),
+			comment:  "JIRA-123",
+			err:      "silence must select specific values of at least one of the labels [service, alertname] required by the silence policy",
+		},
+		{
+			name:     "missing ticket",
+			matchers: service,
+			start:    now,
+			end:      now.Add(time.Hour),
+			comment:  "Upgrade",
+			err:      `silence comment must match the pattern ".*JIRA-[0-9]+.*" required by the silence policy`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := p.Check(tc.matchers, tc.start, tc.end, tc.comment, now)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.err)
+		})
+	}
+
+	var nilPolicy *SilencePolicy
+	require.NoError(t, nilPolicy.Check(nil, now, now.Add(1000*time.Hour), "", now))
+}
+
+func TestSilencePolicyValidation(t *testing.T) {
+	_, err := Load(topologyRoute + `
+silence_policy:
+  max_active_per_creator: -1
+`)
+	require.EqualError(t, err, "max_active_per_creator of silence policy must not be negative")
+
+	_, err = Load(topologyRoute + `
+silence_policy:
+  required_label_names: ['not-valid']
+`)
+	require.EqualError(t, err, `"not-valid" is not a valid label name`)
+}
@@ -0,0 +1,207 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/prometheus/common/model"
+)
+
+// Topology declares services and the services they depend on. Inhibition
+// rules are derived from it so that the alerts of a service are muted while
+// an alert for one of its direct or transitive dependencies is firing.
+type Topology struct {
+	// Services is the list of services making up the dependency graph.
+	Services []*TopologyService `yaml:"services,omitempty" json:"services,omitempty"`
+	// Equal is a set of label names that must have an equal value in the
+	// alert of a service and the alert of its dependency for the inhibition
+	// to take effect.
+	Equal model.LabelNames `yaml:"equal,omitempty" json:"equal,omitempty"`
+}
+
+// TopologyService is a node of the service dependency graph.
+type TopologyService struct {
+	// A unique identifier for this service.
+	Name string `yaml:"name" json:"name"`
+	// Matchers select the alerts that belong to the service.
+	Matchers Matchers `yaml:"matchers" json:"matchers"`
+	// DependsOn lists the names of the services this service depends on.
+	DependsOn []string `yaml:"depends_on,omitempty" json:"depends_on,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for TopologyService.
+func (s *TopologyService) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain TopologyService
+	if err := unmarshal((*plain)(s)); err != nil {
+		return err
+	}
+	if s.Name == "" {
+		return fmt.Errorf("missing name in topology service")
+	}
+	if len(s.Matchers) == 0 {
+		return fmt.Errorf("missing matchers in topology service %q", s.Name)
+	}
+	return nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for Topology.
+func (t *Topology) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain Topology
+	if err := unmarshal((*plain)(t)); err != nil {
+		return err
+	}
+
+	services := t.services()
+	for _, s := range t.Services {
+		if services[s.Name] != s {
+			return fmt.Errorf("topology service %q is not unique", s.Name)
+		}
+	}
+	for _, s := range t.Services {
+		for _, dep := range s.DependsOn {
+			if _, ok := services[dep]; !ok {
+				return fmt.Errorf("undefined service %q in dependencies of topology service %q", dep, s.Name)
+			}
+		}
+	}
+
+	return t.checkCycles(services)
+}
+
+func (t *Topology) services() map[string]*TopologyService {
+	services := make(map[string]*TopologyService, len(t.Services))
+	for _, s := range t.Services {
+		if _, ok := services[s.Name]; !ok {
+			services[s.Name] = s
+		}
+	}
+	return services
+}
+
+// checkCycles returns an error describing the first dependency cycle found
+// in the graph, if any.
+func (t *Topology) checkCycles(services map[string]*TopologyService) error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	var (
+		state = make(map[string]int, len(services))
+		path  []string
+		visit func(name string) error
+	)
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			for i, n := range path {
+				if n == name {
+					cycle := append(append([]string{}, path[i:]...), name)
+					return fmt.Errorf("dependency cycle in topology: %s", strings.Join(cycle, " -> "))
+				}
+			}
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range services[name].DependsOn {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	for _, s := range t.Services {
+		if err := visit(s.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InhibitRules derives the inhibition rules described by the topology. For
+// every service, one rule is returned per direct or transitive dependency,
+// with the dependency as the source and the service as the target. The rules
+// of a service are ordered so that a dependency comes before the services
+// depending on it. An inhibited alert is thus attributed to the root cause of
+// the outage when several of its dependencies are alerting at the same time.
+func (t *Topology) InhibitRules() []InhibitRule {
+	if t == nil {
+		return nil
+	}
+
+	var (
+		services = t.services()
+		rules    []InhibitRule
+		// depth is the length of the longest dependency chain below a
+		// service. Services without dependencies have a depth of zero.
+		depth    = make(map[string]int, len(services))
+		getDepth func(name string) int
+	)
+	getDepth = func(name string) int {
+		if d, ok := depth[name]; ok {
+			return d
+		}
+		d := 0
+		for _, dep := range services[name].DependsOn {
+			if dd := getDepth(dep) + 1; dd > d {
+				d = dd
+			}
+		}
+		depth[name] = d
+		return d
+	}
+
+	for _, s := range t.Services {
+		deps := map[string]struct{}{}
+		queue := append([]string{}, s.DependsOn...)
+		for len(queue) > 0 {
+			name := queue[0]
+			queue = queue[1:]
+			if _, ok := deps[name]; ok {
+				continue
+			}
+			deps[name] = struct{}{}
+			queue = append(queue, services[name].DependsOn...)
+		}
+
+		sorted := make([]string, 0, len(deps))
+		for dep := range deps {
+			sorted = append(sorted, dep)
+		}
+		sort.Slice(sorted, func(i, j int) bool {
+			di, dj := getDepth(sorted[i]), getDepth(sorted[j])
+			if di != dj {
+				return di < dj
+			}
+			return sorted[i] < sorted[j]
+		})
+
+		for _, dep := range sorted {
+			rules = append(rules, InhibitRule{
+				SourceMatchers: services[dep].Matchers,
+				TargetMatchers: s.Matchers,
+				Equal:          t.Equal,
+			})
+		}
+	}
+	return rules
+}
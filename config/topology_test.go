@@ -0,0 +1,160 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+const topologyRoute = `
+route:
+  receiver: team-X
+
+receivers:
+- name: team-X
+`
+
+func TestTopologyInhibitRules(t *testing.T) {
+	in := topologyRoute + `
+topology:
+  equal: [cluster]
+  services:
+  - name: frontend
+    matchers: ['service="frontend"']
+    depends_on: [backend]
+  - name: backend
+    matchers: ['service="backend"']
+    depends_on: [database, cache]
+  - name: cache
+    matchers: ['service="cache"']
+    depends_on: [database]
+  - name: database
+    matchers: ['service="database"']
+`
+	cfg, err := Load(in)
+	require.NoError(t, err)
+
+	type rule struct{ source, target string }
+	var rules []rule
+	for _, r := range cfg.Topology.InhibitRules() {
+		require.Equal(t, model.LabelNames{"cluster"}, r.Equal)
+		require.Len(t, r.SourceMatchers, 1)
+		require.Len(t, r.TargetMatchers, 1)
+		rules = append(rules, rule{r.SourceMatchers[0].Value, r.TargetMatchers[0].Value})
+	}
+
+	// The rules of a service are ordered so that a dependency comes before
+	// the services depending on it.
+	require.Equal(t, []rule{
+		{"database", "frontend"},
+		{"cache", "frontend"},
+		{"backend", "frontend"},
+		{"database", "backend"},
+		{"cache", "backend"},
+		{"database", "cache"},
+	}, rules)
+}
+
+func TestTopologyNilInhibitRules(t *testing.T) {
+	cfg, err := Load(topologyRoute)
+	require.NoError(t, err)
+	require.Nil(t, cfg.Topology)
+	require.Empty(t, cfg.Topology.InhibitRules())
+}
+
+func TestTopologyValidation(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		in       string
+		expected string
+	}{
+		{
+			name: "missing name",
+			in: `
+topology:
+  services:
+  - matchers: ['service="a"']
+`,
+			expected: "missing name in topology service",
+		},
+		{
+			name: "missing matchers",
+			in: `
+topology:
+  services:
+  - name: a
+`,
+			expected: `missing matchers in topology service "a"`,
+		},
+		{
+			name: "duplicate service",
+			in: `
+topology:
+  services:
+  - name: a
+    matchers: ['service="a"']
+  - name: a
+    matchers: ['service="b"']
+`,
+			expected: `topology service "a" is not unique`,
+		},
+		{
+			name: "undefined dependency",
+			in: `
+topology:
+  services:
+  - name: a
+    matchers: ['service="a"']
+    depends_on: [b]
+`,
+			expected: `undefined service "b" in dependencies of topology service "a"`,
+		},
+		{
+			name: "self dependency",
+			in: `
+topology:
+  services:
+  - name: a
+    matchers: ['service="a"']
+    depends_on: [a]
+`,
+			expected: "dependency cycle in topology: a -> a",
+		},
+		{
+			name: "transitive cycle",
+			in: `
+topology:
+  services:
+  - name: a
+    matchers: ['service="a"']
+    depends_on: [b]
+  - name: b
+    matchers: ['service="b"']
+    depends_on: [c]
+  - name: c
+    matchers: ['service="c"']
+    depends_on: [a]
+`,
+			expected: "dependency cycle in topology: a -> b -> c -> a",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Load(topologyRoute + tc.in)
+			require.EqualError(t, err, tc.expected)
+		})
+	}
+}
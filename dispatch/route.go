@@ -88,6 +88,9 @@ func NewRoute(cr *config.Route, parent *Route) *Route {
 	if cr.RepeatInterval != nil {
 		opts.RepeatInterval = time.Duration(*cr.RepeatInterval)
 	}
+	if cr.SuppressAcknowledgedRepeats != nil {
+		opts.SuppressAcknowledgedRepeats = *cr.SuppressAcknowledgedRepeats
+	}
 
 	// Build matchers.
 	var matchers labels.Matchers
@@ -234,6 +237,10 @@ type RouteOpts struct {
 
 	// A list of time intervals for which the route is active.
 	ActiveTimeIntervals []string
+
+	// Do not repeat notifications for groups whose firing alerts have all
+	// been acknowledged.
+	SuppressAcknowledgedRepeats bool
 }
 
 func (ro *RouteOpts) String() string {
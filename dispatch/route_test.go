@@ -383,6 +383,39 @@ routes:
 	require.False(t, child2.RouteOpts.GroupByAll)
 }
 
+func TestInheritParentSuppressAcknowledgedRepeats(t *testing.T) {
+	in := `
+routes:
+- match:
+    env: 'parent'
+  suppress_acknowledged_repeats: true
+
+  routes:
+  - match:
+      env: 'child1'
+
+  - match:
+      env: 'child2'
+    suppress_acknowledged_repeats: false
+
+- match:
+    env: 'other'
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+
+	tree := NewRoute(&ctree, nil)
+	parent := tree.Routes[0]
+	require.False(t, tree.RouteOpts.SuppressAcknowledgedRepeats)
+	require.True(t, parent.RouteOpts.SuppressAcknowledgedRepeats)
+	require.True(t, parent.Routes[0].RouteOpts.SuppressAcknowledgedRepeats)
+	require.False(t, parent.Routes[1].RouteOpts.SuppressAcknowledgedRepeats)
+	require.False(t, tree.Routes[1].RouteOpts.SuppressAcknowledgedRepeats)
+}
+
 func TestRouteMatchers(t *testing.T) {
 	in := `
 receiver: 'notify-def'
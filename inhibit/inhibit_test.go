@@ -20,6 +20,7 @@ import (
 	"github.com/go-kit/log"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
 
 	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/pkg/labels"
@@ -477,3 +478,58 @@ func TestInhibit(t *testing.T) {
 		}
 	}
 }
+
+func TestInhibitTopologyRootCause(t *testing.T) {
+	t.Parallel()
+
+	service := func(name string, deps ...string) *config.TopologyService {
+		return &config.TopologyService{
+			Name:      name,
+			Matchers:  config.Matchers{&labels.Matcher{Type: labels.MatchEqual, Name: "service", Value: name}},
+			DependsOn: deps,
+		}
+	}
+	topology := &config.Topology{
+		Services: []*config.TopologyService{
+			service("frontend", "backend"),
+			service("backend", "database"),
+			service("database"),
+		},
+		Equal: model.LabelNames{"cluster"},
+	}
+
+	m := types.NewMarker(prometheus.NewRegistry())
+	ih := NewInhibitor(nil, topology.InhibitRules(), m, nopLogger)
+	now := time.Now()
+	newAlert := func(svc, cluster string) *types.Alert {
+		return &types.Alert{
+			Alert: model.Alert{
+				Labels:   model.LabelSet{"service": model.LabelValue(svc), "cluster": model.LabelValue(cluster)},
+				StartsAt: now.Add(-time.Minute),
+				EndsAt:   now.Add(time.Hour),
+			},
+		}
+	}
+	backend, database := newAlert("backend", "a"), newAlert("database", "a")
+	for _, a := range []*types.Alert{backend, database} {
+		for _, r := range ih.rules {
+			if r.SourceMatchers.Matches(a.Labels) {
+				r.scache.Set(a)
+			}
+		}
+	}
+
+	// The frontend alert is inhibited by the database alert, which is the
+	// root cause, rather than by the backend alert.
+	frontend := newAlert("frontend", "a").Labels
+	require.True(t, ih.Mutes(frontend))
+	require.Equal(t, []string{database.Fingerprint().String()}, m.Status(frontend.Fingerprint()).InhibitedBy)
+
+	require.True(t, ih.Mutes(backend.Labels))
+	require.Equal(t, []string{database.Fingerprint().String()}, m.Status(backend.Fingerprint()).InhibitedBy)
+
+	require.False(t, ih.Mutes(database.Labels))
+
+	// Dependencies only inhibit alerts with equal labels.
+	require.False(t, ih.Mutes(newAlert("frontend", "b").Labels))
+}
@@ -355,7 +355,9 @@ func TestQuery(t *testing.T) {
 	firingAlerts := []uint64{1, 2, 3}
 	resolvedAlerts := []uint64{4, 5}
 
-	err = nl.Log(recv, "key", firingAlerts, resolvedAlerts, 0)
+	firstTimestamp := time.Now().Add(-time.Hour).UTC()
+
+	err = nl.Log(recv, "key", firingAlerts, resolvedAlerts, firstTimestamp, 0)
 	require.NoError(t, err, "logging notification failed")
 
 	entries, err := nl.Query(QGroupKey("key"), QReceiver(recv))
@@ -363,6 +365,7 @@ func TestQuery(t *testing.T) {
 	entry := entries[0]
 	require.EqualValues(t, firingAlerts, entry.FiringAlerts)
 	require.EqualValues(t, resolvedAlerts, entry.ResolvedAlerts)
+	require.True(t, firstTimestamp.Equal(entry.FirstTimestamp))
 }
 
 func TestStateDecodingError(t *testing.T) {
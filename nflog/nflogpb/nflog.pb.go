@@ -92,10 +92,13 @@ type Entry struct {
 	// FiringAlerts list of hashes of firing alerts at the last notification time.
 	FiringAlerts []uint64 `protobuf:"varint,6,rep,packed,name=firing_alerts,json=firingAlerts,proto3" json:"firing_alerts,omitempty"`
 	// ResolvedAlerts list of hashes of resolved alerts at the last notification time.
-	ResolvedAlerts       []uint64 `protobuf:"varint,7,rep,packed,name=resolved_alerts,json=resolvedAlerts,proto3" json:"resolved_alerts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ResolvedAlerts []uint64 `protobuf:"varint,7,rep,packed,name=resolved_alerts,json=resolvedAlerts,proto3" json:"resolved_alerts,omitempty"`
+	// Timestamp of the first notification sent for the group since it last
+	// had no firing alerts. Notifiers use it to thread related notifications.
+	FirstTimestamp       time.Time `protobuf:"bytes,8,opt,name=first_timestamp,json=firstTimestamp,proto3,stdtime" json:"first_timestamp"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *Entry) Reset()         { *m = Entry{} }
@@ -186,32 +189,32 @@ func init() {
 func init() { proto.RegisterFile("nflog.proto", fileDescriptor_c2d9785ad9c3e602) }
 
 var fileDescriptor_c2d9785ad9c3e602 = []byte{
-	// 385 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xbb, 0x4d, 0xd3, 0xda, 0xe3, 0xb4, 0x94, 0x15, 0x07, 0xcb, 0x08, 0xc7, 0x0a, 0x48,
-	0xf8, 0x82, 0x23, 0x95, 0x27, 0x68, 0x10, 0x12, 0x12, 0x82, 0xc3, 0x8a, 0x2b, 0xb2, 0x36, 0x74,
-	0xb2, 0x5e, 0x61, 0x7b, 0xad, 0xf5, 0x36, 0x6a, 0xde, 0x82, 0x47, 0xe0, 0x71, 0x72, 0xe4, 0x09,
-	0xf8, 0x93, 0x27, 0x41, 0xde, 0xb5, 0x1d, 0x8e, 0xdc, 0x66, 0x7f, 0xf3, 0xcd, 0xcc, 0xb7, 0x1f,
-	0x04, 0xf5, 0xa6, 0x54, 0x22, 0x6b, 0xb4, 0x32, 0x8a, 0x5e, 0xd8, 0x47, 0xb3, 0x8e, 0xe6, 0x42,
-	0x29, 0x51, 0xe2, 0xd2, 0xe2, 0xf5, 0xfd, 0x66, 0x69, 0x64, 0x85, 0xad, 0xe1, 0x55, 0xe3, 0x94,
-	0xd1, 0x13, 0xa1, 0x84, 0xb2, 0xe5, 0xb2, 0xab, 0x1c, 0x5d, 0x7c, 0x06, 0x8f, 0xe1, 0x17, 0x94,
-	0x5b, 0xd4, 0xf4, 0x19, 0x80, 0xd0, 0xea, 0xbe, 0xc9, 0x6b, 0x5e, 0x61, 0x48, 0x12, 0x92, 0xfa,
-	0xcc, 0xb7, 0xe4, 0x23, 0xaf, 0x90, 0x26, 0x10, 0xc8, 0xda, 0xa0, 0xd0, 0xdc, 0x48, 0x55, 0x87,
-	0xa7, 0xb6, 0xff, 0x2f, 0xa2, 0xd7, 0x30, 0x91, 0x77, 0x0f, 0xe1, 0x24, 0x21, 0xe9, 0x25, 0xeb,
-	0xca, 0xc5, 0xf7, 0x53, 0x98, 0xbe, 0xad, 0x8d, 0xde, 0xd1, 0xa7, 0xe0, 0x56, 0xe5, 0x5f, 0x71,
-	0x67, 0x77, 0xcf, 0x98, 0x67, 0xc1, 0x7b, 0xdc, 0xd1, 0x57, 0xe0, 0xe9, 0xde, 0x85, 0xdd, 0x1b,
-	0xdc, 0x3c, 0xce, 0xfa, 0x8f, 0x65, 0x83, 0x3d, 0x36, 0x4a, 0x8e, 0x46, 0x0b, 0xde, 0x16, 0xf6,
-	0xdc, 0xac, 0x37, 0xfa, 0x8e, 0xb7, 0x05, 0x8d, 0xba, 0x6d, 0xad, 0x2a, 0xb7, 0x78, 0x17, 0x9e,
-	0x25, 0x24, 0xf5, 0xd8, 0xf8, 0xa6, 0x2b, 0xf0, 0xc7, 0x60, 0xc2, 0xa9, 0x3d, 0x15, 0x65, 0x2e,
-	0xba, 0x6c, 0x88, 0x2e, 0xfb, 0x34, 0x28, 0x56, 0xde, 0xfe, 0xe7, 0xfc, 0xe4, 0xdb, 0xaf, 0x39,
-	0x61, 0xc7, 0x31, 0xfa, 0x1c, 0x2e, 0x37, 0x52, 0xcb, 0x5a, 0xe4, 0xbc, 0x44, 0x6d, 0xda, 0xf0,
-	0x3c, 0x99, 0xa4, 0x67, 0x6c, 0xe6, 0xe0, 0xad, 0x65, 0xf4, 0x25, 0x3c, 0x1a, 0x8e, 0x0e, 0xb2,
-	0x0b, 0x2b, 0xbb, 0x1a, 0xb0, 0x13, 0x2e, 0xb6, 0xe0, 0x7f, 0xc0, 0xb6, 0x70, 0x29, 0xbd, 0x80,
-	0x29, 0x76, 0x85, 0x4d, 0x28, 0xb8, 0xb9, 0x1a, 0x53, 0xb0, 0x6d, 0xe6, 0x9a, 0xf4, 0x0d, 0x00,
-	0x3e, 0x34, 0x52, 0x63, 0x9b, 0x73, 0xd3, 0x07, 0xf6, 0x9f, 0xbf, 0xe8, 0xe7, 0x6e, 0xcd, 0xea,
-	0x7a, 0xff, 0x27, 0x3e, 0xd9, 0x1f, 0x62, 0xf2, 0xe3, 0x10, 0x93, 0xdf, 0x87, 0x98, 0xac, 0xcf,
-	0xed, 0xe8, 0xeb, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x49, 0xcd, 0xa7, 0x1e, 0x61, 0x02, 0x00,
-	0x00,
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0xcf, 0x6e, 0x13, 0x31,
+	0x10, 0xc6, 0xeb, 0xa4, 0x69, 0x77, 0x27, 0x69, 0x5a, 0x2c, 0x0e, 0xab, 0x20, 0x92, 0x55, 0x40,
+	0x22, 0x17, 0x36, 0x52, 0x79, 0x82, 0x06, 0x21, 0x21, 0xa1, 0x72, 0xb0, 0xb8, 0xa2, 0x95, 0x43,
+	0x27, 0x8e, 0x45, 0x76, 0xbd, 0xb2, 0xdd, 0xa8, 0x79, 0x0b, 0x1e, 0x2b, 0x47, 0x9e, 0x80, 0x3f,
+	0x79, 0x01, 0x5e, 0x01, 0xed, 0xec, 0x9f, 0x22, 0x71, 0xca, 0x6d, 0xfc, 0x9b, 0x6f, 0x66, 0x3e,
+	0x7f, 0xd0, 0xcf, 0x57, 0x1b, 0xa3, 0x92, 0xc2, 0x1a, 0x6f, 0xf8, 0x39, 0x3d, 0x8a, 0xe5, 0x68,
+	0xa2, 0x8c, 0x51, 0x1b, 0x9c, 0x13, 0x5e, 0xde, 0xaf, 0xe6, 0x5e, 0x67, 0xe8, 0xbc, 0xcc, 0x8a,
+	0x4a, 0x39, 0x7a, 0xaa, 0x8c, 0x32, 0x54, 0xce, 0xcb, 0xaa, 0xa2, 0xd3, 0xcf, 0x10, 0x08, 0xfc,
+	0x82, 0x7a, 0x8b, 0x96, 0x3f, 0x07, 0x50, 0xd6, 0xdc, 0x17, 0x69, 0x2e, 0x33, 0x8c, 0x58, 0xcc,
+	0x66, 0xa1, 0x08, 0x89, 0x7c, 0x94, 0x19, 0xf2, 0x18, 0xfa, 0x3a, 0xf7, 0xa8, 0xac, 0xf4, 0xda,
+	0xe4, 0x51, 0x87, 0xfa, 0xff, 0x22, 0x7e, 0x05, 0x5d, 0x7d, 0xf7, 0x10, 0x75, 0x63, 0x36, 0xbb,
+	0x10, 0x65, 0x39, 0xfd, 0xd3, 0x81, 0xde, 0xbb, 0xdc, 0xdb, 0x1d, 0x7f, 0x06, 0xd5, 0xaa, 0xf4,
+	0x2b, 0xee, 0x68, 0xf7, 0x40, 0x04, 0x04, 0x3e, 0xe0, 0x8e, 0xbf, 0x86, 0xc0, 0xd6, 0x2e, 0x68,
+	0x6f, 0xff, 0xfa, 0x49, 0x52, 0x7f, 0x2c, 0x69, 0xec, 0x89, 0xc0, 0xfe, 0x67, 0x74, 0x2d, 0xdd,
+	0x9a, 0xce, 0x0d, 0x6a, 0xa3, 0xef, 0xa5, 0x5b, 0xf3, 0x51, 0xb9, 0xcd, 0x99, 0xcd, 0x16, 0xef,
+	0xa2, 0xd3, 0x98, 0xcd, 0x02, 0xd1, 0xbe, 0xf9, 0x02, 0xc2, 0x36, 0x98, 0xa8, 0x47, 0xa7, 0x46,
+	0x49, 0x15, 0x5d, 0xd2, 0x44, 0x97, 0x7c, 0x6a, 0x14, 0x8b, 0x60, 0xff, 0x63, 0x72, 0xf2, 0xed,
+	0xe7, 0x84, 0x89, 0xc7, 0x31, 0xfe, 0x02, 0x2e, 0x56, 0xda, 0xea, 0x5c, 0xa5, 0x72, 0x83, 0xd6,
+	0xbb, 0xe8, 0x2c, 0xee, 0xce, 0x4e, 0xc5, 0xa0, 0x82, 0x37, 0xc4, 0xf8, 0x2b, 0xb8, 0x6c, 0x8e,
+	0x36, 0xb2, 0x73, 0x92, 0x0d, 0x1b, 0x5c, 0x0b, 0x6f, 0xe1, 0x72, 0xa5, 0xad, 0xf3, 0xe9, 0xa3,
+	0xaf, 0xe0, 0x08, 0x5f, 0x43, 0x1a, 0x6e, 0x3b, 0xd3, 0x2d, 0x84, 0xb7, 0xe8, 0xd6, 0x55, 0xe8,
+	0x2f, 0xa1, 0x87, 0x65, 0x41, 0x81, 0xf7, 0xaf, 0x87, 0x6d, 0xa8, 0xd4, 0x16, 0x55, 0x93, 0xbf,
+	0x05, 0xc0, 0x87, 0x42, 0x5b, 0x74, 0xa9, 0xf4, 0x51, 0xe7, 0x88, 0xe3, 0x61, 0x3d, 0x77, 0xe3,
+	0x17, 0x57, 0xfb, 0xdf, 0xe3, 0x93, 0xfd, 0x61, 0xcc, 0xbe, 0x1f, 0xc6, 0xec, 0xd7, 0x61, 0xcc,
+	0x96, 0x67, 0x34, 0xfa, 0xe6, 0xef, 0x00, 0x57, 0x8e, 0x24, 0x0b, 0xb0, 0x02, 0x00, 0x00,
 }
 
 func (m *Receiver) Marshal() (dAtA []byte, err error) {
@@ -284,48 +287,56 @@ func (m *Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FirstTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FirstTimestamp):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintNflog(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
 	if len(m.ResolvedAlerts) > 0 {
-		dAtA2 := make([]byte, len(m.ResolvedAlerts)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.ResolvedAlerts)*10)
+		var j2 int
 		for _, num := range m.ResolvedAlerts {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintNflog(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintNflog(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x3a
 	}
 	if len(m.FiringAlerts) > 0 {
-		dAtA4 := make([]byte, len(m.FiringAlerts)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.FiringAlerts)*10)
+		var j4 int
 		for _, num := range m.FiringAlerts {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintNflog(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintNflog(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x32
 	}
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err5 != nil {
-		return 0, err5
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n5
-	i = encodeVarintNflog(dAtA, i, uint64(n5))
+	i -= n6
+	i = encodeVarintNflog(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x2a
 	if m.Resolved {
@@ -391,12 +402,12 @@ func (m *MeshEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
-	if err7 != nil {
-		return 0, err7
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n7
-	i = encodeVarintNflog(dAtA, i, uint64(n7))
+	i -= n8
+	i = encodeVarintNflog(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x12
 	if m.Entry != nil {
@@ -485,6 +496,8 @@ func (m *Entry) Size() (n int) {
 		}
 		n += 1 + sovNflog(uint64(l)) + l
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FirstTimestamp)
+	n += 1 + l + sovNflog(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -987,6 +1000,39 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAlerts", wireType)
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNflog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNflog
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNflog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FirstTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNflog(dAtA[iNdEx:])
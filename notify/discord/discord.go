@@ -95,10 +95,11 @@ func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error)
 
 	alerts := types.Alerts(as...)
 	data := notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
-	tmpl := notify.TmplText(n.tmpl, data, &err)
+	tmpl := notify.TmplMarkdown(n.tmpl, data, &err, n.conf.Markup, template.MarkupPlain)
 	if err != nil {
 		return false, err
 	}
+	tmplMessage := notify.TmplMarkdown(n.tmpl, data, &err, n.conf.Markup, template.MarkupDiscord)
 
 	title, truncated := notify.TruncateInRunes(tmpl(n.conf.Title), maxTitleLenRunes)
 	if err != nil {
@@ -107,7 +108,7 @@ func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error)
 	if truncated {
 		level.Warn(n.logger).Log("msg", "Truncated title", "key", key, "max_runes", maxTitleLenRunes)
 	}
-	description, truncated := notify.TruncateInRunes(tmpl(n.conf.Message), maxDescriptionLenRunes)
+	description, truncated := notify.TruncateInRunes(tmplMessage(n.conf.Message), maxDescriptionLenRunes)
 	if err != nil {
 		return false, err
 	}
@@ -32,6 +32,7 @@ import (
 	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/notify"
 	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/template"
 	"github.com/prometheus/alertmanager/types"
 )
 
@@ -168,3 +169,56 @@ func TestDiscordReadingURLFromFile(t *testing.T) {
 
 	test.AssertNotifyLeaksNoSecret(ctx, t, notifier, u.String())
 }
+
+func TestDiscordMarkdown(t *testing.T) {
+	var got webhook
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer srv.Close()
+	u, _ := url.Parse(srv.URL)
+
+	tmpl, err := template.New()
+	require.NoError(t, err)
+	tmpl.ExternalURL = u
+
+	for _, tc := range []struct {
+		markup      string
+		title       string
+		description string
+	}{
+		{
+			markup:      "",
+			title:       "**firing** val_1",
+			description: "**firing** val_1",
+		},
+		{
+			markup:      config.MarkupMarkdown,
+			title:       "firing val_1",
+			description: "**firing** val\\_1",
+		},
+	} {
+		t.Run(tc.markup, func(t *testing.T) {
+			n, err := New(&config.DiscordConfig{
+				HTTPConfig: &commoncfg.HTTPClientConfig{},
+				WebhookURL: &config.SecretURL{URL: u},
+				Title:      `**{{ .Status }}** {{ .CommonLabels.lbl1 }}`,
+				Message:    `**{{ .Status }}** {{ .CommonLabels.lbl1 }}`,
+				Markup:     tc.markup,
+			}, tmpl, log.NewNopLogger())
+			require.NoError(t, err)
+
+			ctx := notify.WithGroupKey(context.Background(), "1")
+			_, err = n.Notify(ctx, &types.Alert{
+				Alert: model.Alert{
+					Labels:   model.LabelSet{"lbl1": "val_1"},
+					StartsAt: time.Now(),
+					EndsAt:   time.Now().Add(time.Hour),
+				},
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.title, got.Embeds[0].Title)
+			require.Equal(t, tc.description, got.Embeds[0].Description)
+		})
+	}
+}
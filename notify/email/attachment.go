@@ -0,0 +1,258 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kit/log/level"
+
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+)
+
+// attachmentFetchTimeout bounds the time spent fetching an attachment from a
+// URL.
+const attachmentFetchTimeout = 30 * time.Second
+
+// attachment is a rendered attachment ready to be added to a message.
+type attachment struct {
+	filename    string
+	contentType string
+	contentID   string
+	inline      bool
+	content     []byte
+}
+
+// renderAttachments renders the configured attachments. Attachments larger
+// than their size limit are dropped with a warning rather than failing the
+// notification. The returned boolean reports whether a failure is
+// recoverable.
+func (n *Email) renderAttachments(ctx context.Context, data *template.Data) ([]*attachment, bool, error) {
+	var (
+		tmplErr     error
+		tmpl        = notify.TmplText(n.tmpl, data, &tmplErr)
+		attachments []*attachment
+	)
+	for _, a := range n.conf.Attachments {
+		filename := tmpl(a.Filename)
+		if tmplErr != nil {
+			return nil, false, fmt.Errorf("execute attachment filename template: %w", tmplErr)
+		}
+
+		var (
+			content     []byte
+			contentType = a.ContentType
+			err         error
+		)
+		switch {
+		case a.Content != "":
+			content = []byte(tmpl(a.Content))
+			if tmplErr != nil {
+				return nil, false, fmt.Errorf("execute %q attachment template: %w", filename, tmplErr)
+			}
+		case a.Alerts == "csv":
+			content, err = alertsCSV(data.Alerts)
+			if contentType == "" {
+				contentType = "text/csv; charset=utf-8"
+			}
+		case a.Alerts == "json":
+			content, err = json.Marshal(data.Alerts)
+			if contentType == "" {
+				contentType = "application/json"
+			}
+		case a.File != "":
+			content, err = readLimited(a.File, a.MaxSize)
+		case a.URL != "":
+			url := tmpl(a.URL)
+			if tmplErr != nil {
+				return nil, false, fmt.Errorf("execute %q attachment url template: %w", filename, tmplErr)
+			}
+			content, err = fetchLimited(ctx, n.client, url, a.MaxSize)
+			if err != nil {
+				return nil, true, fmt.Errorf("fetch %q attachment: %w", filename, notify.RedactURL(err))
+			}
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("render %q attachment: %w", filename, err)
+		}
+
+		if int64(len(content)) > a.MaxSize {
+			level.Warn(n.logger).Log("msg", "Dropping attachment exceeding its size limit", "filename", filename, "max_size", a.MaxSize)
+			continue
+		}
+		if contentType == "" {
+			contentType = mime.TypeByExtension(filepath.Ext(filename))
+		}
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		attachments = append(attachments, &attachment{
+			filename:    filename,
+			contentType: contentType,
+			contentID:   a.ContentID,
+			inline:      a.Inline,
+			content:     content,
+		})
+	}
+	return attachments, false, nil
+}
+
+// alertsCSV returns a CSV dump of the alerts with one alert per row.
+func alertsCSV(alerts template.Alerts) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"status", "fingerprint", "starts_at", "ends_at", "generator_url", "labels", "annotations"}); err != nil {
+		return nil, err
+	}
+	for _, a := range alerts {
+		var endsAt string
+		if !a.EndsAt.IsZero() {
+			endsAt = a.EndsAt.Format(time.RFC3339)
+		}
+		if err := w.Write([]string{
+			a.Status,
+			a.Fingerprint,
+			a.StartsAt.Format(time.RFC3339),
+			endsAt,
+			a.GeneratorURL,
+			formatKV(a.Labels),
+			formatKV(a.Annotations),
+		}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// formatKV formats key/value pairs sorted by key.
+func formatKV(kv template.KV) string {
+	keys := make([]string, 0, len(kv))
+	for k := range kv {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", k, kv[k]))
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// readLimited reads at most one byte more than limit from the file, which is
+// enough to tell that it exceeds the limit.
+func readLimited(path string, limit int64) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(io.LimitReader(f, limit+1))
+}
+
+// fetchLimited downloads at most one byte more than limit from the URL.
+func fetchLimited(ctx context.Context, client *http.Client, url string, limit int64) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, attachmentFetchTimeout)
+	defer cancel()
+	resp, err := notify.Get(ctx, client, url)
+	if err != nil {
+		return nil, err
+	}
+	defer notify.Drain(resp)
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, limit+1))
+}
+
+// wrapMultipart nests the body of the given content type as the first part of
+// a new multipart body of the given subtype, followed by the attachments. It
+// returns the content type and the new body.
+func wrapMultipart(subtype, contentType string, body []byte, attachments []*attachment) (string, []byte, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	part, err := w.CreatePart(textproto.MIMEHeader{"Content-Type": {contentType}})
+	if err != nil {
+		return "", nil, err
+	}
+	if _, err := part.Write(body); err != nil {
+		return "", nil, err
+	}
+
+	for _, a := range attachments {
+		disposition := "attachment"
+		if a.inline {
+			disposition = "inline"
+		}
+		header := textproto.MIMEHeader{
+			"Content-Type":              {a.contentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType(disposition, map[string]string{"filename": a.filename})},
+		}
+		if a.inline {
+			header.Set("Content-Id", "<"+a.contentID+">")
+		}
+		part, err := w.CreatePart(header)
+		if err != nil {
+			return "", nil, err
+		}
+		if err := writeBase64(part, a.content); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return "", nil, err
+	}
+
+	params := map[string]string{"boundary": w.Boundary()}
+	if subtype == "related" {
+		params["type"] = strings.SplitN(contentType, ";", 2)[0]
+	}
+	return mime.FormatMediaType("multipart/"+subtype, params), buf.Bytes(), nil
+}
+
+// writeBase64 writes the base64 encoding of b in lines of 76 characters as
+// required by RFC 2045.
+func writeBase64(w io.Writer, b []byte) error {
+	const lineLen = 76
+	enc := base64.StdEncoding.EncodeToString(b)
+	for len(enc) > 0 {
+		n := lineLen
+		if len(enc) < n {
+			n = len(enc)
+		}
+		if _, err := io.WriteString(w, enc[:n]+"\r\n"); err != nil {
+			return err
+		}
+		enc = enc[n:]
+	}
+	return nil
+}
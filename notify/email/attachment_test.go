@@ -0,0 +1,205 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	commoncfg "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func testTemplateData(t *testing.T) (*template.Template, *template.Data) {
+	tmpl, err := template.New()
+	require.NoError(t, err)
+	tmpl.ExternalURL, _ = url.Parse("http://am")
+
+	alerts := []*types.Alert{
+		{
+			Alert: model.Alert{
+				Labels:      model.LabelSet{"alertname": "HighLatency", "instance": "a"},
+				Annotations: model.LabelSet{"summary": "latency is high, really"},
+				StartsAt:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+	return tmpl, tmpl.Data("receiver", model.LabelSet{"alertname": "HighLatency"}, alerts...)
+}
+
+func TestRenderAttachments(t *testing.T) {
+	tmpl, data := testTemplateData(t)
+
+	image := filepath.Join(t.TempDir(), "logo.png")
+	require.NoError(t, os.WriteFile(image, []byte("png"), 0o600))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "HighLatency", r.URL.Query().Get("alert"))
+		user, pass, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "user", user)
+		require.Equal(t, "pass", pass)
+		w.Write([]byte("graph"))
+	}))
+	defer srv.Close()
+
+	n, err := New(&config.EmailConfig{
+		Headers: map[string]string{},
+		HTTPConfig: &commoncfg.HTTPClientConfig{
+			BasicAuth: &commoncfg.BasicAuth{Username: "user", Password: "pass"},
+		},
+		Attachments: []*config.EmailAttachment{
+			{Filename: "{{ .CommonLabels.alertname }}.txt", Content: "{{ len .Alerts }} alerts", MaxSize: 100},
+			{Filename: "alerts.csv", Alerts: "csv", MaxSize: 1000},
+			{Filename: "alerts.json", Alerts: "json", MaxSize: 1000},
+			{Filename: "logo.png", File: image, Inline: true, ContentID: "logo", MaxSize: 100},
+			{Filename: "graph.png", URL: srv.URL + "?alert={{ .CommonLabels.alertname }}", Inline: true, ContentID: "graph", MaxSize: 100},
+			{Filename: "large.txt", Content: "too large", MaxSize: 3},
+		},
+	}, tmpl, log.NewNopLogger())
+	require.NoError(t, err)
+
+	attachments, _, err := n.renderAttachments(context.Background(), data)
+	require.NoError(t, err)
+	require.Len(t, attachments, 5)
+
+	require.Equal(t, "HighLatency.txt", attachments[0].filename)
+	require.Equal(t, "text/plain; charset=utf-8", attachments[0].contentType)
+	require.Equal(t, "1 alerts", string(attachments[0].content))
+
+	require.Equal(t, "text/csv; charset=utf-8", attachments[1].contentType)
+	records, err := csv.NewReader(bytes.NewReader(attachments[1].content)).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"status", "fingerprint", "starts_at", "ends_at", "generator_url", "labels", "annotations"},
+		{"firing", data.Alerts[0].Fingerprint, "2024-01-01T00:00:00Z", "", "", `alertname="HighLatency", instance="a"`, `summary="latency is high, really"`},
+	}, records)
+
+	require.Equal(t, "application/json", attachments[2].contentType)
+	var alerts template.Alerts
+	require.NoError(t, json.Unmarshal(attachments[2].content, &alerts))
+	require.Equal(t, data.Alerts[0].Labels, alerts[0].Labels)
+
+	require.Equal(t, "image/png", attachments[3].contentType)
+	require.Equal(t, "png", string(attachments[3].content))
+	require.True(t, attachments[3].inline)
+	require.Equal(t, "logo", attachments[3].contentID)
+
+	require.Equal(t, "graph", string(attachments[4].content))
+}
+
+func TestRenderAttachmentsErrors(t *testing.T) {
+	tmpl, data := testTemplateData(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	for _, tc := range []struct {
+		name       string
+		attachment *config.EmailAttachment
+		retry      bool
+		err        string
+	}{
+		{
+			name:       "invalid template",
+			attachment: &config.EmailAttachment{Filename: "a.txt", Content: "{{ .Foo }}", MaxSize: 100},
+			err:        `execute "a.txt" attachment template`,
+		},
+		{
+			name:       "missing file",
+			attachment: &config.EmailAttachment{Filename: "a.txt", File: filepath.Join(t.TempDir(), "missing"), MaxSize: 100},
+			err:        `render "a.txt" attachment`,
+		},
+		{
+			name:       "failed download",
+			attachment: &config.EmailAttachment{Filename: "a.png", URL: srv.URL, MaxSize: 100},
+			retry:      true,
+			err:        `fetch "a.png" attachment: unexpected status code 500`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			n := &Email{
+				conf:   &config.EmailConfig{Attachments: []*config.EmailAttachment{tc.attachment}},
+				tmpl:   tmpl,
+				logger: log.NewNopLogger(),
+				client: http.DefaultClient,
+			}
+			_, retry, err := n.renderAttachments(context.Background(), data)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.err)
+			require.Equal(t, tc.retry, retry)
+		})
+	}
+}
+
+func TestWrapMultipart(t *testing.T) {
+	content := bytes.Repeat([]byte("x"), 100)
+	contentType, body, err := wrapMultipart("related", "text/html; charset=UTF-8", []byte("<img src=\"cid:logo\">"), []*attachment{
+		{filename: "logo.png", contentType: "image/png", contentID: "logo", inline: true, content: content},
+	})
+	require.NoError(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	require.NoError(t, err)
+	require.Equal(t, "multipart/related", mediaType)
+	require.Equal(t, "text/html", params["type"])
+
+	r := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	part, err := r.NextPart()
+	require.NoError(t, err)
+	require.Equal(t, "text/html; charset=UTF-8", part.Header.Get("Content-Type"))
+	b, err := io.ReadAll(part)
+	require.NoError(t, err)
+	require.Equal(t, "<img src=\"cid:logo\">", string(b))
+
+	part, err = r.NextPart()
+	require.NoError(t, err)
+	require.Equal(t, "<logo>", part.Header.Get("Content-Id"))
+	require.Equal(t, "inline; filename=logo.png", part.Header.Get("Content-Disposition"))
+	require.Equal(t, "logo.png", part.FileName())
+	// The multipart reader doesn't decode base64, check that lines are
+	// wrapped and decode them.
+	b, err = io.ReadAll(part)
+	require.NoError(t, err)
+	for _, line := range bytes.Split(bytes.TrimSpace(b), []byte("\r\n")) {
+		require.LessOrEqual(t, len(line), 76)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(string(bytes.ReplaceAll(b, []byte("\r\n"), nil)))
+	require.NoError(t, err)
+	require.Equal(t, content, decoded)
+
+	_, err = r.NextPart()
+	require.Equal(t, io.EOF, err)
+}
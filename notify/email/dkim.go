@@ -0,0 +1,181 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// dkimHeaders are the header fields signed when present in the message.
+var dkimHeaders = []string{
+	"From", "To", "Cc", "Subject", "Date", "Message-Id", "In-Reply-To", "References", "Content-Type", "Mime-Version",
+}
+
+var wspRun = regexp.MustCompile(`[ \t]+`)
+
+// dkimSign returns the message prefixed with a DKIM-Signature header as
+// specified by RFC 6376, using the relaxed canonicalization for both the
+// header and the body. Bare line feeds are converted to CRLF beforehand, as
+// the SMTP client would do when sending the message.
+func (n *Email) dkimSign(msg []byte, now time.Time) ([]byte, error) {
+	b, err := os.ReadFile(n.conf.DKIMPrivateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("read DKIM private key: %w", err)
+	}
+	key, algo, err := parseDKIMKey(b)
+	if err != nil {
+		return nil, fmt.Errorf("parse DKIM private key: %w", err)
+	}
+
+	msg = bytes.ReplaceAll(bytes.ReplaceAll(msg, []byte("\r\n"), []byte("\n")), []byte("\n"), []byte("\r\n"))
+	header, body, ok := bytes.Cut(msg, []byte("\r\n\r\n"))
+	if !ok {
+		return nil, errors.New("missing header and body separator")
+	}
+	fields := parseHeaderFields(string(header) + "\r\n")
+
+	var (
+		names  []string
+		hashed strings.Builder
+	)
+	for _, name := range dkimHeaders {
+		if f, ok := fields[strings.ToLower(name)]; ok {
+			names = append(names, name)
+			hashed.WriteString(relaxedHeader(f))
+		}
+	}
+
+	bh := sha256.Sum256(relaxedBody(body))
+	sig := fmt.Sprintf("DKIM-Signature: v=1; a=%s; c=relaxed/relaxed; d=%s; s=%s; t=%d;\r\n\th=%s;\r\n\tbh=%s;\r\n\tb=",
+		algo, n.conf.DKIMDomain, n.conf.DKIMSelector, now.Unix(), strings.Join(names, ":"), base64.StdEncoding.EncodeToString(bh[:]))
+	// The signature header is hashed last, with an empty signature and
+	// without the trailing CRLF.
+	hashed.WriteString(strings.TrimSuffix(relaxedHeader(sig), "\r\n"))
+
+	digest := sha256.Sum256([]byte(hashed.String()))
+	opts := crypto.Hash(0)
+	if algo == "rsa-sha256" {
+		opts = crypto.SHA256
+	}
+	signature, err := key.Sign(rand.Reader, digest[:], opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(sig)
+	buf.WriteString(base64.StdEncoding.EncodeToString(signature))
+	buf.WriteString("\r\n")
+	buf.Write(msg)
+	return buf.Bytes(), nil
+}
+
+// parseDKIMKey parses a PEM encoded RSA or Ed25519 private key and returns
+// the matching DKIM signing algorithm.
+func parseDKIMKey(b []byte) (crypto.Signer, string, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, "", errors.New("no PEM data found")
+	}
+	var (
+		key interface{}
+		err error
+	)
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, "", fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return k, "rsa-sha256", nil
+	case ed25519.PrivateKey:
+		return k, "ed25519-sha256", nil
+	default:
+		return nil, "", fmt.Errorf("unsupported key type %T", key)
+	}
+}
+
+// parseHeaderFields returns the raw header fields, including continuation
+// lines, by lowercased name. The last instance of a field wins, as signers
+// select fields from the bottom of the header.
+func parseHeaderFields(header string) map[string]string {
+	fields := map[string]string{}
+	var current string
+	flush := func() {
+		if current == "" {
+			return
+		}
+		name, _, _ := strings.Cut(current, ":")
+		fields[strings.ToLower(strings.TrimSpace(name))] = current
+	}
+	for _, line := range strings.SplitAfter(header, "\r\n") {
+		if line == "" {
+			continue
+		}
+		if line[0] == ' ' || line[0] == '\t' {
+			current += line
+			continue
+		}
+		flush()
+		current = line
+	}
+	flush()
+	return fields
+}
+
+// relaxedHeader canonicalizes a header field with the relaxed algorithm of
+// RFC 6376, section 3.4.2.
+func relaxedHeader(field string) string {
+	name, value, _ := strings.Cut(field, ":")
+	value = strings.ReplaceAll(value, "\r\n", "")
+	value = wspRun.ReplaceAllString(value, " ")
+	return strings.ToLower(strings.TrimSpace(name)) + ":" + strings.TrimSpace(value) + "\r\n"
+}
+
+// relaxedBody canonicalizes a body with the relaxed algorithm of RFC 6376,
+// section 3.4.4.
+func relaxedBody(body []byte) []byte {
+	lines := strings.Split(string(body), "\r\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(wspRun.ReplaceAllString(line, " "), " ")
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return []byte(strings.Join(lines, "\r\n") + "\r\n")
+}
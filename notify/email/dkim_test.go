@@ -0,0 +1,277 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// smtpServer accepts a single message and sends it to the returned channel.
+func smtpServer(t *testing.T) (*config.HostPort, <-chan []byte) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	msgs := make(chan []byte, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		fmt.Fprint(conn, "220 localhost ESMTP\r\n")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch cmd := strings.ToUpper(strings.Fields(line)[0]); cmd {
+			case "EHLO", "HELO":
+				fmt.Fprint(conn, "250 localhost\r\n")
+			case "DATA":
+				fmt.Fprint(conn, "354 go ahead\r\n")
+				var msg bytes.Buffer
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if line == ".\r\n" {
+						break
+					}
+					msg.WriteString(strings.TrimPrefix(line, "."))
+				}
+				msgs <- msg.Bytes()
+				fmt.Fprint(conn, "250 ok\r\n")
+			case "QUIT":
+				fmt.Fprint(conn, "221 bye\r\n")
+				return
+			default:
+				fmt.Fprint(conn, "250 ok\r\n")
+			}
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(l.Addr().String())
+	require.NoError(t, err)
+	return &config.HostPort{Host: host, Port: port}, msgs
+}
+
+// verifyDKIM checks the DKIM signature of a message signed with the relaxed
+// canonicalization against the public key and returns the signature tags.
+func verifyDKIM(raw []byte, pub crypto.PublicKey) (map[string]string, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	sigValue := msg.Header.Get("Dkim-Signature")
+	if sigValue == "" {
+		return nil, errors.New("missing signature")
+	}
+	tags := map[string]string{}
+	for _, tag := range strings.Split(sigValue, ";") {
+		k, v, _ := strings.Cut(strings.TrimSpace(tag), "=")
+		tags[k] = strings.Join(strings.Fields(v), "")
+	}
+
+	body, err := io.ReadAll(msg.Body)
+	if err != nil {
+		return nil, err
+	}
+	bh := sha256.Sum256(relaxedBody(body))
+	if base64.StdEncoding.EncodeToString(bh[:]) != tags["bh"] {
+		return nil, errors.New("body hash mismatch")
+	}
+
+	collapse := regexp.MustCompile(`[ \t]+`)
+	var data strings.Builder
+	for _, name := range strings.Split(tags["h"], ":") {
+		values := msg.Header[textproto.CanonicalMIMEHeaderKey(name)]
+		if len(values) == 0 {
+			return nil, fmt.Errorf("signed header %q missing", name)
+		}
+		fmt.Fprintf(&data, "%s:%s\r\n", strings.ToLower(name), strings.TrimSpace(collapse.ReplaceAllString(values[len(values)-1], " ")))
+	}
+	unsigned := regexp.MustCompile(`b=[^;]*$`).ReplaceAllString(sigValue, "b=")
+	fmt.Fprintf(&data, "dkim-signature:%s", strings.TrimSpace(collapse.ReplaceAllString(unsigned, " ")))
+	digest := sha256.Sum256([]byte(data.String()))
+
+	sig, err := base64.StdEncoding.DecodeString(tags["b"])
+	if err != nil {
+		return nil, err
+	}
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		if tags["a"] != "rsa-sha256" {
+			return nil, fmt.Errorf("unexpected algorithm %q", tags["a"])
+		}
+		if err := rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig); err != nil {
+			return nil, err
+		}
+	case ed25519.PublicKey:
+		if tags["a"] != "ed25519-sha256" {
+			return nil, fmt.Errorf("unexpected algorithm %q", tags["a"])
+		}
+		if !ed25519.Verify(k, digest[:], sig) {
+			return nil, errors.New("invalid signature")
+		}
+	}
+	return tags, nil
+}
+
+func writeKey(t *testing.T, typ string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "dkim.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600))
+	return path
+}
+
+func TestEmailNotifyDKIM(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name string
+		key  string
+		pub  crypto.PublicKey
+	}{
+		{
+			name: "rsa",
+			key:  writeKey(t, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey)),
+			pub:  &rsaKey.PublicKey,
+		},
+		{
+			name: "ed25519",
+			key:  writeKey(t, "PRIVATE KEY", edDER),
+			pub:  edPub,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tmpl, _ := testTemplateData(t)
+			smarthost, msgs := smtpServer(t)
+
+			email, err := New(&config.EmailConfig{
+				To:                 "to@example.com",
+				From:               "alertmanager@example.com",
+				Hello:              "localhost",
+				Smarthost:          *smarthost,
+				RequireTLS:         new(bool),
+				Headers:            map[string]string{"Subject": "{{ .CommonLabels.alertname }}  is   firing"},
+				HTML:               `<p>{{ len .Alerts }} alerts</p>`,
+				Text:               "{{ len .Alerts }} alerts  \n\n",
+				DKIMDomain:         "example.com",
+				DKIMSelector:       "alerts",
+				DKIMPrivateKeyFile: tc.key,
+			}, tmpl, log.NewNopLogger())
+			require.NoError(t, err)
+
+			ctx := notify.WithGroupKey(context.Background(), "1")
+			alert := &types.Alert{Alert: model.Alert{
+				Labels:   model.LabelSet{"alertname": "HighLatency"},
+				StartsAt: time.Now(),
+			}}
+			retry, err := email.Notify(ctx, alert)
+			require.NoError(t, err)
+			require.False(t, retry)
+
+			tags, err := verifyDKIM(<-msgs, tc.pub)
+			require.NoError(t, err)
+			require.Equal(t, "example.com", tags["d"])
+			require.Equal(t, "alerts", tags["s"])
+			require.Equal(t, "relaxed/relaxed", tags["c"])
+			require.Equal(t, "From:To:Subject:Date:Message-Id:Content-Type:Mime-Version", tags["h"])
+			ts, err := strconv.ParseInt(tags["t"], 10, 64)
+			require.NoError(t, err)
+			require.InDelta(t, time.Now().Unix(), ts, 60)
+		})
+	}
+}
+
+func TestDKIMSignTamperedMessage(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	email := &Email{conf: &config.EmailConfig{
+		DKIMDomain:         "example.com",
+		DKIMSelector:       "alerts",
+		DKIMPrivateKeyFile: writeKey(t, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key)),
+	}}
+
+	msg, err := email.dkimSign([]byte("From: a@example.com\nSubject: test\n\nbody\n"), time.Now())
+	require.NoError(t, err)
+	require.NotContains(t, strings.ReplaceAll(string(msg), "\r\n", ""), "\n", "bare line feeds must be converted")
+	_, err = verifyDKIM(msg, &key.PublicKey)
+	require.NoError(t, err)
+
+	tampered := bytes.Replace(msg, []byte("Subject: test"), []byte("Subject: tset"), 1)
+	_, err = verifyDKIM(tampered, &key.PublicKey)
+	require.Error(t, err)
+
+	tampered = bytes.Replace(msg, []byte("body"), []byte("ydob"), 1)
+	_, err = verifyDKIM(tampered, &key.PublicKey)
+	require.EqualError(t, err, "body hash mismatch")
+}
+
+func TestDKIMCanonicalization(t *testing.T) {
+	// Example from RFC 6376, section 3.4.5.
+	require.Equal(t, "a:X\r\n", relaxedHeader("A: X\r\n"))
+	require.Equal(t, "b:Y Z\r\n", relaxedHeader("B : Y\t\r\n\tZ  \r\n"))
+	require.Equal(t, " C\r\nD E\r\n", string(relaxedBody([]byte(" C \r\nD \t E\r\n\r\n\r\n"))))
+	require.Empty(t, relaxedBody([]byte("\r\n\r\n")))
+}
+
+func TestParseDKIMKey(t *testing.T) {
+	_, _, err := parseDKIMKey([]byte("not a key"))
+	require.EqualError(t, err, "no PEM data found")
+
+	_, _, err = parseDKIMKey(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("x")}))
+	require.EqualError(t, err, `unsupported PEM block type "CERTIFICATE"`)
+}
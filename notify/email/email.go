@@ -24,6 +24,7 @@ import (
 	"mime/multipart"
 	"mime/quotedprintable"
 	"net"
+	"net/http"
 	"net/mail"
 	"net/smtp"
 	"net/textproto"
@@ -47,10 +48,21 @@ type Email struct {
 	tmpl     *template.Template
 	logger   log.Logger
 	hostname string
+	// client fetches the attachments read from URLs.
+	client *http.Client
 }
 
 // New returns a new Email notifier.
-func New(c *config.EmailConfig, t *template.Template, l log.Logger) *Email {
+func New(c *config.EmailConfig, t *template.Template, l log.Logger, httpOpts ...commoncfg.HTTPClientOption) (*Email, error) {
+	httpConfig := commoncfg.DefaultHTTPClientConfig
+	if c.HTTPConfig != nil {
+		httpConfig = *c.HTTPConfig
+	}
+	client, err := commoncfg.NewClientFromConfig(httpConfig, "email", httpOpts...)
+	if err != nil {
+		return nil, err
+	}
+
 	if _, ok := c.Headers["Subject"]; !ok {
 		c.Headers["Subject"] = config.DefaultEmailSubject
 	}
@@ -66,7 +78,7 @@ func New(c *config.EmailConfig, t *template.Template, l log.Logger) *Email {
 	if err != nil {
 		h = "localhost.localdomain"
 	}
-	return &Email{conf: c, tmpl: t, logger: l, hostname: h}
+	return &Email{conf: c, tmpl: t, logger: l, hostname: h, client: client}, nil
 }
 
 // auth resolves a string of authentication mechanisms.
@@ -130,6 +142,27 @@ func (n *Email) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
 		err     error
 		success = false
 	)
+
+	// Render the attachments before connecting to the server, as fetching
+	// them may take a while.
+	var (
+		tmplErr error
+		data    = notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
+		tmpl    = notify.TmplText(n.tmpl, data, &tmplErr)
+	)
+	from := tmpl(n.conf.From)
+	if tmplErr != nil {
+		return false, fmt.Errorf("execute 'from' template: %w", tmplErr)
+	}
+	to := tmpl(n.conf.To)
+	if tmplErr != nil {
+		return false, fmt.Errorf("execute 'to' template: %w", tmplErr)
+	}
+	attachments, retry, err := n.renderAttachments(ctx, data)
+	if err != nil {
+		return retry, err
+	}
+
 	if n.conf.Smarthost.Port == "465" {
 		tlsConfig, err := commoncfg.NewTLSConfig(&n.conf.TLSConfig)
 		if err != nil {
@@ -203,20 +236,6 @@ func (n *Email) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
 		}
 	}
 
-	var (
-		tmplErr error
-		data    = notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
-		tmpl    = notify.TmplText(n.tmpl, data, &tmplErr)
-	)
-	from := tmpl(n.conf.From)
-	if tmplErr != nil {
-		return false, fmt.Errorf("execute 'from' template: %w", tmplErr)
-	}
-	to := tmpl(n.conf.To)
-	if tmplErr != nil {
-		return false, fmt.Errorf("execute 'to' template: %w", tmplErr)
-	}
-
 	addrs, err := mail.ParseAddressList(from)
 	if err != nil {
 		return false, fmt.Errorf("parse 'from' addresses: %w", err)
@@ -237,13 +256,6 @@ func (n *Email) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
 		}
 	}
 
-	// Send the email headers and body.
-	message, err := c.Data()
-	if err != nil {
-		return true, fmt.Errorf("send DATA command: %w", err)
-	}
-	defer message.Close()
-
 	buffer := &bytes.Buffer{}
 	for header, t := range n.conf.Headers {
 		value, err := n.tmpl.ExecuteTextString(t, data)
@@ -253,22 +265,29 @@ func (n *Email) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
 		fmt.Fprintf(buffer, "%s: %s\r\n", header, mime.QEncoding.Encode("utf-8", value))
 	}
 
+	msgID, threadID := n.messageIDs(ctx)
 	if _, ok := n.conf.Headers["Message-Id"]; !ok {
-		fmt.Fprintf(buffer, "Message-Id: %s\r\n", fmt.Sprintf("<%d.%d@%s>", time.Now().UnixNano(), rand.Uint64(), n.hostname))
+		fmt.Fprintf(buffer, "Message-Id: %s\r\n", msgID)
+	}
+	// Reference the first notification of the group so that mail clients
+	// thread firing, repeated and resolved notifications together.
+	if threadID != "" && threadID != msgID {
+		if _, ok := n.conf.Headers["In-Reply-To"]; !ok {
+			fmt.Fprintf(buffer, "In-Reply-To: %s\r\n", threadID)
+		}
+		if _, ok := n.conf.Headers["References"]; !ok {
+			fmt.Fprintf(buffer, "References: %s\r\n", threadID)
+		}
 	}
 
 	multipartBuffer := &bytes.Buffer{}
 	multipartWriter := multipart.NewWriter(multipartBuffer)
 
 	fmt.Fprintf(buffer, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
-	fmt.Fprintf(buffer, "Content-Type: multipart/alternative;  boundary=%s\r\n", multipartWriter.Boundary())
-	fmt.Fprintf(buffer, "MIME-Version: 1.0\r\n\r\n")
 
-	// TODO: Add some useful headers here, such as URL of the alertmanager
-	// and active/resolved.
-	_, err = message.Write(buffer.Bytes())
+	textBody, htmlBody, err := n.renderBodies(data)
 	if err != nil {
-		return false, fmt.Errorf("write headers: %w", err)
+		return false, err
 	}
 
 	if len(n.conf.Text) > 0 {
@@ -280,12 +299,8 @@ func (n *Email) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
 		if err != nil {
 			return false, fmt.Errorf("create part for text template: %w", err)
 		}
-		body, err := n.tmpl.ExecuteTextString(n.conf.Text, data)
-		if err != nil {
-			return false, fmt.Errorf("execute text template: %w", err)
-		}
 		qw := quotedprintable.NewWriter(w)
-		_, err = qw.Write([]byte(body))
+		_, err = qw.Write([]byte(textBody))
 		if err != nil {
 			return true, fmt.Errorf("write text part: %w", err)
 		}
@@ -295,7 +310,7 @@ func (n *Email) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
 		}
 	}
 
-	if len(n.conf.HTML) > 0 {
+	if len(n.conf.HTML) > 0 || n.conf.Markup == config.MarkupMarkdown {
 		// Html template
 		// Preferred alternative placed last per section 5.1.4 of RFC 2046
 		// https://www.ietf.org/rfc/rfc2046.txt
@@ -306,12 +321,8 @@ func (n *Email) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
 		if err != nil {
 			return false, fmt.Errorf("create part for html template: %w", err)
 		}
-		body, err := n.tmpl.ExecuteHTMLString(n.conf.HTML, data)
-		if err != nil {
-			return false, fmt.Errorf("execute html template: %w", err)
-		}
 		qw := quotedprintable.NewWriter(w)
-		_, err = qw.Write([]byte(body))
+		_, err = qw.Write([]byte(htmlBody))
 		if err != nil {
 			return true, fmt.Errorf("write HTML part: %w", err)
 		}
@@ -326,9 +337,56 @@ func (n *Email) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
 		return false, fmt.Errorf("close multipartWriter: %w", err)
 	}
 
-	_, err = message.Write(multipartBuffer.Bytes())
+	// Inline attachments are referenced from the HTML part and go along with
+	// the alternatives, other attachments wrap the whole.
+	var inline, attached []*attachment
+	for _, a := range attachments {
+		if a.inline {
+			inline = append(inline, a)
+		} else {
+			attached = append(attached, a)
+		}
+	}
+	contentType := "multipart/alternative;  boundary=" + multipartWriter.Boundary()
+	body := multipartBuffer.Bytes()
+	if len(inline) > 0 {
+		contentType, body, err = wrapMultipart("related", contentType, body, inline)
+		if err != nil {
+			return false, fmt.Errorf("write inline attachments: %w", err)
+		}
+	}
+	if len(attached) > 0 {
+		contentType, body, err = wrapMultipart("mixed", contentType, body, attached)
+		if err != nil {
+			return false, fmt.Errorf("write attachments: %w", err)
+		}
+	}
+
+	fmt.Fprintf(buffer, "Content-Type: %s\r\n", contentType)
+	fmt.Fprintf(buffer, "MIME-Version: 1.0\r\n\r\n")
+
+	// TODO: Add some useful headers here, such as URL of the alertmanager
+	// and active/resolved.
+	buffer.Write(body)
+	msg := buffer.Bytes()
+
+	if n.conf.DKIMDomain != "" {
+		msg, err = n.dkimSign(msg, time.Now())
+		if err != nil {
+			return false, fmt.Errorf("sign message: %w", err)
+		}
+	}
+
+	// Send the email headers and body.
+	message, err := c.Data()
 	if err != nil {
-		return false, fmt.Errorf("write body buffer: %w", err)
+		return true, fmt.Errorf("send DATA command: %w", err)
+	}
+	defer message.Close()
+
+	_, err = message.Write(msg)
+	if err != nil {
+		return false, fmt.Errorf("write message: %w", err)
 	}
 
 	success = true
@@ -372,3 +430,59 @@ func (n *Email) getPassword() (string, error) {
 	}
 	return string(n.conf.AuthPassword), nil
 }
+
+// messageIDs returns the Message-ID of the notification and the Message-ID
+// of the first notification sent for its group. Both are derived from the
+// group key, the integration index and the notification times, so retries
+// and restarts yield the same identifiers while the integrations of a
+// receiver don't collide. The thread identifier is empty if the context doesn't
+// carry the time of the first notification.
+func (n *Email) messageIDs(ctx context.Context) (string, string) {
+	key, err := notify.ExtractGroupKey(ctx)
+	if err != nil {
+		return fmt.Sprintf("<%d.%d@%s>", time.Now().UnixNano(), rand.Uint64(), n.hostname), ""
+	}
+	idx, _ := notify.IntegrationIndex(ctx)
+	now, ok := notify.Now(ctx)
+	if !ok {
+		now = time.Now()
+	}
+	msgID := n.messageID(key, idx, now)
+
+	first, ok := notify.FirstNotification(ctx)
+	if !ok {
+		return msgID, ""
+	}
+	return msgID, n.messageID(key, idx, first)
+}
+
+func (n *Email) messageID(key notify.Key, idx int, t time.Time) string {
+	return fmt.Sprintf("<%d.%s.%d@%s>", t.UnixNano(), key.Hash(), idx, n.hostname)
+}
+
+// renderBodies renders the text and HTML bodies. With the markdown markup,
+// both are converted from the text template.
+func (n *Email) renderBodies(data *template.Data) (string, string, error) {
+	if n.conf.Markup == config.MarkupMarkdown {
+		markdown, err := n.tmpl.ExecuteTextString(n.conf.Text, data)
+		if err != nil {
+			return "", "", fmt.Errorf("execute text template: %w", err)
+		}
+		return template.ConvertMarkdown(markdown, template.MarkupPlain), template.ConvertMarkdown(markdown, template.MarkupHTML), nil
+	}
+
+	var text, html string
+	if len(n.conf.Text) > 0 {
+		var err error
+		if text, err = n.tmpl.ExecuteTextString(n.conf.Text, data); err != nil {
+			return "", "", fmt.Errorf("execute text template: %w", err)
+		}
+	}
+	if len(n.conf.HTML) > 0 {
+		var err error
+		if html, err = n.tmpl.ExecuteHTMLString(n.conf.HTML, data); err != nil {
+			return "", "", fmt.Errorf("execute html template: %w", err)
+		}
+	}
+	return text, html, nil
+}
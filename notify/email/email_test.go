@@ -46,6 +46,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
 	"github.com/prometheus/alertmanager/template"
 	"github.com/prometheus/alertmanager/types"
 )
@@ -188,7 +189,10 @@ func notifyEmailWithContext(ctx context.Context, cfg *config.EmailConfig, server
 		return nil, false, err
 	}
 	tmpl.ExternalURL, _ = url.Parse("http://am")
-	email := New(cfg, tmpl, log.NewNopLogger())
+	email, err := New(cfg, tmpl, log.NewNopLogger())
+	if err != nil {
+		return nil, false, err
+	}
 
 	retry, err := email.Notify(ctx, firingAlert)
 	if err != nil {
@@ -643,3 +647,96 @@ func TestEmailNoUsernameStillOk(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, a)
 }
+
+func TestEmailMessageIDs(t *testing.T) {
+	email := &Email{
+		conf: &config.EmailConfig{}, tmpl: &template.Template{}, logger: log.NewNopLogger(), hostname: "localhost",
+	}
+	key := notify.Key("{}:{alertname=\"test\"}")
+	first := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := first.Add(time.Hour)
+
+	// Without a group key, a random identifier is generated and the
+	// notification isn't threaded.
+	msgID, threadID := email.messageIDs(context.Background())
+	require.NotEmpty(t, msgID)
+	require.Empty(t, threadID)
+
+	ctx := notify.WithGroupKey(context.Background(), string(key))
+	ctx = notify.WithNow(ctx, first)
+	ctx = notify.WithFirstNotification(ctx, first)
+
+	// The first notification starts the thread.
+	msgID, threadID = email.messageIDs(ctx)
+	require.Equal(t, fmt.Sprintf("<%d.%s.0@localhost>", first.UnixNano(), key.Hash()), msgID)
+	require.Equal(t, msgID, threadID)
+
+	// Retries of the same notification yield the same identifier.
+	again, _ := email.messageIDs(ctx)
+	require.Equal(t, msgID, again)
+
+	// Later notifications reference the first one.
+	ctx = notify.WithNow(ctx, now)
+	laterID, laterThreadID := email.messageIDs(ctx)
+	require.Equal(t, fmt.Sprintf("<%d.%s.0@localhost>", now.UnixNano(), key.Hash()), laterID)
+	require.Equal(t, msgID, laterThreadID)
+}
+
+func TestEmailMessageIDsIntegrations(t *testing.T) {
+	email := &Email{
+		conf: &config.EmailConfig{}, tmpl: &template.Template{}, logger: log.NewNopLogger(), hostname: "localhost",
+	}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := notify.WithGroupKey(context.Background(), "{}:{alertname=\"test\"}")
+	ctx = notify.WithNow(ctx, now)
+	ctx = notify.WithFirstNotification(ctx, now)
+
+	// Two email integrations of the same receiver notify the same group at
+	// the same time but must not share their identifiers.
+	firstID, firstThreadID := email.messageIDs(notify.WithIntegrationIndex(ctx, 0))
+	secondID, secondThreadID := email.messageIDs(notify.WithIntegrationIndex(ctx, 1))
+	require.NotEqual(t, firstID, secondID)
+	require.NotEqual(t, firstThreadID, secondThreadID)
+	require.Equal(t, secondID, secondThreadID)
+}
+
+func TestEmailRenderBodies(t *testing.T) {
+	tmpl, err := template.New()
+	require.NoError(t, err)
+	data := &template.Data{Status: "firing", CommonLabels: template.KV{"instance": "<db-1>"}}
+
+	for _, tc := range []struct {
+		title string
+		conf  *config.EmailConfig
+		text  string
+		html  string
+	}{
+		{
+			title: "templates as is",
+			conf: &config.EmailConfig{
+				Text: `**{{ .Status }}** {{ .CommonLabels.instance }}`,
+				HTML: `<b>{{ .Status }}</b> {{ .CommonLabels.instance }}`,
+			},
+			text: "**firing** <db-1>",
+			html: "<b>firing</b> &lt;db-1&gt;",
+		},
+		{
+			title: "markdown converted from the text template",
+			conf: &config.EmailConfig{
+				Text:   `**{{ .Status }}** {{ .CommonLabels.instance }}`,
+				HTML:   `ignored`,
+				Markup: config.MarkupMarkdown,
+			},
+			text: "firing <db-1>",
+			html: "<p><strong>firing</strong> &lt;db-1&gt;</p>",
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			email := &Email{conf: tc.conf, tmpl: tmpl, logger: log.NewNopLogger()}
+			text, html, err := email.renderBodies(data)
+			require.NoError(t, err)
+			require.Equal(t, tc.text, text)
+			require.Equal(t, tc.html, html)
+		})
+	}
+}
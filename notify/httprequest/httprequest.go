@@ -0,0 +1,227 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httprequest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	commoncfg "github.com/prometheus/common/config"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/webhook"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// maxResponseSize is the maximum size of the responses read for their fields.
+const maxResponseSize = 1 << 20
+
+// Notifier implements a Notifier for HTTP requests rendered from templates.
+type Notifier struct {
+	conf   *config.HTTPRequestConfig
+	tmpl   *template.Template
+	logger log.Logger
+	client *http.Client
+}
+
+// New returns a new Notifier.
+func New(conf *config.HTTPRequestConfig, t *template.Template, l log.Logger, httpOpts ...commoncfg.HTTPClientOption) (*Notifier, error) {
+	client, err := commoncfg.NewClientFromConfig(*conf.HTTPConfig, "http", httpOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Notifier{
+		conf:   conf,
+		tmpl:   t,
+		logger: l,
+		client: client,
+	}, nil
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+	var numTruncated uint64
+	if n.conf.MaxAlerts != 0 && uint64(len(alerts)) > n.conf.MaxAlerts {
+		alerts, numTruncated = alerts[:n.conf.MaxAlerts], uint64(len(alerts))-n.conf.MaxAlerts
+	}
+	data := notify.GetTemplateData(ctx, n.tmpl, alerts, n.logger)
+
+	groupKey, err := notify.ExtractGroupKey(ctx)
+	if err != nil {
+		level.Error(n.logger).Log("err", err)
+	}
+
+	req, err := n.newRequest(ctx, data, groupKey, numTruncated)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return true, notify.RedactURL(err)
+	}
+	defer notify.Drain(resp)
+
+	body := io.LimitReader(resp.Body, maxResponseSize)
+	if shouldRetry, err := n.check(resp.StatusCode, body); err != nil {
+		return shouldRetry, notify.NewErrorWithReason(notify.GetFailureReasonFromStatusCode(resp.StatusCode), err)
+	}
+
+	if len(n.conf.ResponseFields) > 0 {
+		n.logResponseFields(body)
+	}
+	return false, nil
+}
+
+// newRequest renders the request from the templates.
+func (n *Notifier) newRequest(ctx context.Context, data *template.Data, groupKey notify.Key, numTruncated uint64) (*http.Request, error) {
+	var err error
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+
+	method := strings.ToUpper(strings.TrimSpace(tmpl(n.conf.Method)))
+	u, perr := url.Parse(strings.TrimSpace(tmpl(string(n.conf.URL))))
+	headers := make(http.Header, len(n.conf.Headers))
+	for k, v := range n.conf.Headers {
+		headers.Set(k, tmpl(string(v)))
+	}
+	query := make(map[string]string, len(n.conf.Query))
+	for k, v := range n.conf.Query {
+		query[k] = tmpl(v)
+	}
+	body := tmpl(n.conf.Body)
+	if err != nil {
+		return nil, fmt.Errorf("render request: %w", err)
+	}
+	if perr != nil {
+		return nil, fmt.Errorf("render request: invalid url: %w", notify.RedactURL(perr))
+	}
+
+	if len(query) > 0 {
+		q := u.Query()
+		for k, v := range query {
+			q.Set(k, v)
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	if n.conf.Body == "" {
+		var buf bytes.Buffer
+		if err := json.NewEncoder(&buf).Encode(&webhook.Message{
+			Version:         "4",
+			Data:            data,
+			GroupKey:        groupKey.String(),
+			TruncatedAlerts: numTruncated,
+		}); err != nil {
+			return nil, err
+		}
+		body = buf.String()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), strings.NewReader(body))
+	if err != nil {
+		return nil, notify.RedactURL(err)
+	}
+	req.Header.Set("User-Agent", notify.UserAgentHeader)
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header[k] = v
+	}
+	return req, nil
+}
+
+// check returns whether the request should be retried and an error if the
+// response isn't successful.
+func (n *Notifier) check(code int, body io.Reader) (bool, error) {
+	if n.success(code) {
+		return false, nil
+	}
+
+	var retry bool
+	for _, c := range n.conf.RetryStatusCodes {
+		if c == strconv.Itoa(code) || (strings.HasSuffix(c, "xx") && c[0] == byte('0'+code/100)) {
+			retry = true
+			break
+		}
+	}
+
+	s := fmt.Sprintf("unexpected status code %v", code)
+	if bs, err := io.ReadAll(body); err == nil && len(bs) > 0 {
+		s = fmt.Sprintf("%s: %s", s, bs)
+	}
+	return retry, errors.New(s)
+}
+
+func (n *Notifier) success(code int) bool {
+	if len(n.conf.SuccessStatusCodes) == 0 {
+		return code/100 == 2
+	}
+	for _, c := range n.conf.SuccessStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// logResponseFields logs the configured fields of a JSON response.
+func (n *Notifier) logResponseFields(body io.Reader) {
+	var v interface{}
+	if err := json.NewDecoder(body).Decode(&v); err != nil {
+		level.Warn(n.logger).Log("msg", "Failed to decode the response to log its fields", "err", err)
+		return
+	}
+
+	kvs := []interface{}{"msg", "Notification sent"}
+	for _, f := range n.conf.ResponseFields {
+		if fv, ok := lookup(v, f); ok {
+			kvs = append(kvs, f, fv)
+		}
+	}
+	level.Info(n.logger).Log(kvs...)
+}
+
+// lookup returns the value at the dot-separated path in a decoded JSON
+// value. Elements of arrays are selected by their index.
+func lookup(v interface{}, path string) (interface{}, bool) {
+	for _, k := range strings.Split(path, ".") {
+		switch vv := v.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if v, ok = vv[k]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(k)
+			if err != nil || i < 0 || i >= len(vv) {
+				return nil, false
+			}
+			v = vv[i]
+		default:
+			return nil, false
+		}
+	}
+	return v, true
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httprequest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	commoncfg "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/notify/webhook"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func newTestNotifier(t *testing.T, conf *config.HTTPRequestConfig) *Notifier {
+	conf.HTTPConfig = &commoncfg.HTTPClientConfig{}
+	if conf.Method == "" {
+		conf.Method = "POST"
+	}
+	// The notifier doesn't use the default templates.
+	tmpl, err := template.New()
+	require.NoError(t, err)
+	tmpl.ExternalURL, _ = url.Parse("http://am")
+	n, err := New(conf, tmpl, log.NewNopLogger())
+	require.NoError(t, err)
+	return n
+}
+
+func testContext() context.Context {
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	return notify.WithGroupLabels(ctx, model.LabelSet{"alertname": "HighLatency"})
+}
+
+func testAlert() *types.Alert {
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "HighLatency", "team": "payments"},
+			StartsAt: time.Now().Add(-time.Minute),
+		},
+	}
+}
+
+func TestNotifyRendersRequest(t *testing.T) {
+	var (
+		method, path, query, contentType, auth string
+		body                                   []byte
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path, query = r.Method, r.URL.Path, r.URL.RawQuery
+		contentType, auth = r.Header.Get("Content-Type"), r.Header.Get("Authorization")
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	n := newTestNotifier(t, &config.HTTPRequestConfig{
+		Method: `{{ if eq .Status "firing" }}PUT{{ else }}DELETE{{ end }}`,
+		URL:    config.Secret(srv.URL + "/incidents/{{ .GroupLabels.alertname }}"),
+		Headers: map[string]config.Secret{
+			"Authorization": "Bearer token",
+			"content-type":  "text/plain",
+		},
+		Query: map[string]string{"team": "{{ .CommonLabels.team }}"},
+		Body:  "{{ .Alerts.Firing | len }} alerts firing",
+	})
+
+	retry, err := n.Notify(testContext(), testAlert())
+	require.NoError(t, err)
+	require.False(t, retry)
+	require.Equal(t, "PUT", method)
+	require.Equal(t, "/incidents/HighLatency", path)
+	require.Equal(t, "team=payments", query)
+	require.Equal(t, "text/plain", contentType)
+	require.Equal(t, "Bearer token", auth)
+	require.Equal(t, "1 alerts firing", string(body))
+}
+
+func TestNotifyDefaultBody(t *testing.T) {
+	var msg webhook.Message
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+	}))
+	defer srv.Close()
+
+	n := newTestNotifier(t, &config.HTTPRequestConfig{URL: config.Secret(srv.URL), MaxAlerts: 1})
+	_, err := n.Notify(testContext(), testAlert(), testAlert())
+	require.NoError(t, err)
+	require.Equal(t, "4", msg.Version)
+	require.Equal(t, "1", msg.GroupKey)
+	require.Equal(t, uint64(1), msg.TruncatedAlerts)
+	require.Len(t, msg.Alerts, 1)
+}
+
+func TestNotifyTemplateError(t *testing.T) {
+	n := newTestNotifier(t, &config.HTTPRequestConfig{URL: "http://example.com", Body: "{{ .Unknown }}"})
+	retry, err := n.Notify(testContext(), testAlert())
+	require.ErrorContains(t, err, "render request")
+	require.False(t, retry)
+}
+
+func TestCheck(t *testing.T) {
+	for _, tc := range []struct {
+		success []int
+		retry   []string
+		code    int
+
+		expErr   bool
+		expRetry bool
+	}{
+		{code: http.StatusOK},
+		{code: http.StatusNoContent},
+		{code: http.StatusBadRequest, expErr: true},
+		{code: http.StatusServiceUnavailable, retry: []string{"5xx"}, expErr: true, expRetry: true},
+		{code: http.StatusServiceUnavailable, expErr: true},
+		{code: http.StatusTooManyRequests, retry: []string{"5xx", "429"}, expErr: true, expRetry: true},
+		{code: http.StatusConflict, retry: []string{"4xx"}, expErr: true, expRetry: true},
+		{code: http.StatusOK, success: []int{201}, expErr: true},
+		{code: http.StatusCreated, success: []int{201}},
+		{code: http.StatusConflict, success: []int{201, 409}},
+	} {
+		n := &Notifier{conf: &config.HTTPRequestConfig{SuccessStatusCodes: tc.success, RetryStatusCodes: tc.retry}}
+		retry, err := n.check(tc.code, bytes.NewBufferString("details"))
+		require.Equal(t, tc.expRetry, retry, "status code %d", tc.code)
+		if !tc.expErr {
+			require.NoError(t, err, "status code %d", tc.code)
+			continue
+		}
+		require.EqualError(t, err, fmt.Sprintf("unexpected status code %d: details", tc.code))
+	}
+}
+
+func TestLookup(t *testing.T) {
+	var v interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{"id": "abc", "data": {"tickets": [{"key": "OPS-1"}]}}`), &v))
+
+	for path, exp := range map[string]interface{}{
+		"id":                 "abc",
+		"data.tickets.0.key": "OPS-1",
+		"data.tickets.1.key": nil,
+		"data.tickets.x":     nil,
+		"id.nested":          nil,
+		"missing":            nil,
+	} {
+		got, ok := lookup(v, path)
+		require.Equal(t, exp != nil, ok, path)
+		require.Equal(t, exp, got, path)
+	}
+}
+
+func TestRedactedURL(t *testing.T) {
+	ctx, u, fn := test.GetContextWithCancelingURL()
+	defer fn()
+
+	secret := "secret"
+	n := newTestNotifier(t, &config.HTTPRequestConfig{URL: config.Secret(u.String())})
+
+	test.AssertNotifyLeaksNoSecret(ctx, t, n, secret)
+}
@@ -89,16 +89,17 @@ func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error)
 	level.Debug(n.logger).Log("incident", key)
 
 	data := notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
-	tmpl := notify.TmplText(n.tmpl, data, &err)
+	tmpl := notify.TmplMarkdown(n.tmpl, data, &err, n.conf.Markup, template.MarkupPlain)
 	if err != nil {
 		return false, err
 	}
+	tmplText := notify.TmplMarkdown(n.tmpl, data, &err, n.conf.Markup, template.MarkupMSTeams)
 
 	title := tmpl(n.conf.Title)
 	if err != nil {
 		return false, err
 	}
-	text := tmpl(n.conf.Text)
+	text := tmplText(n.conf.Text)
 	if err != nil {
 		return false, err
 	}
@@ -28,6 +28,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
 
+	"github.com/prometheus/alertmanager/ack"
 	"github.com/prometheus/alertmanager/featurecontrol"
 	"github.com/prometheus/alertmanager/inhibit"
 	"github.com/prometheus/alertmanager/nflog"
@@ -119,6 +120,9 @@ const (
 	keyNow
 	keyMuteTimeIntervals
 	keyActiveTimeIntervals
+	keySuppressAcknowledgedRepeats
+	keyFirstNotification
+	keyIntegrationIndex
 )
 
 // WithReceiverName populates a context with a receiver name.
@@ -151,6 +155,18 @@ func WithNow(ctx context.Context, t time.Time) context.Context {
 	return context.WithValue(ctx, keyNow, t)
 }
 
+// WithFirstNotification populates a context with the time of the first
+// notification sent for the group since it last had no firing alerts.
+func WithFirstNotification(ctx context.Context, t time.Time) context.Context {
+	return context.WithValue(ctx, keyFirstNotification, t)
+}
+
+// WithIntegrationIndex populates a context with the index of the integration
+// within its receiver.
+func WithIntegrationIndex(ctx context.Context, idx int) context.Context {
+	return context.WithValue(ctx, keyIntegrationIndex, idx)
+}
+
 // WithRepeatInterval populates a context with a repeat interval.
 func WithRepeatInterval(ctx context.Context, t time.Duration) context.Context {
 	return context.WithValue(ctx, keyRepeatInterval, t)
@@ -165,6 +181,12 @@ func WithActiveTimeIntervals(ctx context.Context, at []string) context.Context {
 	return context.WithValue(ctx, keyActiveTimeIntervals, at)
 }
 
+// WithSuppressAcknowledgedRepeats populates a context with whether repeat
+// notifications of acknowledged alerts are suppressed.
+func WithSuppressAcknowledgedRepeats(ctx context.Context, suppress bool) context.Context {
+	return context.WithValue(ctx, keySuppressAcknowledgedRepeats, suppress)
+}
+
 // RepeatInterval extracts a repeat interval from the context. Iff none exists, the
 // second argument is false.
 func RepeatInterval(ctx context.Context) (time.Duration, bool) {
@@ -200,6 +222,20 @@ func Now(ctx context.Context) (time.Time, bool) {
 	return v, ok
 }
 
+// FirstNotification extracts the time of the first notification of the
+// group from the context. Iff none exists, the second argument is false.
+func FirstNotification(ctx context.Context) (time.Time, bool) {
+	v, ok := ctx.Value(keyFirstNotification).(time.Time)
+	return v, ok
+}
+
+// IntegrationIndex extracts the index of the integration within its receiver
+// from the context. Iff none exists, the second argument is false.
+func IntegrationIndex(ctx context.Context) (int, bool) {
+	v, ok := ctx.Value(keyIntegrationIndex).(int)
+	return v, ok
+}
+
 // FiringAlerts extracts a slice of firing alerts from the context.
 // Iff none exists, the second argument is false.
 func FiringAlerts(ctx context.Context) ([]uint64, bool) {
@@ -228,6 +264,14 @@ func ActiveTimeIntervalNames(ctx context.Context) ([]string, bool) {
 	return v, ok
 }
 
+// SuppressAcknowledgedRepeats extracts from the context whether repeat
+// notifications of acknowledged alerts are suppressed. Iff none exists, the
+// second argument is false.
+func SuppressAcknowledgedRepeats(ctx context.Context) (bool, bool) {
+	v, ok := ctx.Value(keySuppressAcknowledgedRepeats).(bool)
+	return v, ok
+}
+
 // A Stage processes alerts under the constraints of the given context.
 type Stage interface {
 	Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error)
@@ -242,7 +286,7 @@ func (f StageFunc) Exec(ctx context.Context, l log.Logger, alerts ...*types.Aler
 }
 
 type NotificationLog interface {
-	Log(r *nflogpb.Receiver, gkey string, firingAlerts, resolvedAlerts []uint64, expiry time.Duration) error
+	Log(r *nflogpb.Receiver, gkey string, firingAlerts, resolvedAlerts []uint64, firstTimestamp time.Time, expiry time.Duration) error
 	Query(params ...nflog.QueryParam) ([]*nflogpb.Entry, error)
 }
 
@@ -288,7 +332,7 @@ func NewMetrics(r prometheus.Registerer, ff featurecontrol.Flagger) *Metrics {
 		numNotificationSuppressedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: "alertmanager",
 			Name:      "notifications_suppressed_total",
-			Help:      "The total number of notifications suppressed for being silenced, inhibited, outside of active time intervals or within muted time intervals.",
+			Help:      "The total number of notifications suppressed for being silenced, inhibited, acknowledged, outside of active time intervals or within muted time intervals.",
 		}, []string{"reason"}),
 		notificationLatencySeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: "alertmanager",
@@ -380,8 +424,10 @@ func (pb *PipelineBuilder) New(
 	wait func() time.Duration,
 	inhibitor *inhibit.Inhibitor,
 	silencer *silence.Silencer,
+	acknowledger *ack.Acknowledger,
 	intervener *timeinterval.Intervener,
 	notificationLog NotificationLog,
+	recorder NotificationRecorder,
 	peer Peer,
 ) RoutingStage {
 	rs := make(RoutingStage, len(receivers))
@@ -393,7 +439,7 @@ func (pb *PipelineBuilder) New(
 	ss := NewMuteStage(silencer, pb.metrics)
 
 	for name := range receivers {
-		st := createReceiverStage(name, receivers[name], wait, notificationLog, pb.metrics)
+		st := createReceiverStage(name, receivers[name], wait, acknowledger, notificationLog, recorder, pb.metrics)
 		rs[name] = MultiStage{ms, is, tas, tms, ss, st}
 	}
 
@@ -407,7 +453,9 @@ func createReceiverStage(
 	name string,
 	integrations []Integration,
 	wait func() time.Duration,
+	acknowledger Acknowledger,
 	notificationLog NotificationLog,
+	recorder NotificationRecorder,
 	metrics *Metrics,
 ) Stage {
 	var fs FanoutStage
@@ -420,8 +468,12 @@ func createReceiverStage(
 		var s MultiStage
 		s = append(s, NewWaitStage(wait))
 		s = append(s, NewDedupStage(&integrations[i], notificationLog, recv))
+		s = append(s, NewAcknowledgeStage(acknowledger, notificationLog, recv, metrics))
 		s = append(s, NewRetryStage(integrations[i], name, metrics))
 		s = append(s, NewSetNotifiesStage(notificationLog, recv))
+		if recorder != nil {
+			s = append(s, NewRecordNotificationStage(recorder, recv))
+		}
 
 		fs = append(fs, s)
 	}
@@ -518,6 +570,7 @@ const (
 	SuppressedReasonInhibition         = "inhibition"
 	SuppressedReasonMuteTimeInterval   = "mute_time_interval"
 	SuppressedReasonActiveTimeInterval = "active_time_interval"
+	SuppressedReasonAcknowledgement    = "acknowledgement"
 )
 
 // MuteStage filters alerts through a Muter.
@@ -564,6 +617,80 @@ func (n *MuteStage) Exec(ctx context.Context, logger log.Logger, alerts ...*type
 	return ctx, filtered, nil
 }
 
+// Acknowledger determines whether alerts have been acknowledged.
+type Acknowledger interface {
+	Acknowledged(alert *types.Alert) bool
+}
+
+// AcknowledgeStage suppresses the repeat notifications of an integration for
+// groups whose firing alerts have all been acknowledged, if the route asks for
+// it. Notifications are only suppressed if the firing alerts are the same as
+// when the integration last notified the group.
+type AcknowledgeStage struct {
+	acknowledger Acknowledger
+	nflog        NotificationLog
+	recv         *nflogpb.Receiver
+	metrics      *Metrics
+}
+
+// NewAcknowledgeStage returns a new AcknowledgeStage.
+func NewAcknowledgeStage(a Acknowledger, l NotificationLog, recv *nflogpb.Receiver, metrics *Metrics) *AcknowledgeStage {
+	return &AcknowledgeStage{acknowledger: a, nflog: l, recv: recv, metrics: metrics}
+}
+
+// Exec implements the Stage interface.
+func (n *AcknowledgeStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	if suppress, ok := SuppressAcknowledgedRepeats(ctx); !ok || !suppress {
+		return ctx, alerts, nil
+	}
+
+	var firing int
+	for _, a := range alerts {
+		if a.Resolved() {
+			continue
+		}
+		if !n.acknowledger.Acknowledged(a) {
+			return ctx, alerts, nil
+		}
+		firing++
+	}
+	if firing == 0 {
+		return ctx, alerts, nil
+	}
+
+	gkey, ok := GroupKey(ctx)
+	if !ok {
+		return ctx, nil, errors.New("group key missing")
+	}
+	firingAlerts, ok := FiringAlerts(ctx)
+	if !ok {
+		return ctx, nil, errors.New("firing alerts missing")
+	}
+
+	entries, err := n.nflog.Query(nflog.QGroupKey(gkey), nflog.QReceiver(n.recv))
+	if err != nil && !errors.Is(err, nflog.ErrNotFound) {
+		return ctx, nil, err
+	}
+	if len(entries) != 1 {
+		return ctx, alerts, nil
+	}
+
+	// Only repeats of the last notification are suppressed, changes to the
+	// firing alerts are still notified.
+	firingSet := make(map[uint64]struct{}, len(firingAlerts))
+	for _, h := range firingAlerts {
+		firingSet[h] = struct{}{}
+	}
+	entry := entries[0]
+	if len(entry.FiringAlerts) != len(firingSet) || !entry.IsFiringSubset(firingSet) {
+		return ctx, alerts, nil
+	}
+
+	n.metrics.numNotificationSuppressedTotal.WithLabelValues(SuppressedReasonAcknowledgement).Add(float64(len(alerts)))
+	level.Debug(l).Log("msg", "Repeat notification not sent, all firing alerts are acknowledged", "alerts", len(alerts))
+	return ctx, nil, nil
+}
+
 // WaitStage waits for a certain amount of time before continuing or until the
 // context is done.
 type WaitStage struct {
@@ -724,6 +851,17 @@ func (n *DedupStage) Exec(ctx context.Context, _ log.Logger, alerts ...*types.Al
 		return ctx, nil, fmt.Errorf("unexpected entry result size %d", len(entries))
 	}
 
+	// Notifications belong to the same series as long as the group had
+	// firing alerts when it was last notified.
+	first, ok := Now(ctx)
+	if !ok {
+		first = n.now()
+	}
+	if entry != nil && len(entry.FiringAlerts) > 0 && !entry.FirstTimestamp.IsZero() {
+		first = entry.FirstTimestamp
+	}
+	ctx = WithFirstNotification(ctx, first)
+
 	if n.needsUpdate(entry, firingSet, resolvedSet, repeatInterval) {
 		return ctx, alerts, nil
 	}
@@ -773,6 +911,8 @@ func (r RetryStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Ale
 func (r RetryStage) exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
 	var sent []*types.Alert
 
+	ctx = WithIntegrationIndex(ctx, r.integration.Index())
+
 	// If we shouldn't send notifications for resolved alerts, but there are only
 	// resolved alerts, report them all as successfully notified (we still want the
 	// notification log to log them for the next run of DedupStage).
@@ -915,7 +1055,42 @@ func (n SetNotifiesStage) Exec(ctx context.Context, l log.Logger, alerts ...*typ
 	}
 	expiry := 2 * repeat
 
-	return ctx, alerts, n.nflog.Log(n.recv, gkey, firing, resolved, expiry)
+	first, ok := FirstNotification(ctx)
+	if !ok {
+		return ctx, nil, errors.New("first notification missing")
+	}
+
+	return ctx, alerts, n.nflog.Log(n.recv, gkey, firing, resolved, first, expiry)
+}
+
+// NotificationRecorder records the notifications sent for alerts.
+type NotificationRecorder interface {
+	Notified(alerts []*types.Alert, receiver, integration string, now time.Time)
+}
+
+// RecordNotificationStage records the notifications of the passed alerts. The
+// passed alerts should have already been sent to the receivers.
+type RecordNotificationStage struct {
+	recorder NotificationRecorder
+	recv     *nflogpb.Receiver
+}
+
+// NewRecordNotificationStage returns a new instance of a RecordNotificationStage.
+func NewRecordNotificationStage(r NotificationRecorder, recv *nflogpb.Receiver) *RecordNotificationStage {
+	return &RecordNotificationStage{
+		recorder: r,
+		recv:     recv,
+	}
+}
+
+// Exec implements the Stage interface.
+func (n RecordNotificationStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	now, ok := Now(ctx)
+	if !ok {
+		return ctx, nil, errors.New("missing now timestamp")
+	}
+	n.recorder.Notified(alerts, n.recv.GroupName, n.recv.Integration, now)
+	return ctx, alerts, nil
 }
 
 type timeStage struct {
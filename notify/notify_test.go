@@ -60,15 +60,15 @@ type testNflog struct {
 	qres []*nflogpb.Entry
 	qerr error
 
-	logFunc func(r *nflogpb.Receiver, gkey string, firingAlerts, resolvedAlerts []uint64, expiry time.Duration) error
+	logFunc func(r *nflogpb.Receiver, gkey string, firingAlerts, resolvedAlerts []uint64, firstTimestamp time.Time, expiry time.Duration) error
 }
 
 func (l *testNflog) Query(p ...nflog.QueryParam) ([]*nflogpb.Entry, error) {
 	return l.qres, l.qerr
 }
 
-func (l *testNflog) Log(r *nflogpb.Receiver, gkey string, firingAlerts, resolvedAlerts []uint64, expiry time.Duration) error {
-	return l.logFunc(r, gkey, firingAlerts, resolvedAlerts, expiry)
+func (l *testNflog) Log(r *nflogpb.Receiver, gkey string, firingAlerts, resolvedAlerts []uint64, firstTimestamp time.Time, expiry time.Duration) error {
+	return l.logFunc(r, gkey, firingAlerts, resolvedAlerts, firstTimestamp, expiry)
 }
 
 func (l *testNflog) GC() (int, error) {
@@ -299,6 +299,58 @@ func TestDedupStage(t *testing.T) {
 	require.Equal(t, alerts, res, "unexpected alerts returned")
 }
 
+func TestDedupStageFirstNotification(t *testing.T) {
+	now := utcNow()
+	first := now.Add(-time.Hour)
+	s := &DedupStage{
+		hash: hashAlert,
+		now: func() time.Time {
+			return now
+		},
+		rs: sendResolved(false),
+	}
+	ctx := WithGroupKey(context.Background(), "1")
+	ctx = WithRepeatInterval(ctx, time.Hour)
+
+	for _, tc := range []struct {
+		name  string
+		entry *nflogpb.Entry
+		exp   time.Time
+	}{
+		{
+			name: "no previous notification",
+			exp:  now,
+		},
+		{
+			name:  "previous notification with firing alerts",
+			entry: &nflogpb.Entry{FiringAlerts: []uint64{1}, FirstTimestamp: first},
+			exp:   first,
+		},
+		{
+			name:  "previous notification without firing alerts",
+			entry: &nflogpb.Entry{ResolvedAlerts: []uint64{1}, FirstTimestamp: first},
+			exp:   now,
+		},
+		{
+			name:  "previous notification without first timestamp",
+			entry: &nflogpb.Entry{FiringAlerts: []uint64{1}},
+			exp:   now,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s.nflog = &testNflog{qerr: nflog.ErrNotFound}
+			if tc.entry != nil {
+				s.nflog = &testNflog{qres: []*nflogpb.Entry{tc.entry}}
+			}
+			resctx, _, err := s.Exec(ctx, log.NewNopLogger(), &types.Alert{})
+			require.NoError(t, err)
+			got, ok := FirstNotification(resctx)
+			require.True(t, ok)
+			require.Equal(t, tc.exp, got)
+		})
+	}
+}
+
 func TestMultiStage(t *testing.T) {
 	var (
 		alerts1 = []*types.Alert{{}}
@@ -598,6 +650,27 @@ func TestRetryStageSendResolved(t *testing.T) {
 	require.NotNil(t, resctx)
 }
 
+func TestRetryStageIntegrationIndex(t *testing.T) {
+	var (
+		idx int
+		ok  bool
+	)
+	i := Integration{
+		notifier: notifierFunc(func(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+			idx, ok = IntegrationIndex(ctx)
+			return false, nil
+		}),
+		rs:  sendResolved(true),
+		idx: 2,
+	}
+	r := NewRetryStage(i, "", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}))
+
+	_, _, err := r.Exec(context.Background(), log.NewNopLogger(), &types.Alert{})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 2, idx)
+}
+
 func TestSetNotifiesStage(t *testing.T) {
 	tnflog := &testNflog{}
 	s := &SetNotifiesStage{
@@ -629,11 +702,20 @@ func TestSetNotifiesStage(t *testing.T) {
 	ctx = WithResolvedAlerts(ctx, []uint64{})
 	ctx = WithRepeatInterval(ctx, time.Hour)
 
-	tnflog.logFunc = func(r *nflogpb.Receiver, gkey string, firingAlerts, resolvedAlerts []uint64, expiry time.Duration) error {
+	resctx, res, err = s.Exec(ctx, log.NewNopLogger(), alerts...)
+	require.EqualError(t, err, "first notification missing")
+	require.Nil(t, res)
+	require.NotNil(t, resctx)
+
+	first := utcNow().Add(-time.Hour)
+	ctx = WithFirstNotification(ctx, first)
+
+	tnflog.logFunc = func(r *nflogpb.Receiver, gkey string, firingAlerts, resolvedAlerts []uint64, firstTimestamp time.Time, expiry time.Duration) error {
 		require.Equal(t, s.recv, r)
 		require.Equal(t, "1", gkey)
 		require.Equal(t, []uint64{0, 1, 2}, firingAlerts)
 		require.Equal(t, []uint64{}, resolvedAlerts)
+		require.Equal(t, first, firstTimestamp)
 		require.Equal(t, 2*time.Hour, expiry)
 		return nil
 	}
@@ -645,11 +727,12 @@ func TestSetNotifiesStage(t *testing.T) {
 	ctx = WithFiringAlerts(ctx, []uint64{})
 	ctx = WithResolvedAlerts(ctx, []uint64{0, 1, 2})
 
-	tnflog.logFunc = func(r *nflogpb.Receiver, gkey string, firingAlerts, resolvedAlerts []uint64, expiry time.Duration) error {
+	tnflog.logFunc = func(r *nflogpb.Receiver, gkey string, firingAlerts, resolvedAlerts []uint64, firstTimestamp time.Time, expiry time.Duration) error {
 		require.Equal(t, s.recv, r)
 		require.Equal(t, "1", gkey)
 		require.Equal(t, []uint64{}, firingAlerts)
 		require.Equal(t, []uint64{0, 1, 2}, resolvedAlerts)
+		require.Equal(t, first, firstTimestamp)
 		require.Equal(t, 2*time.Hour, expiry)
 		return nil
 	}
@@ -659,6 +742,36 @@ func TestSetNotifiesStage(t *testing.T) {
 	require.NotNil(t, resctx)
 }
 
+type testNotificationRecorder struct {
+	alerts      []*types.Alert
+	receiver    string
+	integration string
+	now         time.Time
+}
+
+func (r *testNotificationRecorder) Notified(alerts []*types.Alert, receiver, integration string, now time.Time) {
+	r.alerts, r.receiver, r.integration, r.now = alerts, receiver, integration, now
+}
+
+func TestRecordNotificationStage(t *testing.T) {
+	r := &testNotificationRecorder{}
+	s := NewRecordNotificationStage(r, &nflogpb.Receiver{GroupName: "team-X", Integration: "webhook"})
+	alerts := []*types.Alert{{}, {}}
+	ctx := context.Background()
+
+	_, res, err := s.Exec(ctx, log.NewNopLogger(), alerts...)
+	require.EqualError(t, err, "missing now timestamp")
+	require.Nil(t, res)
+	require.Nil(t, r.alerts)
+
+	now := time.Now()
+	ctx = WithNow(ctx, now)
+	_, res, err = s.Exec(ctx, log.NewNopLogger(), alerts...)
+	require.NoError(t, err)
+	require.Equal(t, alerts, res)
+	require.Equal(t, &testNotificationRecorder{alerts: alerts, receiver: "team-X", integration: "webhook", now: now}, r)
+}
+
 func TestMuteStage(t *testing.T) {
 	// Mute all label sets that have a "mute" key.
 	muter := types.MuteFunc(func(lset model.LabelSet) bool {
@@ -1030,6 +1143,109 @@ func TestTimeActiveStage(t *testing.T) {
 	}
 }
 
+type acknowledgerFunc func(*types.Alert) bool
+
+func (f acknowledgerFunc) Acknowledged(a *types.Alert) bool { return f(a) }
+
+func TestAcknowledgeStage(t *testing.T) {
+	// Acknowledge all alerts that have an "ack" label.
+	acknowledger := acknowledgerFunc(func(a *types.Alert) bool {
+		_, ok := a.Labels["ack"]
+		return ok
+	})
+
+	var (
+		acked    = &types.Alert{Alert: model.Alert{Labels: model.LabelSet{"ack": "me"}}}
+		other    = &types.Alert{Alert: model.Alert{Labels: model.LabelSet{"ack": "me", "foo": "baz"}}}
+		unacked  = &types.Alert{Alert: model.Alert{Labels: model.LabelSet{"foo": "bar"}}}
+		resolved = &types.Alert{Alert: model.Alert{
+			Labels: model.LabelSet{"ack": "me", "foo": "bar"},
+			EndsAt: time.Now().Add(-time.Minute),
+		}}
+	)
+
+	for _, tc := range []struct {
+		name       string
+		suppress   bool
+		alerts     []*types.Alert
+		entry      *nflogpb.Entry
+		suppressed bool
+	}{
+		{
+			name:   "route setting disabled",
+			alerts: []*types.Alert{acked},
+			entry:  &nflogpb.Entry{FiringAlerts: []uint64{hashAlert(acked)}},
+		},
+		{
+			name:       "repeat of acknowledged alerts",
+			suppress:   true,
+			alerts:     []*types.Alert{acked},
+			entry:      &nflogpb.Entry{FiringAlerts: []uint64{hashAlert(acked)}},
+			suppressed: true,
+		},
+		{
+			name:       "repeat with resolved alerts",
+			suppress:   true,
+			alerts:     []*types.Alert{acked, resolved},
+			entry:      &nflogpb.Entry{FiringAlerts: []uint64{hashAlert(acked)}},
+			suppressed: true,
+		},
+		{
+			name:     "first notification",
+			suppress: true,
+			alerts:   []*types.Alert{acked},
+		},
+		{
+			name:     "unacknowledged alert",
+			suppress: true,
+			alerts:   []*types.Alert{acked, unacked},
+			entry:    &nflogpb.Entry{FiringAlerts: []uint64{hashAlert(acked), hashAlert(unacked)}},
+		},
+		{
+			name:     "new firing alert",
+			suppress: true,
+			alerts:   []*types.Alert{acked, other},
+			entry:    &nflogpb.Entry{FiringAlerts: []uint64{hashAlert(acked)}},
+		},
+		{
+			name:     "firing alert resolved",
+			suppress: true,
+			alerts:   []*types.Alert{acked},
+			entry:    &nflogpb.Entry{FiringAlerts: []uint64{hashAlert(acked), hashAlert(other)}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			l := &testNflog{qerr: nflog.ErrNotFound}
+			if tc.entry != nil {
+				l = &testNflog{qres: []*nflogpb.Entry{tc.entry}}
+			}
+			metrics := NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{})
+			stage := NewAcknowledgeStage(acknowledger, l, &nflogpb.Receiver{GroupName: "test"}, metrics)
+
+			var firing []uint64
+			for _, a := range tc.alerts {
+				if !a.Resolved() {
+					firing = append(firing, hashAlert(a))
+				}
+			}
+			ctx := WithGroupKey(context.Background(), "1")
+			ctx = WithFiringAlerts(ctx, firing)
+			if tc.suppress {
+				ctx = WithSuppressAcknowledgedRepeats(ctx, true)
+			}
+
+			_, out, err := stage.Exec(ctx, log.NewNopLogger(), tc.alerts...)
+			require.NoError(t, err)
+			if tc.suppressed {
+				require.Empty(t, out)
+				require.Equal(t, float64(len(tc.alerts)), prom_testutil.ToFloat64(metrics.numNotificationSuppressedTotal))
+			} else {
+				require.Equal(t, tc.alerts, out)
+			}
+		})
+	}
+}
+
 func BenchmarkHashAlert(b *testing.B) {
 	alert := &types.Alert{
 		Alert: model.Alert{
@@ -97,16 +97,23 @@ func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error)
 	var (
 		data     = notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
 		tmplText = notify.TmplText(n.tmpl, data, &err)
+		// With the markdown markup, the fields formatted by Slack are
+		// converted to mrkdwn and the others to plain text.
+		tmplMrkdwn = notify.TmplMarkdown(n.tmpl, data, &err, n.conf.Markup, template.MarkupSlack)
+		tmplPlain  = notify.TmplMarkdown(n.tmpl, data, &err, n.conf.Markup, template.MarkupPlain)
 	)
 	var markdownIn []string
 
-	if len(n.conf.MrkdwnIn) == 0 {
-		markdownIn = []string{"fallback", "pretext", "text"}
-	} else {
+	switch {
+	case len(n.conf.MrkdwnIn) > 0:
 		markdownIn = n.conf.MrkdwnIn
+	case n.conf.Markup == config.MarkupMarkdown:
+		markdownIn = []string{"pretext", "text", "fields"}
+	default:
+		markdownIn = []string{"fallback", "pretext", "text"}
 	}
 
-	title, truncated := notify.TruncateInRunes(tmplText(n.conf.Title), maxTitleLenRunes)
+	title, truncated := notify.TruncateInRunes(tmplPlain(n.conf.Title), maxTitleLenRunes)
 	if truncated {
 		key, err := notify.ExtractGroupKey(ctx)
 		if err != nil {
@@ -117,13 +124,13 @@ func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error)
 	att := &attachment{
 		Title:      title,
 		TitleLink:  tmplText(n.conf.TitleLink),
-		Pretext:    tmplText(n.conf.Pretext),
-		Text:       tmplText(n.conf.Text),
-		Fallback:   tmplText(n.conf.Fallback),
+		Pretext:    tmplMrkdwn(n.conf.Pretext),
+		Text:       tmplMrkdwn(n.conf.Text),
+		Fallback:   tmplPlain(n.conf.Fallback),
 		CallbackID: tmplText(n.conf.CallbackID),
 		ImageURL:   tmplText(n.conf.ImageURL),
 		ThumbURL:   tmplText(n.conf.ThumbURL),
-		Footer:     tmplText(n.conf.Footer),
+		Footer:     tmplPlain(n.conf.Footer),
 		Color:      tmplText(n.conf.Color),
 		MrkdwnIn:   markdownIn,
 	}
@@ -142,8 +149,8 @@ func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error)
 
 			// Rebuild the field by executing any templates and setting the new value for short
 			fields[index] = config.SlackField{
-				Title: tmplText(field.Title),
-				Value: tmplText(field.Value),
+				Title: tmplPlain(field.Title),
+				Value: tmplMrkdwn(field.Value),
 				Short: &short,
 			}
 		}
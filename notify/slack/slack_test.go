@@ -15,6 +15,7 @@ package slack
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -33,6 +34,7 @@ import (
 	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/notify"
 	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/template"
 	"github.com/prometheus/alertmanager/types"
 )
 
@@ -235,3 +237,48 @@ func TestNotifier_Notify_WithReason(t *testing.T) {
 		})
 	}
 }
+
+func TestSlackMarkdown(t *testing.T) {
+	var got request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+	u, _ := url.Parse(srv.URL)
+
+	tmpl, err := template.New()
+	require.NoError(t, err)
+	tmpl.ExternalURL = u
+
+	notifier, err := New(&config.SlackConfig{
+		HTTPConfig: &commoncfg.HTTPClientConfig{},
+		APIURL:     &config.SecretURL{URL: u},
+		Title:      `**{{ .Status }}**`,
+		Text:       `**{{ .Status }}** [{{ .CommonLabels.instance }}]({{ .ExternalURL }})`,
+		Fallback:   `**{{ .Status }}**`,
+		Fields: []*config.SlackField{
+			{Title: `*instance*`, Value: `*{{ .CommonLabels.instance }}*`},
+		},
+		Markup: config.MarkupMarkdown,
+	}, tmpl, log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	_, err = notifier.Notify(ctx, &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"instance": "<db-1>"},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+	})
+	require.NoError(t, err)
+
+	att := got.Attachments[0]
+	require.Equal(t, "firing", att.Title)
+	require.Equal(t, "*firing* <"+u.String()+"|&lt;db-1&gt;>", att.Text)
+	require.Equal(t, "firing", att.Fallback)
+	require.Equal(t, "instance", att.Fields[0].Title)
+	require.Equal(t, "_&lt;db-1&gt;_", att.Fields[0].Value)
+	require.Equal(t, []string{"pretext", "text", "fields"}, att.MrkdwnIn)
+}
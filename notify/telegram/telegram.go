@@ -50,7 +50,12 @@ func New(conf *config.TelegramConfig, t *template.Template, l log.Logger, httpOp
 		return nil, err
 	}
 
-	client, err := createTelegramClient(conf.APIUrl.String(), conf.ParseMode, httpclient)
+	parseMode := conf.ParseMode
+	if conf.Markup == config.MarkupMarkdown {
+		// Markdown is converted to HTML.
+		parseMode = "HTML"
+	}
+	client, err := createTelegramClient(conf.APIUrl.String(), parseMode, httpclient)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +76,10 @@ func (n *Notifier) Notify(ctx context.Context, alert ...*types.Alert) (bool, err
 		tmpl = notify.TmplText(n.tmpl, data, &err)
 	)
 
-	if n.conf.ParseMode == "HTML" {
+	switch {
+	case n.conf.Markup == config.MarkupMarkdown:
+		tmpl = notify.TmplMarkdown(n.tmpl, data, &err, n.conf.Markup, template.MarkupTelegram)
+	case n.conf.ParseMode == "HTML":
 		tmpl = notify.TmplHTML(n.tmpl, data, &err)
 	}
 
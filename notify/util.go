@@ -16,17 +16,20 @@ package notify
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
 	"github.com/prometheus/common/version"
 
+	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/template"
 	"github.com/prometheus/alertmanager/types"
 )
@@ -153,6 +156,19 @@ func TmplHTML(tmpl *template.Template, data *template.Data, err *error) func(str
 	}
 }
 
+// TmplMarkdown is like TmplText for templates of notification text written
+// in markdown, which is converted to the markup. The text is left as rendered
+// if the integration is not configured with the markdown markup.
+func TmplMarkdown(tmpl *template.Template, data *template.Data, err *error, markup string, to template.Markup) func(string) string {
+	tmplText := TmplText(tmpl, data, err)
+	if markup != config.MarkupMarkdown {
+		return tmplText
+	}
+	return func(name string) string {
+		return template.ConvertMarkdown(tmplText(name), to)
+	}
+}
+
 // Key is a string that can be hashed.
 type Key string
 
@@ -179,6 +195,44 @@ func (k Key) String() string {
 	return string(k)
 }
 
+// IdempotencyKey returns a key identifying a notification by its group and the
+// hashes of its firing and resolved alerts, as recorded in the notification
+// log. The retries of a notification have the same key.
+func IdempotencyKey(ctx context.Context) (string, error) {
+	key, err := ExtractGroupKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	firing, ok := FiringAlerts(ctx)
+	if !ok {
+		return "", fmt.Errorf("firing alerts missing")
+	}
+	resolved, ok := ResolvedAlerts(ctx)
+	if !ok {
+		return "", fmt.Errorf("resolved alerts missing")
+	}
+
+	h := sha256.New()
+	// hash.Hash.Write never returns an error.
+	//nolint: errcheck
+	h.Write([]byte(string(key)))
+	for _, hashes := range [][]uint64{firing, resolved} {
+		sorted := append([]uint64(nil), hashes...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		// The number of hashes separates the firing and resolved alerts.
+		b := make([]byte, 8)
+		binary.BigEndian.PutUint64(b, uint64(len(sorted)))
+		//nolint: errcheck
+		h.Write(b)
+		for _, v := range sorted {
+			binary.BigEndian.PutUint64(b, v)
+			//nolint: errcheck
+			h.Write(b)
+		}
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
 // GetTemplateData creates the template data from the context and the alerts.
 func GetTemplateData(ctx context.Context, tmpl *template.Template, alerts []*types.Alert, l log.Logger) *template.Data {
 	recv, ok := ReceiverName(ctx)
@@ -15,6 +15,7 @@ package notify
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -216,3 +217,37 @@ func TestRetrierCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestIdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+	_, err := IdempotencyKey(ctx)
+	require.EqualError(t, err, "group key missing")
+
+	ctx = WithGroupKey(ctx, "1")
+	_, err = IdempotencyKey(ctx)
+	require.EqualError(t, err, "firing alerts missing")
+
+	ctx = WithFiringAlerts(ctx, []uint64{1, 2})
+	_, err = IdempotencyKey(ctx)
+	require.EqualError(t, err, "resolved alerts missing")
+
+	ctx = WithResolvedAlerts(ctx, []uint64{3})
+	key, err := IdempotencyKey(ctx)
+	require.NoError(t, err)
+
+	// The order of the alerts doesn't matter.
+	other, err := IdempotencyKey(WithFiringAlerts(ctx, []uint64{2, 1}))
+	require.NoError(t, err)
+	require.Equal(t, key, other)
+
+	for _, c := range []context.Context{
+		WithGroupKey(ctx, "2"),
+		WithFiringAlerts(ctx, []uint64{1}),
+		WithResolvedAlerts(ctx, []uint64{}),
+		WithFiringAlerts(WithResolvedAlerts(ctx, []uint64{1, 2}), []uint64{3}),
+	} {
+		other, err := IdempotencyKey(c)
+		require.NoError(t, err)
+		require.NotEqual(t, key, other)
+	}
+}
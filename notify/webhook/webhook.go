@@ -16,12 +16,17 @@ package webhook
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
@@ -33,6 +38,17 @@ import (
 	"github.com/prometheus/alertmanager/types"
 )
 
+// The headers of the messages sent to webhooks.
+const (
+	// IdempotencyKeyHeader identifies a notification. It is the same for the
+	// retries of a notification.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// SignatureHeader holds the signature of signed messages.
+	SignatureHeader = "X-Alertmanager-Signature"
+	// TimestampHeader holds the Unix time at which signed messages were sent.
+	TimestampHeader = "X-Alertmanager-Timestamp"
+)
+
 // Notifier implements a Notifier for generic webhooks.
 type Notifier struct {
 	conf    *config.WebhookConfig
@@ -114,7 +130,20 @@ func (n *Notifier) Notify(ctx context.Context, alerts ...*types.Alert) (bool, er
 		url = strings.TrimSpace(string(content))
 	}
 
-	resp, err := notify.PostJSON(ctx, n.client, url, &buf)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		return false, notify.RedactURL(err)
+	}
+	req.Header.Set("User-Agent", notify.UserAgentHeader)
+	req.Header.Set("Content-Type", "application/json")
+	if key, err := notify.IdempotencyKey(ctx); err == nil {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	if err := n.sign(req.Header, buf.Bytes(), time.Now()); err != nil {
+		return false, err
+	}
+
+	resp, err := n.client.Do(req)
 	if err != nil {
 		return true, notify.RedactURL(err)
 	}
@@ -127,6 +156,40 @@ func (n *Notifier) Notify(ctx context.Context, alerts ...*types.Alert) (bool, er
 	return shouldRetry, err
 }
 
+// sign adds the signature of the body to the headers, if a signing secret is
+// configured. The signature is the hex-encoded HMAC-SHA256 of the timestamp
+// and the body joined by a dot.
+func (n *Notifier) sign(h http.Header, body []byte, now time.Time) error {
+	secret := []byte(n.conf.SigningSecret)
+	if n.conf.SigningSecretFile != "" {
+		content, err := os.ReadFile(n.conf.SigningSecretFile)
+		if err != nil {
+			return fmt.Errorf("read signing_secret_file: %w", err)
+		}
+		secret = bytes.TrimSpace(content)
+	}
+	if len(secret) == 0 {
+		return nil
+	}
+
+	ts := strconv.FormatInt(now.Unix(), 10)
+	h.Set(TimestampHeader, ts)
+	h.Set(SignatureHeader, "sha256="+Signature(secret, ts, body))
+	return nil
+}
+
+// Signature returns the hex-encoded HMAC-SHA256 signature of a message sent at
+// the given Unix timestamp.
+func Signature(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	// hash.Hash.Write never returns an error.
+	//nolint: errcheck
+	mac.Write([]byte(timestamp + "."))
+	//nolint: errcheck
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
 func errDetails(body io.Reader, url string) string {
 	if body == nil {
 		return url
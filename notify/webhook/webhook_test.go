@@ -15,11 +15,17 @@ package webhook
 
 import (
 	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/go-kit/log"
@@ -27,7 +33,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
 	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/template"
 	"github.com/prometheus/alertmanager/types"
 )
 
@@ -139,3 +147,67 @@ func TestWebhookReadingURLFromFile(t *testing.T) {
 
 	test.AssertNotifyLeaksNoSecret(ctx, t, notifier, u.String())
 }
+
+func TestWebhookSignatureAndIdempotencyKey(t *testing.T) {
+	var (
+		headers http.Header
+		body    []byte
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("s3cr3t\n"), 0o600))
+
+	// The notifier doesn't use the default templates.
+	tmpl, err := template.New()
+	require.NoError(t, err)
+	tmpl.ExternalURL = u
+	notifier, err := New(
+		&config.WebhookConfig{
+			URL:               &config.SecretURL{URL: u},
+			HTTPConfig:        &commoncfg.HTTPClientConfig{},
+			SigningSecretFile: secretFile,
+		},
+		tmpl,
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	ctx = notify.WithFiringAlerts(ctx, []uint64{1, 2})
+	ctx = notify.WithResolvedAlerts(ctx, []uint64{})
+	_, err = notifier.Notify(ctx, &types.Alert{})
+	require.NoError(t, err)
+
+	ts := headers.Get(TimestampHeader)
+	require.NotEmpty(t, ts)
+	mac := hmac.New(sha256.New, []byte("s3cr3t"))
+	mac.Write([]byte(ts + "." + string(body)))
+	require.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), headers.Get(SignatureHeader))
+
+	// Retries have the same key, other notifications of the group don't.
+	key := headers.Get(IdempotencyKeyHeader)
+	require.NotEmpty(t, key)
+	_, err = notifier.Notify(ctx, &types.Alert{})
+	require.NoError(t, err)
+	require.Equal(t, key, headers.Get(IdempotencyKeyHeader))
+
+	ctx = notify.WithFiringAlerts(ctx, []uint64{1})
+	ctx = notify.WithResolvedAlerts(ctx, []uint64{2})
+	_, err = notifier.Notify(ctx, &types.Alert{})
+	require.NoError(t, err)
+	require.NotEqual(t, key, headers.Get(IdempotencyKeyHeader))
+
+	// Without a secret, messages aren't signed.
+	notifier.conf.SigningSecretFile = ""
+	_, err = notifier.Notify(ctx, &types.Alert{})
+	require.NoError(t, err)
+	require.Empty(t, headers.Get(SignatureHeader))
+	require.Empty(t, headers.Get(TimestampHeader))
+}
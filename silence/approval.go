@@ -0,0 +1,85 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package silence
+
+import (
+	"errors"
+
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+var (
+	// ErrNotPendingApproval is returned when deciding on a silence that is
+	// not pending approval.
+	ErrNotPendingApproval = errors.New("silence is not pending approval")
+	// ErrSelfApproval is returned when the creator of a silence attempts to
+	// approve it.
+	ErrSelfApproval = errors.New("silence cannot be approved by its creator")
+)
+
+// awaitsApproval returns true if the silence requires approval and has not
+// been approved nor rejected yet.
+func awaitsApproval(sil *pb.Silence) bool {
+	return sil.Approval != nil && sil.Approval.State == pb.Approval_PENDING
+}
+
+// Approve approves the silence with the given ID on behalf of approver. The
+// silence mutes alerts from then on.
+func (s *Silences) Approve(id, approver, comment string) error {
+	return s.decide(id, pb.Approval_APPROVED, approver, comment)
+}
+
+// Reject rejects the silence with the given ID on behalf of approver. The
+// silence is expired immediately.
+func (s *Silences) Reject(id, approver, comment string) error {
+	return s.decide(id, pb.Approval_REJECTED, approver, comment)
+}
+
+func (s *Silences) decide(id string, state pb.Approval_State, by, comment string) error {
+	if by == "" {
+		return errors.New("approver missing")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	prev, ok := s.getSilence(id)
+	if !ok {
+		return ErrNotFound
+	}
+	now := s.nowUTC()
+	if getState(prev, now) != types.SilenceStatePendingApproval {
+		return ErrNotPendingApproval
+	}
+	if state == pb.Approval_APPROVED && by == prev.CreatedBy {
+		return ErrSelfApproval
+	}
+
+	sil := cloneSilence(prev)
+	sil.Approval = &pb.Approval{
+		State:     state,
+		DecidedBy: by,
+		DecidedAt: now,
+		Comment:   comment,
+	}
+	if state == pb.Approval_REJECTED {
+		endSilence(sil, now)
+	}
+	s.addRevision(sil, prev, now)
+
+	// As when expiring, skip validation as the silence was validated when
+	// it was created.
+	return s.setSilence(sil, now, true)
+}
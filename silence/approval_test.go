@@ -0,0 +1,97 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package silence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestSilenceApproval(t *testing.T) {
+	ss, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+
+	clock := clock.NewMock()
+	ss.clock = clock
+	now := ss.nowUTC()
+
+	m := types.NewMarker(prometheus.NewRegistry())
+	s := NewSilencer(ss, m, log.NewNopLogger())
+
+	newSilence := func() *pb.Silence {
+		return &pb.Silence{
+			Matchers:  []*pb.Matcher{{Name: "foo", Pattern: "bar"}},
+			StartsAt:  now,
+			EndsAt:    now.Add(time.Hour),
+			CreatedBy: "alice",
+			Approval:  &pb.Approval{State: pb.Approval_PENDING},
+		}
+	}
+	lset := model.LabelSet{"foo": "bar"}
+
+	id, err := ss.Set(newSilence())
+	require.NoError(t, err)
+
+	sil, err := ss.QueryOne(QIDs(id))
+	require.NoError(t, err)
+	require.Equal(t, types.SilenceStatePendingApproval, getState(sil, ss.nowUTC()))
+	require.False(t, s.Mutes(lset), "expected alert not silenced by silence pending approval")
+
+	count, err := ss.CountState(types.SilenceStatePendingApproval)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	require.Equal(t, ErrSelfApproval, ss.Approve(id, "alice", ""))
+	require.EqualError(t, ss.Approve(id, "", ""), "approver missing")
+	require.Equal(t, ErrNotFound, ss.Approve("unknown", "bob", ""))
+
+	clock.Add(time.Minute)
+	require.NoError(t, ss.Approve(id, "bob", "lgtm"))
+	require.True(t, s.Mutes(lset), "expected alert silenced by approved silence")
+
+	sil, err = ss.QueryOne(QIDs(id))
+	require.NoError(t, err)
+	require.Equal(t, &pb.Approval{
+		State:     pb.Approval_APPROVED,
+		DecidedBy: "bob",
+		DecidedAt: ss.nowUTC(),
+		Comment:   "lgtm",
+	}, sil.Approval)
+	require.Equal(t, types.SilenceStateActive, getState(sil, ss.nowUTC()))
+
+	// Deciding twice on a silence is not possible.
+	require.Equal(t, ErrNotPendingApproval, ss.Approve(id, "bob", ""))
+	require.Equal(t, ErrNotPendingApproval, ss.Reject(id, "bob", ""))
+
+	// Rejecting a silence expires it, even on behalf of its creator.
+	id, err = ss.Set(newSilence())
+	require.NoError(t, err)
+	clock.Add(time.Minute)
+	require.NoError(t, ss.Reject(id, "alice", "not needed anymore"))
+	clock.Add(time.Millisecond)
+
+	sil, err = ss.QueryOne(QIDs(id))
+	require.NoError(t, err)
+	require.Equal(t, pb.Approval_REJECTED, sil.Approval.State)
+	require.Equal(t, types.SilenceStateExpired, getState(sil, ss.nowUTC()))
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package silence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/provider"
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// SilenceExpiringAlertName is the alert name of the alerts emitted for
+// silences that are about to expire.
+const SilenceExpiringAlertName = "SilenceExpiring"
+
+// expiryCheckInterval is how often the silences are checked for upcoming
+// expiry.
+const expiryCheckInterval = 30 * time.Second
+
+// ExpiryNotifier emits an alert for every active silence ending within a
+// configured time, so that its creator can extend it or let it expire
+// deliberately. The alert resolves when the silence ends or is extended.
+type ExpiryNotifier struct {
+	silences *Silences
+	alerts   provider.Alerts
+	before   time.Duration
+	labels   model.LabelSet
+	logger   log.Logger
+
+	ctx    context.Context
+	cancel func()
+}
+
+// NewExpiryNotifier returns a new ExpiryNotifier putting alerts for the
+// silences expiring within before into alerts. The given labels are added
+// to the alerts.
+func NewExpiryNotifier(s *Silences, alerts provider.Alerts, before time.Duration, labels model.LabelSet, l log.Logger) *ExpiryNotifier {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &ExpiryNotifier{
+		silences: s,
+		alerts:   alerts,
+		before:   before,
+		labels:   labels,
+		logger:   l,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Run checks the silences periodically until the notifier is stopped.
+func (n *ExpiryNotifier) Run() {
+	t := time.NewTicker(expiryCheckInterval)
+	defer t.Stop()
+
+	for {
+		if err := n.check(); err != nil {
+			level.Error(n.logger).Log("msg", "Checking for expiring silences failed", "err", err)
+		}
+		select {
+		case <-n.ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Stop the notifier. It is safe to call on a nil notifier.
+func (n *ExpiryNotifier) Stop() {
+	if n == nil {
+		return
+	}
+	n.cancel()
+}
+
+// check fires the alerts of the silences about to expire and resolves those
+// of the silences that have been extended or expired early.
+func (n *ExpiryNotifier) check() error {
+	sils, _, err := n.silences.Query()
+	if err != nil {
+		return err
+	}
+	now := n.silences.nowUTC()
+
+	var alerts []*types.Alert
+	for _, sil := range sils {
+		a := n.alert(sil, now)
+		if getState(sil, now) == types.SilenceStateActive && sil.EndsAt.Sub(now) <= n.before {
+			alerts = append(alerts, a)
+			continue
+		}
+
+		prev, err := n.alerts.Get(a.Fingerprint())
+		if err != nil || prev.ResolvedAt(now) {
+			continue
+		}
+		a.StartsAt = prev.StartsAt
+		a.EndsAt = now
+		alerts = append(alerts, a)
+	}
+	if len(alerts) == 0 {
+		return nil
+	}
+	return n.alerts.Put(alerts...)
+}
+
+// alert returns the alert firing until the given silence expires.
+func (n *ExpiryNotifier) alert(sil *pb.Silence, now time.Time) *types.Alert {
+	ls := make(model.LabelSet, len(n.labels)+3)
+	for k, v := range n.labels {
+		ls[k] = v
+	}
+	ls[model.AlertNameLabel] = SilenceExpiringAlertName
+	ls["silence_id"] = model.LabelValue(sil.Id)
+	ls["created_by"] = model.LabelValue(sil.CreatedBy)
+
+	var matchers string
+	if ms, err := (matcherCache{}).add(sil); err == nil {
+		matchers = ms.String()
+	}
+
+	startsAt := sil.EndsAt.Add(-n.before)
+	if startsAt.Before(sil.StartsAt) {
+		startsAt = sil.StartsAt
+	}
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels: ls,
+			Annotations: model.LabelSet{
+				"summary":  model.LabelValue(fmt.Sprintf("Silence %s created by %s expires at %s", sil.Id, sil.CreatedBy, sil.EndsAt.Format(time.RFC3339))),
+				"comment":  model.LabelValue(sil.Comment),
+				"matchers": model.LabelValue(matchers),
+			},
+			StartsAt: startsAt,
+			EndsAt:   sil.EndsAt,
+		},
+		UpdatedAt: now,
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package silence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/provider/mem"
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestExpiryNotifier(t *testing.T) {
+	s, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+	clock := clock.NewMock()
+	// Alerts are merged based on the wall clock, make the silence end
+	// shortly after the current time.
+	clock.Set(time.Now().Add(-165 * time.Minute))
+	s.clock = clock
+	now := s.nowUTC()
+
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, nil, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	defer alerts.Close()
+
+	n := NewExpiryNotifier(s, alerts, time.Hour, model.LabelSet{"severity": "info"}, log.NewNopLogger())
+	defer n.Stop()
+
+	sil := &pb.Silence{
+		Matchers:  []*pb.Matcher{{Name: "a", Pattern: "b"}},
+		StartsAt:  now,
+		EndsAt:    now.Add(3 * time.Hour),
+		CreatedBy: "alice",
+		Comment:   "maintenance",
+	}
+	id, err := s.Set(sil)
+	require.NoError(t, err)
+
+	fp := model.LabelSet{
+		"alertname":  "SilenceExpiring",
+		"silence_id": model.LabelValue(id),
+		"created_by": "alice",
+		"severity":   "info",
+	}.Fingerprint()
+
+	// The silence does not expire soon.
+	require.NoError(t, n.check())
+	_, err = alerts.Get(fp)
+	require.Error(t, err)
+
+	// The silence expires within the hour.
+	clock.Add(2*time.Hour + 30*time.Minute)
+	require.NoError(t, n.check())
+	a, err := alerts.Get(fp)
+	require.NoError(t, err)
+	require.False(t, a.ResolvedAt(s.nowUTC()))
+	require.Equal(t, now.Add(2*time.Hour), a.StartsAt)
+	require.Equal(t, now.Add(3*time.Hour), a.EndsAt)
+	require.Equal(t, model.LabelValue("maintenance"), a.Annotations["comment"])
+	require.Equal(t, model.LabelValue(`{a="b"}`), a.Annotations["matchers"])
+
+	// Extending the silence resolves the alert.
+	sil, err = s.QueryOne(QIDs(id))
+	require.NoError(t, err)
+	sil = cloneSilence(sil)
+	sil.EndsAt = now.Add(24 * time.Hour)
+	_, err = s.Set(sil)
+	require.NoError(t, err)
+
+	require.NoError(t, n.check())
+	a, err = alerts.Get(fp)
+	require.NoError(t, err)
+	require.True(t, a.ResolvedAt(s.nowUTC()))
+	require.Equal(t, now.Add(2*time.Hour), a.StartsAt)
+
+	// Expiring the silence early does not fire the alert again.
+	require.NoError(t, s.Expire(id))
+	require.NoError(t, n.check())
+	a, err = alerts.Get(fp)
+	require.NoError(t, err)
+	require.True(t, a.ResolvedAt(s.nowUTC()))
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package silence
+
+import (
+	"time"
+
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+)
+
+// addRevision records prev as the latest revision of sil, which is about to
+// replace it. Revisions superseded for longer than the retention time are
+// dropped.
+func (s *Silences) addRevision(sil, prev *pb.Silence, now time.Time) {
+	rev := cloneSilence(prev)
+	rev.Revisions = nil
+
+	revs := make([]*pb.Silence, 0, len(prev.Revisions)+1)
+	revs = append(append(revs, prev.Revisions...), rev)
+
+	// A revision is superseded at the update time of its successor. The
+	// latest revision is superseded now and always kept.
+	cutoff := now.Add(-s.retention)
+	for len(revs) > 1 && revs[1].UpdatedAt.Before(cutoff) {
+		revs = revs[1:]
+	}
+	sil.Revisions = revs
+}
+
+// History returns the revisions of the silence with the given ID, oldest
+// first. The last revision is the current state of the silence.
+func (s *Silences) History(id string) ([]*pb.Silence, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	sil, ok := s.getSilence(id)
+	if !ok {
+		return nil, ErrNotFound
+	}
+	cur := cloneSilence(sil)
+	cur.Revisions = nil
+
+	return append(append(make([]*pb.Silence, 0, len(sil.Revisions)+1), sil.Revisions...), cur), nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package silence
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+)
+
+func TestSilenceHistory(t *testing.T) {
+	s, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+
+	clock := clock.NewMock()
+	s.clock = clock
+	now := s.nowUTC()
+
+	_, err = s.History("unknown")
+	require.Equal(t, ErrNotFound, err)
+
+	sil := &pb.Silence{
+		Matchers:  []*pb.Matcher{{Name: "a", Pattern: "b"}},
+		StartsAt:  now,
+		EndsAt:    now.Add(24 * time.Hour),
+		CreatedBy: "alice",
+		Comment:   "maintenance",
+	}
+	id, err := s.Set(sil)
+	require.NoError(t, err)
+
+	history, err := s.History(id)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, "alice", history[0].CreatedBy)
+
+	// Update the silence in place.
+	clock.Add(time.Minute)
+	sil = cloneSilence(sil)
+	sil.Comment = "extended maintenance"
+	sil.CreatedBy = "bob"
+	_, err = s.Set(sil)
+	require.NoError(t, err)
+
+	// Change the matchers, replacing the silence.
+	clock.Add(time.Minute)
+	sil = cloneSilence(sil)
+	sil.Matchers = []*pb.Matcher{{Name: "a", Pattern: "c"}}
+	sil.CreatedBy = "carol"
+	newID, err := s.Set(sil)
+	require.NoError(t, err)
+	require.NotEqual(t, id, newID)
+
+	type revision struct {
+		id, createdBy, comment, pattern string
+		updatedAt, endsAt               time.Time
+	}
+	revisions := func(id string) []revision {
+		history, err := s.History(id)
+		require.NoError(t, err)
+		var res []revision
+		for _, r := range history {
+			require.Empty(t, r.Revisions)
+			res = append(res, revision{r.Id, r.CreatedBy, r.Comment, r.Matchers[0].Pattern, r.UpdatedAt, r.EndsAt})
+		}
+		return res
+	}
+	require.Equal(t, []revision{
+		{id, "alice", "maintenance", "b", now, now.Add(24 * time.Hour)},
+		{id, "bob", "extended maintenance", "b", now.Add(time.Minute), now.Add(24 * time.Hour)},
+		{id, "bob", "extended maintenance", "b", now.Add(2 * time.Minute), now.Add(2 * time.Minute)},
+		{newID, "carol", "extended maintenance", "c", now.Add(2 * time.Minute), now.Add(24 * time.Hour)},
+	}, revisions(newID))
+	require.Len(t, revisions(id), 3)
+
+	// The history is replicated along with the silence.
+	var buf bytes.Buffer
+	_, err = s.Snapshot(&buf)
+	require.NoError(t, err)
+	s2, err := New(Options{SnapshotReader: &buf})
+	require.NoError(t, err)
+	history, err = s2.History(newID)
+	require.NoError(t, err)
+	require.Len(t, history, 4)
+
+	// Revisions superseded for longer than the retention time are dropped
+	// on the next change.
+	clock.Add(2 * time.Hour)
+	sil = cloneSilence(sil)
+	sil.Comment = "even longer maintenance"
+	_, err = s.Set(sil)
+	require.NoError(t, err)
+
+	require.Equal(t, []revision{
+		{newID, "carol", "extended maintenance", "c", now.Add(2 * time.Minute), now.Add(24 * time.Hour)},
+		{newID, "carol", "even longer maintenance", "c", now.Add(122 * time.Minute), now.Add(24 * time.Hour)},
+	}, revisions(newID))
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package silence
+
+import (
+	"github.com/prometheus/common/model"
+
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+)
+
+// labelPair is a label name and value an equality matcher requires.
+type labelPair struct {
+	name, value string
+}
+
+// matcherIndex is an inverted index of silences by their equality matchers.
+// A silence can only match label sets containing the label pair of any of
+// its equality matchers with a non-empty value, so it is indexed under one
+// of them. Silences without such a matcher are candidates for any label set.
+type matcherIndex struct {
+	pairs     map[labelPair]map[string]struct{}
+	unindexed map[string]struct{}
+	// keys holds the label pair each indexed silence is indexed under.
+	keys map[string]labelPair
+}
+
+func newMatcherIndex() *matcherIndex {
+	return &matcherIndex{
+		pairs:     map[labelPair]map[string]struct{}{},
+		unindexed: map[string]struct{}{},
+		keys:      map[string]labelPair{},
+	}
+}
+
+// indexKey returns the label pair the silence is indexed under, if any.
+func indexKey(sil *pb.Silence) (labelPair, bool) {
+	for _, m := range sil.Matchers {
+		if m.Type == pb.Matcher_EQUAL && m.Pattern != "" {
+			return labelPair{name: m.Name, value: m.Pattern}, true
+		}
+	}
+	return labelPair{}, false
+}
+
+// add indexes the silence, replacing any previous version of it.
+func (mi *matcherIndex) add(sil *pb.Silence) {
+	mi.delete(sil.Id)
+
+	key, ok := indexKey(sil)
+	if !ok {
+		mi.unindexed[sil.Id] = struct{}{}
+		return
+	}
+	ids, ok := mi.pairs[key]
+	if !ok {
+		ids = map[string]struct{}{}
+		mi.pairs[key] = ids
+	}
+	ids[sil.Id] = struct{}{}
+	mi.keys[sil.Id] = key
+}
+
+// delete removes the silence with the given ID from the index.
+func (mi *matcherIndex) delete(id string) {
+	delete(mi.unindexed, id)
+
+	key, ok := mi.keys[id]
+	if !ok {
+		return
+	}
+	delete(mi.keys, id)
+	ids := mi.pairs[key]
+	delete(ids, id)
+	if len(ids) == 0 {
+		delete(mi.pairs, key)
+	}
+}
+
+// candidates returns the IDs of the silences that may match the label set.
+func (mi *matcherIndex) candidates(lset model.LabelSet) []string {
+	res := make([]string, 0, len(mi.unindexed))
+	for id := range mi.unindexed {
+		res = append(res, id)
+	}
+	for name, value := range lset {
+		for id := range mi.pairs[labelPair{name: string(name), value: string(value)}] {
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+// reindex rebuilds the index from the current state.
+func (s *Silences) reindex() {
+	s.mi = newMatcherIndex()
+	for _, e := range s.st {
+		s.mi.add(e.Silence)
+	}
+}
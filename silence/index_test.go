@@ -0,0 +1,128 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package silence
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+)
+
+func TestMatcherIndex(t *testing.T) {
+	mi := newMatcherIndex()
+	candidates := func(lset model.LabelSet) []string {
+		res := mi.candidates(lset)
+		sort.Strings(res)
+		return res
+	}
+
+	mi.add(&pb.Silence{Id: "eq", Matchers: []*pb.Matcher{
+		{Type: pb.Matcher_REGEXP, Name: "a", Pattern: "b.*"},
+		{Type: pb.Matcher_EQUAL, Name: "c", Pattern: "d"},
+	}})
+	mi.add(&pb.Silence{Id: "re", Matchers: []*pb.Matcher{
+		{Type: pb.Matcher_REGEXP, Name: "a", Pattern: "b.*"},
+	}})
+	mi.add(&pb.Silence{Id: "empty", Matchers: []*pb.Matcher{
+		{Type: pb.Matcher_EQUAL, Name: "a", Pattern: ""},
+	}})
+
+	require.Equal(t, []string{"empty", "eq", "re"}, candidates(model.LabelSet{"a": "b", "c": "d"}))
+	require.Equal(t, []string{"empty", "re"}, candidates(model.LabelSet{"a": "b", "c": "e"}))
+
+	// Replacing a silence indexes it under its new matchers.
+	mi.add(&pb.Silence{Id: "eq", Matchers: []*pb.Matcher{
+		{Type: pb.Matcher_EQUAL, Name: "c", Pattern: "e"},
+	}})
+	require.Equal(t, []string{"empty", "re"}, candidates(model.LabelSet{"a": "b", "c": "d"}))
+	require.Equal(t, []string{"empty", "eq", "re"}, candidates(model.LabelSet{"a": "b", "c": "e"}))
+
+	mi.delete("eq")
+	mi.delete("re")
+	require.Equal(t, []string{"empty"}, candidates(model.LabelSet{"a": "b", "c": "e"}))
+	require.Empty(t, mi.pairs)
+	require.Empty(t, mi.keys)
+}
+
+func TestSilencesIndexMaintenance(t *testing.T) {
+	s, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+	clock := clock.NewMock()
+	s.clock = clock
+	now := s.nowUTC()
+
+	query := func(s *Silences, lset model.LabelSet) []string {
+		sils, _, err := s.Query(QMatches(lset))
+		require.NoError(t, err)
+		var ids []string
+		for _, sil := range sils {
+			ids = append(ids, sil.Id)
+		}
+		sort.Strings(ids)
+		return ids
+	}
+
+	id1, err := s.Set(&pb.Silence{
+		Matchers: []*pb.Matcher{{Type: pb.Matcher_EQUAL, Name: "a", Pattern: "b"}},
+		StartsAt: now,
+		EndsAt:   now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	id2, err := s.Set(&pb.Silence{
+		Matchers: []*pb.Matcher{{Type: pb.Matcher_NOT_EQUAL, Name: "a", Pattern: "c"}},
+		StartsAt: now,
+		EndsAt:   now.Add(2 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	lset := model.LabelSet{"a": "b"}
+	require.Equal(t, sortedIDs(id1, id2), query(s, lset))
+	require.Empty(t, query(s, model.LabelSet{"a": "c"}))
+
+	// Silences received from peers or loaded from a snapshot are indexed.
+	var buf bytes.Buffer
+	_, err = s.Snapshot(&buf)
+	require.NoError(t, err)
+	b := buf.Bytes()
+
+	s2, err := New(Options{SnapshotReader: bytes.NewReader(b)})
+	require.NoError(t, err)
+	require.Equal(t, sortedIDs(id1, id2), query(s2, lset))
+
+	s3, err := New(Options{})
+	require.NoError(t, err)
+	s3.clock = clock
+	require.NoError(t, s3.Merge(b))
+	require.Equal(t, sortedIDs(id1, id2), query(s3, lset))
+
+	// Garbage collected silences are removed from the index.
+	clock.Add(2*time.Hour + time.Minute)
+	n, err := s.GC()
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, []string{id2}, query(s, lset))
+	require.NotContains(t, s.mi.keys, id1)
+}
+
+func sortedIDs(ids ...string) []string {
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,219 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package silence
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/alertmanager/pkg/labels"
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// ErrNotConsolidatable is returned when consolidating silences none of which
+// mutes all alerts muted by the others whenever they mute them.
+var ErrNotConsolidatable = errors.New("none of the silences mutes all alerts muted by the others")
+
+// OverlapKind describes how the matchers of two overlapping silences relate.
+type OverlapKind string
+
+const (
+	// OverlapDuplicate means both silences mute the same alerts.
+	OverlapDuplicate OverlapKind = "duplicate"
+	// OverlapSubsumes means a silence mutes all alerts muted by the other.
+	OverlapSubsumes OverlapKind = "subsumes"
+)
+
+// Overlap is a pair of silences with overlapping time ranges, where Silence
+// mutes all alerts muted by Other.
+type Overlap struct {
+	Kind    OverlapKind
+	Silence *pb.Silence
+	Other   *pb.Silence
+}
+
+// Overlaps returns the pairs of silences that have not expired yet, whose
+// time ranges overlap and one of which mutes all alerts muted by the other.
+// Recurring silences are left out as they mute alerts at different times.
+func (s *Silences) Overlaps() ([]Overlap, error) {
+	sils, _, err := s.Query(QState(types.SilenceStateActive, types.SilenceStatePending, types.SilenceStatePendingApproval))
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(sils, func(i, j int) bool { return sils[i].Id < sils[j].Id })
+
+	mc := matcherCache{}
+	var res []Overlap
+	for i, a := range sils {
+		if len(a.TimeIntervals) > 0 {
+			continue
+		}
+		am, err := mc.Get(a)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range sils[i+1:] {
+			if len(b.TimeIntervals) > 0 || !a.StartsAt.Before(b.EndsAt) || !b.StartsAt.Before(a.EndsAt) {
+				continue
+			}
+			bm, err := mc.Get(b)
+			if err != nil {
+				return nil, err
+			}
+			ab, ba := subsumes(am, bm), subsumes(bm, am)
+			switch {
+			case ab && ba:
+				res = append(res, Overlap{Kind: OverlapDuplicate, Silence: a, Other: b})
+			case ab:
+				res = append(res, Overlap{Kind: OverlapSubsumes, Silence: a, Other: b})
+			case ba:
+				res = append(res, Overlap{Kind: OverlapSubsumes, Silence: b, Other: a})
+			}
+		}
+	}
+	return res, nil
+}
+
+// Consolidated returns a new silence that can replace the silences with the
+// given IDs. It has the matchers and the time range of the silence muting all
+// alerts muted by the others at all times they mute them, and combines their
+// comments. The silence is neither saved nor are the given silences expired.
+func (s *Silences) Consolidated(ids []string) (*pb.Silence, error) {
+	if len(ids) < 2 {
+		return nil, errors.New("at least two silences are required")
+	}
+	now := s.nowUTC()
+	sils := make([]*pb.Silence, 0, len(ids))
+	for _, id := range ids {
+		sil, err := s.QueryOne(QIDs(id))
+		if err != nil {
+			return nil, fmt.Errorf("silence %s: %w", id, err)
+		}
+		switch getState(sil, now) {
+		case types.SilenceStateExpired:
+			return nil, fmt.Errorf("silence %s has expired", id)
+		case types.SilenceStatePendingApproval:
+			// It mutes nothing yet, replacing it would bypass its approval.
+			return nil, fmt.Errorf("silence %s is pending approval", id)
+		}
+		if len(sil.TimeIntervals) > 0 {
+			return nil, fmt.Errorf("silence %s is recurring", id)
+		}
+		sils = append(sils, sil)
+	}
+
+	// Only the remaining time ranges matter, as the new silence mutes alerts
+	// from now on.
+	startsAt := func(sil *pb.Silence) time.Time {
+		if sil.StartsAt.Before(now) {
+			return now
+		}
+		return sil.StartsAt
+	}
+
+	mc := matcherCache{}
+	var broadest, outlasting *pb.Silence
+	for _, a := range sils {
+		am, err := mc.Get(a)
+		if err != nil {
+			return nil, err
+		}
+		all := true
+		var outside *pb.Silence
+		for _, b := range sils {
+			bm, err := mc.Get(b)
+			if err != nil {
+				return nil, err
+			}
+			if !subsumes(am, bm) {
+				all = false
+				break
+			}
+			if outside == nil && (startsAt(b).Before(startsAt(a)) || b.EndsAt.After(a.EndsAt)) {
+				outside = b
+			}
+		}
+		if !all {
+			continue
+		}
+		if outside == nil {
+			broadest = a
+			break
+		}
+		if outlasting == nil {
+			outlasting = outside
+		}
+	}
+	if broadest == nil {
+		if outlasting != nil {
+			return nil, fmt.Errorf("silence %s is not within the time range of the silences muting all alerts: %w", outlasting.Id, ErrNotConsolidatable)
+		}
+		return nil, ErrNotConsolidatable
+	}
+
+	res := &pb.Silence{
+		Matchers: cloneSilence(broadest).Matchers,
+		StartsAt: broadest.StartsAt,
+		EndsAt:   broadest.EndsAt,
+	}
+	var comments []string
+	seen := map[string]struct{}{}
+	for _, sil := range sils {
+		if _, ok := seen[sil.Comment]; ok || sil.Comment == "" {
+			continue
+		}
+		seen[sil.Comment] = struct{}{}
+		comments = append(comments, sil.Comment)
+	}
+	res.Comment = strings.Join(comments, "; ")
+
+	return res, nil
+}
+
+// subsumes returns true if every label set matched by b is also matched by
+// a. It errs on the side of false negatives as it relies on the matchers
+// only rather than on the languages of their regular expressions.
+func subsumes(a, b labels.Matchers) bool {
+	for _, ma := range a {
+		implied := false
+		for _, mb := range b {
+			if mb.Name == ma.Name && implies(mb, ma) {
+				implied = true
+				break
+			}
+		}
+		if !implied {
+			return false
+		}
+	}
+	return true
+}
+
+// implies returns true if every label value matched by mb is also matched by
+// ma, which are matchers on the same label.
+func implies(mb, ma *labels.Matcher) bool {
+	switch {
+	case mb.Type == ma.Type && mb.Value == ma.Value:
+		return true
+	case mb.Type == labels.MatchEqual:
+		return ma.Matches(mb.Value)
+	case mb.Type == labels.MatchRegexp && ma.Type == labels.MatchNotEqual:
+		return !mb.Matches(ma.Value)
+	}
+	return false
+}
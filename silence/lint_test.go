@@ -0,0 +1,168 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package silence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/pkg/labels"
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+)
+
+func TestSubsumes(t *testing.T) {
+	m := func(mt labels.MatchType, n, v string) *labels.Matcher {
+		matcher, err := labels.NewMatcher(mt, n, v)
+		require.NoError(t, err)
+		return matcher
+	}
+	for _, tc := range []struct {
+		a, b     labels.Matchers
+		subsumes bool
+	}{
+		{
+			a:        labels.Matchers{m(labels.MatchEqual, "a", "b")},
+			b:        labels.Matchers{m(labels.MatchEqual, "a", "b")},
+			subsumes: true,
+		},
+		{
+			a:        labels.Matchers{m(labels.MatchEqual, "a", "b")},
+			b:        labels.Matchers{m(labels.MatchEqual, "a", "b"), m(labels.MatchEqual, "c", "d")},
+			subsumes: true,
+		},
+		{
+			a:        labels.Matchers{m(labels.MatchEqual, "a", "b"), m(labels.MatchEqual, "c", "d")},
+			b:        labels.Matchers{m(labels.MatchEqual, "a", "b")},
+			subsumes: false,
+		},
+		{
+			a:        labels.Matchers{m(labels.MatchRegexp, "a", "b|c")},
+			b:        labels.Matchers{m(labels.MatchEqual, "a", "b")},
+			subsumes: true,
+		},
+		{
+			a:        labels.Matchers{m(labels.MatchNotEqual, "a", "d")},
+			b:        labels.Matchers{m(labels.MatchRegexp, "a", "b|c")},
+			subsumes: true,
+		},
+		{
+			a:        labels.Matchers{m(labels.MatchNotEqual, "a", "b")},
+			b:        labels.Matchers{m(labels.MatchRegexp, "a", "b|c")},
+			subsumes: false,
+		},
+		{
+			a:        labels.Matchers{m(labels.MatchRegexp, "a", "b|c")},
+			b:        labels.Matchers{m(labels.MatchRegexp, "a", "b")},
+			subsumes: false,
+		},
+		{
+			a:        labels.Matchers{m(labels.MatchNotRegexp, "a", "x.*")},
+			b:        labels.Matchers{m(labels.MatchNotRegexp, "a", "x.*"), m(labels.MatchEqual, "c", "d")},
+			subsumes: true,
+		},
+	} {
+		require.Equal(t, tc.subsumes, subsumes(tc.a, tc.b), "%s subsumes %s", tc.a, tc.b)
+	}
+}
+
+func TestSilencesOverlapsAndConsolidated(t *testing.T) {
+	s, err := New(Options{})
+	require.NoError(t, err)
+	clock := clock.NewMock()
+	s.clock = clock
+	now := s.nowUTC()
+
+	set := func(comment string, start, end time.Duration, ms ...*pb.Matcher) string {
+		id, err := s.Set(&pb.Silence{
+			Matchers: ms,
+			StartsAt: now.Add(start),
+			EndsAt:   now.Add(end),
+			Comment:  comment,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	eq := func(n, v string) *pb.Matcher { return &pb.Matcher{Type: pb.Matcher_EQUAL, Name: n, Pattern: v} }
+	re := func(n, v string) *pb.Matcher { return &pb.Matcher{Type: pb.Matcher_REGEXP, Name: n, Pattern: v} }
+
+	broad := set("upgrade", 0, time.Hour, re("service", "api|web"))
+	narrow := set("upgrade api", 30*time.Minute, 2*time.Hour, eq("service", "api"), eq("env", "prod"))
+	dup := set("upgrade", 0, time.Hour, re("service", "api|web"))
+	// Does not overlap in time.
+	later := set("later", 3*time.Hour, 4*time.Hour, eq("service", "api"))
+	// Unrelated.
+	db := set("db", 0, time.Hour, eq("service", "db"))
+
+	overlaps, err := s.Overlaps()
+	require.NoError(t, err)
+
+	type overlap struct {
+		kind           OverlapKind
+		silence, other string
+	}
+	var got []overlap
+	for _, o := range overlaps {
+		got = append(got, overlap{o.Kind, o.Silence.Id, o.Other.Id})
+	}
+	want := []overlap{
+		{OverlapSubsumes, broad, narrow},
+		{OverlapSubsumes, dup, narrow},
+	}
+	if broad < dup {
+		want = append(want, overlap{OverlapDuplicate, broad, dup})
+	} else {
+		want = append(want, overlap{OverlapDuplicate, dup, broad})
+	}
+	require.ElementsMatch(t, want, got)
+
+	// The narrow silence outlasts the broad ones, whose matchers would
+	// otherwise mute alerts nobody silenced after they end.
+	_, err = s.Consolidated([]string{narrow, broad, dup})
+	require.ErrorIs(t, err, ErrNotConsolidatable)
+	require.ErrorContains(t, err, "silence "+narrow+" is not within the time range")
+	// Neither are disjoint silences consolidated over the gap between them.
+	_, err = s.Consolidated([]string{broad, later})
+	require.ErrorIs(t, err, ErrNotConsolidatable)
+
+	inner := set("upgrade api", 15*time.Minute, 45*time.Minute, eq("service", "api"), eq("env", "prod"))
+	sil, err := s.Consolidated([]string{inner, broad, dup})
+	require.NoError(t, err)
+	require.Equal(t, &pb.Silence{
+		Matchers: []*pb.Matcher{re("service", "api|web")},
+		StartsAt: now,
+		EndsAt:   now.Add(time.Hour),
+		Comment:  "upgrade api; upgrade",
+	}, sil)
+
+	// Silences pending approval mute nothing yet.
+	pending, err := s.Set(&pb.Silence{
+		Matchers: []*pb.Matcher{eq("service", "web")},
+		StartsAt: now,
+		EndsAt:   now.Add(time.Hour),
+		Approval: &pb.Approval{State: pb.Approval_PENDING},
+	})
+	require.NoError(t, err)
+	_, err = s.Consolidated([]string{broad, pending})
+	require.EqualError(t, err, "silence "+pending+" is pending approval")
+
+	_, err = s.Consolidated([]string{narrow, db})
+	require.Equal(t, ErrNotConsolidatable, err)
+	_, err = s.Consolidated([]string{narrow})
+	require.EqualError(t, err, "at least two silences are required")
+	_, err = s.Consolidated([]string{narrow, "unknown"})
+	require.EqualError(t, err, "silence unknown: silence not found")
+}
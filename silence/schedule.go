@@ -0,0 +1,306 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package silence
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log/level"
+
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/timeinterval"
+)
+
+// scheduleLookahead bounds how far into the future the next active window of
+// a recurring silence is searched for.
+const scheduleLookahead = 366 * 24 * time.Hour
+
+// scheduleCache holds the time intervals of recurring silences by silence ID,
+// sparing the conversion from protobuf (including loading time zones) on
+// every evaluation.
+type scheduleCache struct {
+	mtx     sync.Mutex
+	entries map[string]scheduleCacheEntry
+}
+
+type scheduleCacheEntry struct {
+	updatedAt time.Time
+	tis       []timeinterval.TimeInterval
+}
+
+func newScheduleCache() *scheduleCache {
+	return &scheduleCache{entries: map[string]scheduleCacheEntry{}}
+}
+
+// Get retrieves the time intervals for a given silence. If it is a missed
+// cache access or the silence was updated since, it converts and adds the
+// time intervals of the requested silence to the cache.
+func (c *scheduleCache) Get(s *pb.Silence) ([]timeinterval.TimeInterval, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, ok := c.entries[s.Id]; ok && e.updatedAt.Equal(s.UpdatedAt) {
+		return e.tis, nil
+	}
+	tis, err := TimeIntervalsFromProto(s.TimeIntervals)
+	if err != nil {
+		return nil, err
+	}
+	c.entries[s.Id] = scheduleCacheEntry{updatedAt: s.UpdatedAt, tis: tis}
+	return tis, nil
+}
+
+// Delete removes the time intervals of the silence with the given ID.
+func (c *scheduleCache) Delete(id string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	delete(c.entries, id)
+}
+
+// TimeIntervalsToProto converts time intervals into their protobuf
+// representation.
+func TimeIntervalsToProto(tis []timeinterval.TimeInterval) []*pb.TimeInterval {
+	if len(tis) == 0 {
+		return nil
+	}
+	inclusiveRanges := func(n int, get func(int) timeinterval.InclusiveRange) []*pb.TimeInterval_InclusiveRange {
+		if n == 0 {
+			return nil
+		}
+		res := make([]*pb.TimeInterval_InclusiveRange, 0, n)
+		for i := 0; i < n; i++ {
+			r := get(i)
+			res = append(res, &pb.TimeInterval_InclusiveRange{Begin: int32(r.Begin), End: int32(r.End)})
+		}
+		return res
+	}
+
+	res := make([]*pb.TimeInterval, 0, len(tis))
+	for _, ti := range tis {
+		pti := &pb.TimeInterval{
+			Weekdays: inclusiveRanges(len(ti.Weekdays), func(i int) timeinterval.InclusiveRange {
+				return ti.Weekdays[i].InclusiveRange
+			}),
+			DaysOfMonth: inclusiveRanges(len(ti.DaysOfMonth), func(i int) timeinterval.InclusiveRange {
+				return ti.DaysOfMonth[i].InclusiveRange
+			}),
+			Months: inclusiveRanges(len(ti.Months), func(i int) timeinterval.InclusiveRange {
+				return ti.Months[i].InclusiveRange
+			}),
+			Years: inclusiveRanges(len(ti.Years), func(i int) timeinterval.InclusiveRange {
+				return ti.Years[i].InclusiveRange
+			}),
+		}
+		for _, tr := range ti.Times {
+			pti.Times = append(pti.Times, &pb.TimeInterval_TimeRange{
+				StartMinute: int32(tr.StartMinute),
+				EndMinute:   int32(tr.EndMinute),
+			})
+		}
+		if ti.Location != nil && ti.Location.Location != nil {
+			pti.Location = ti.Location.String()
+		}
+		res = append(res, pti)
+	}
+	return res
+}
+
+// TimeIntervalsFromProto converts the protobuf representation of time
+// intervals back into time intervals. It returns an error if the time zone
+// of an interval cannot be loaded.
+func TimeIntervalsFromProto(ptis []*pb.TimeInterval) ([]timeinterval.TimeInterval, error) {
+	if len(ptis) == 0 {
+		return nil, nil
+	}
+	inclusiveRange := func(r *pb.TimeInterval_InclusiveRange) timeinterval.InclusiveRange {
+		return timeinterval.InclusiveRange{Begin: int(r.Begin), End: int(r.End)}
+	}
+
+	res := make([]timeinterval.TimeInterval, 0, len(ptis))
+	for _, pti := range ptis {
+		var ti timeinterval.TimeInterval
+		for _, tr := range pti.Times {
+			ti.Times = append(ti.Times, timeinterval.TimeRange{StartMinute: int(tr.StartMinute), EndMinute: int(tr.EndMinute)})
+		}
+		for _, r := range pti.Weekdays {
+			ti.Weekdays = append(ti.Weekdays, timeinterval.WeekdayRange{InclusiveRange: inclusiveRange(r)})
+		}
+		for _, r := range pti.DaysOfMonth {
+			ti.DaysOfMonth = append(ti.DaysOfMonth, timeinterval.DayOfMonthRange{InclusiveRange: inclusiveRange(r)})
+		}
+		for _, r := range pti.Months {
+			ti.Months = append(ti.Months, timeinterval.MonthRange{InclusiveRange: inclusiveRange(r)})
+		}
+		for _, r := range pti.Years {
+			ti.Years = append(ti.Years, timeinterval.YearRange{InclusiveRange: inclusiveRange(r)})
+		}
+		if pti.Location != "" {
+			loc, err := time.LoadLocation(pti.Location)
+			if err != nil {
+				return nil, err
+			}
+			ti.Location = &timeinterval.Location{Location: loc}
+		}
+		res = append(res, ti)
+	}
+	return res, nil
+}
+
+func validateTimeInterval(ti *pb.TimeInterval) error {
+	for _, tr := range ti.Times {
+		if tr.StartMinute < 0 || tr.EndMinute > 24*60 || tr.StartMinute >= tr.EndMinute {
+			return fmt.Errorf("invalid time range %d-%d", tr.StartMinute, tr.EndMinute)
+		}
+	}
+	checkRanges := func(name string, rs []*pb.TimeInterval_InclusiveRange, min, max int32) error {
+		for _, r := range rs {
+			if r.Begin < min || r.End > max || r.Begin > r.End {
+				return fmt.Errorf("invalid %s range %d:%d", name, r.Begin, r.End)
+			}
+		}
+		return nil
+	}
+	if err := checkRanges("weekday", ti.Weekdays, 0, 6); err != nil {
+		return err
+	}
+	for _, r := range ti.DaysOfMonth {
+		if r.Begin == 0 || r.End == 0 || r.Begin < -31 || r.Begin > 31 || r.End < -31 || r.End > 31 {
+			return fmt.Errorf("invalid day of month range %d:%d", r.Begin, r.End)
+		}
+	}
+	if err := checkRanges("month", ti.Months, 1, 12); err != nil {
+		return err
+	}
+	if err := checkRanges("year", ti.Years, 0, 9999); err != nil {
+		return err
+	}
+	if ti.Location != "" {
+		if _, err := time.LoadLocation(ti.Location); err != nil {
+			return fmt.Errorf("invalid location: %w", err)
+		}
+	}
+	return nil
+}
+
+// scheduleContains returns true if the time is within one of the time
+// intervals.
+func scheduleContains(tis []timeinterval.TimeInterval, t time.Time) bool {
+	for _, ti := range tis {
+		if ti.ContainsTime(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// nextBoundary returns the first instant after t at which the result of
+// scheduleContains may change. These are the beginnings of days and the
+// edges of time ranges, in the location of each time interval.
+func nextBoundary(tis []timeinterval.TimeInterval, t time.Time) time.Time {
+	var next time.Time
+	for _, ti := range tis {
+		lt := t
+		if ti.Location != nil {
+			lt = t.In(ti.Location.Location)
+		}
+		y, m, d := lt.Date()
+		candidates := []time.Time{time.Date(y, m, d+1, 0, 0, 0, 0, lt.Location())}
+		for _, tr := range ti.Times {
+			candidates = append(candidates,
+				time.Date(y, m, d, 0, tr.StartMinute, 0, 0, lt.Location()),
+				time.Date(y, m, d, 0, tr.EndMinute, 0, 0, lt.Location()),
+			)
+		}
+		for _, c := range candidates {
+			if c.After(t) && (next.IsZero() || c.Before(next)) {
+				next = c
+			}
+		}
+	}
+	return next
+}
+
+// nextActiveWindow returns the first window of time, starting at or after
+// from and ending at the latest at until, during which the time intervals
+// are active. The search is bounded by scheduleLookahead.
+func nextActiveWindow(tis []timeinterval.TimeInterval, from, until time.Time) (start, end time.Time, ok bool) {
+	if limit := from.Add(scheduleLookahead); limit.Before(until) {
+		until = limit
+	}
+	for t := from; t.Before(until); t = nextBoundary(tis, t) {
+		if !scheduleContains(tis, t) {
+			continue
+		}
+		start = t
+		for t.Before(until) && scheduleContains(tis, t) {
+			t = nextBoundary(tis, t)
+		}
+		if t.After(until) {
+			t = until
+		}
+		return start, t, true
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+// inSchedule returns true if the silence mutes alerts at the given time
+// according to its time intervals. Silences without time intervals are
+// always in schedule.
+func (s *Silences) inSchedule(sil *pb.Silence, now time.Time) bool {
+	if len(sil.TimeIntervals) == 0 {
+		return true
+	}
+	tis, err := s.sc.Get(sil)
+	if err != nil {
+		// Err on the side of muting as the silence is active.
+		level.Error(s.logger).Log("msg", "Failed to load time intervals of silence", "silence", sil.Id, "err", err)
+		return true
+	}
+	return scheduleContains(tis, now)
+}
+
+// ErrNoActiveWindow is returned if a silence will not mute alerts anymore.
+var ErrNoActiveWindow = errors.New("no upcoming active window")
+
+// NextActiveWindow returns the next window of time during which the silence
+// mutes alerts. If the silence is currently muting alerts, the current window
+// is returned. For silences without time intervals, this is the time range
+// of the silence. ErrNoActiveWindow is returned if the silence does not mute
+// alerts anymore.
+func (s *Silences) NextActiveWindow(sil *pb.Silence) (time.Time, time.Time, error) {
+	now := s.nowUTC()
+	if !now.Before(sil.EndsAt) {
+		return time.Time{}, time.Time{}, ErrNoActiveWindow
+	}
+	from := sil.StartsAt
+	if from.Before(now) {
+		from = now
+	}
+	if len(sil.TimeIntervals) == 0 {
+		return from, sil.EndsAt, nil
+	}
+
+	tis, err := s.sc.Get(sil)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	start, end, ok := nextActiveWindow(tis, from, sil.EndsAt)
+	if !ok {
+		return time.Time{}, time.Time{}, ErrNoActiveWindow
+	}
+	return start, end, nil
+}
@@ -0,0 +1,239 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package silence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/timeinterval"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// workingHours is Monday to Friday, 09:00 to 17:00 UTC.
+var workingHours = []*pb.TimeInterval{
+	{
+		Times:    []*pb.TimeInterval_TimeRange{{StartMinute: 9 * 60, EndMinute: 17 * 60}},
+		Weekdays: []*pb.TimeInterval_InclusiveRange{{Begin: 1, End: 5}},
+	},
+}
+
+func TestTimeIntervalsProtoRoundTrip(t *testing.T) {
+	sydney, err := time.LoadLocation("Australia/Sydney")
+	require.NoError(t, err)
+
+	tis := []timeinterval.TimeInterval{
+		{
+			Times:       []timeinterval.TimeRange{{StartMinute: 60, EndMinute: 120}},
+			Weekdays:    []timeinterval.WeekdayRange{{InclusiveRange: timeinterval.InclusiveRange{Begin: 1, End: 5}}},
+			DaysOfMonth: []timeinterval.DayOfMonthRange{{InclusiveRange: timeinterval.InclusiveRange{Begin: -3, End: -1}}},
+			Months:      []timeinterval.MonthRange{{InclusiveRange: timeinterval.InclusiveRange{Begin: 3, End: 3}}},
+			Years:       []timeinterval.YearRange{{InclusiveRange: timeinterval.InclusiveRange{Begin: 2024, End: 2025}}},
+			Location:    &timeinterval.Location{Location: sydney},
+		},
+		{
+			Weekdays: []timeinterval.WeekdayRange{{InclusiveRange: timeinterval.InclusiveRange{Begin: 6, End: 6}}},
+		},
+	}
+
+	ptis := TimeIntervalsToProto(tis)
+	require.Len(t, ptis, 2)
+	require.Equal(t, "Australia/Sydney", ptis[0].Location)
+	for _, pti := range ptis {
+		require.NoError(t, validateTimeInterval(pti))
+	}
+
+	got, err := TimeIntervalsFromProto(ptis)
+	require.NoError(t, err)
+	require.Equal(t, tis, got)
+
+	_, err = TimeIntervalsFromProto([]*pb.TimeInterval{{Location: "Nowhere/Special"}})
+	require.Error(t, err)
+}
+
+func TestValidateTimeInterval(t *testing.T) {
+	for _, tc := range []struct {
+		ti  *pb.TimeInterval
+		err string
+	}{
+		{
+			ti: workingHours[0],
+		},
+		{
+			ti:  &pb.TimeInterval{Times: []*pb.TimeInterval_TimeRange{{StartMinute: 600, EndMinute: 600}}},
+			err: "invalid time range 600-600",
+		},
+		{
+			ti:  &pb.TimeInterval{Times: []*pb.TimeInterval_TimeRange{{StartMinute: 0, EndMinute: 1441}}},
+			err: "invalid time range 0-1441",
+		},
+		{
+			ti:  &pb.TimeInterval{Weekdays: []*pb.TimeInterval_InclusiveRange{{Begin: 5, End: 7}}},
+			err: "invalid weekday range 5:7",
+		},
+		{
+			ti:  &pb.TimeInterval{DaysOfMonth: []*pb.TimeInterval_InclusiveRange{{Begin: 0, End: 3}}},
+			err: "invalid day of month range 0:3",
+		},
+		{
+			ti:  &pb.TimeInterval{Months: []*pb.TimeInterval_InclusiveRange{{Begin: 12, End: 1}}},
+			err: "invalid month range 12:1",
+		},
+		{
+			ti:  &pb.TimeInterval{Location: "Nowhere/Special"},
+			err: "invalid location",
+		},
+	} {
+		checkErr(t, tc.err, validateTimeInterval(tc.ti))
+	}
+}
+
+func TestNextActiveWindow(t *testing.T) {
+	tis, err := TimeIntervalsFromProto(workingHours)
+	require.NoError(t, err)
+	weekends, err := TimeIntervalsFromProto([]*pb.TimeInterval{
+		{Weekdays: []*pb.TimeInterval_InclusiveRange{{Begin: 6, End: 6}, {Begin: 0, End: 0}}},
+	})
+	require.NoError(t, err)
+
+	// 2024-01-01 is a Monday.
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 1, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	for _, tc := range []struct {
+		name        string
+		tis         []timeinterval.TimeInterval
+		from, until time.Time
+		ok          bool
+		start, end  time.Time
+	}{
+		{
+			name:  "before the window",
+			tis:   tis,
+			from:  at(1, 8, 0),
+			until: at(31, 0, 0),
+			ok:    true,
+			start: at(1, 9, 0),
+			end:   at(1, 17, 0),
+		},
+		{
+			name:  "within the window",
+			tis:   tis,
+			from:  at(1, 10, 30),
+			until: at(31, 0, 0),
+			ok:    true,
+			start: at(1, 10, 30),
+			end:   at(1, 17, 0),
+		},
+		{
+			name:  "over the weekend",
+			tis:   tis,
+			from:  at(5, 17, 0),
+			until: at(31, 0, 0),
+			ok:    true,
+			start: at(8, 9, 0),
+			end:   at(8, 17, 0),
+		},
+		{
+			name:  "window cut by the end of the silence",
+			tis:   tis,
+			from:  at(1, 8, 0),
+			until: at(1, 12, 0),
+			ok:    true,
+			start: at(1, 9, 0),
+			end:   at(1, 12, 0),
+		},
+		{
+			name:  "window spanning several days",
+			tis:   weekends,
+			from:  at(1, 8, 0),
+			until: at(31, 0, 0),
+			ok:    true,
+			start: at(6, 0, 0),
+			end:   at(8, 0, 0),
+		},
+		{
+			name:  "no window before the end of the silence",
+			tis:   tis,
+			from:  at(6, 0, 0),
+			until: at(7, 12, 0),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end, ok := nextActiveWindow(tc.tis, tc.from, tc.until)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.start, start)
+			require.Equal(t, tc.end, end)
+		})
+	}
+}
+
+func TestSilencerRecurringSilence(t *testing.T) {
+	ss, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+
+	clock := clock.NewMock()
+	// Monday, one hour before working hours.
+	clock.Set(time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC))
+	ss.clock = clock
+	now := ss.nowUTC()
+
+	m := types.NewMarker(prometheus.NewRegistry())
+	s := NewSilencer(ss, m, log.NewNopLogger())
+
+	id, err := ss.Set(&pb.Silence{
+		Matchers:      []*pb.Matcher{{Name: "foo", Pattern: "bar"}},
+		StartsAt:      now.Add(-time.Hour),
+		EndsAt:        now.Add(30 * 24 * time.Hour),
+		TimeIntervals: workingHours,
+	})
+	require.NoError(t, err)
+
+	lset := model.LabelSet{"foo": "bar"}
+	require.False(t, s.Mutes(lset), "expected alert not silenced outside of the schedule")
+
+	start, end, err := ss.NextActiveWindow(&pb.Silence{
+		Id:            id,
+		StartsAt:      now.Add(-time.Hour),
+		EndsAt:        now.Add(30 * 24 * time.Hour),
+		TimeIntervals: workingHours,
+	})
+	require.NoError(t, err)
+	require.Equal(t, now.Add(time.Hour), start)
+	require.Equal(t, now.Add(9*time.Hour), end)
+
+	clock.Add(2 * time.Hour)
+	require.True(t, s.Mutes(lset), "expected alert silenced within the schedule")
+	require.Equal(t, []string{id}, m.Status(lset.Fingerprint()).SilencedBy)
+
+	clock.Add(8 * time.Hour)
+	require.False(t, s.Mutes(lset), "expected alert not silenced outside of the schedule")
+
+	now = ss.nowUTC()
+	_, err = ss.Set(&pb.Silence{
+		Matchers:      []*pb.Matcher{{Name: "foo", Pattern: "bar"}},
+		StartsAt:      now,
+		EndsAt:        now.Add(time.Hour),
+		TimeIntervals: []*pb.TimeInterval{{Weekdays: []*pb.TimeInterval_InclusiveRange{{Begin: 7, End: 7}}}},
+	})
+	require.EqualError(t, err, "silence invalid: invalid time interval 0: invalid weekday range 7:7")
+}
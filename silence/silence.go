@@ -138,12 +138,12 @@ func (s *Silencer) Mutes(lset model.LabelSet) bool {
 		allIDs := append(append(make([]string, 0, totalSilences), activeIDs...), pendingIDs...)
 		allSils, _, err = s.silences.Query(
 			QIDs(allIDs...),
-			QState(types.SilenceStateActive, types.SilenceStatePending),
+			QState(types.SilenceStateActive, types.SilenceStatePending, types.SilenceStatePendingApproval),
 		)
 	} else {
 		// New silences have been added, do a full query.
 		allSils, newVersion, err = s.silences.Query(
-			QState(types.SilenceStateActive, types.SilenceStatePending),
+			QState(types.SilenceStateActive, types.SilenceStatePending, types.SilenceStatePendingApproval),
 			QMatches(lset),
 		)
 	}
@@ -163,9 +163,15 @@ func (s *Silencer) Mutes(lset model.LabelSet) bool {
 	now := s.silences.nowUTC()
 	for _, sil := range allSils {
 		switch getState(sil, now) {
-		case types.SilenceStatePending:
+		case types.SilenceStatePending, types.SilenceStatePendingApproval:
 			pendingIDs = append(pendingIDs, sil.Id)
 		case types.SilenceStateActive:
+			if !s.silences.inSchedule(sil, now) {
+				// Recurring silences are pending in between two of
+				// their scheduled time intervals.
+				pendingIDs = append(pendingIDs, sil.Id)
+				continue
+			}
 			activeIDs = append(activeIDs, sil.Id)
 		default:
 			// Do nothing, silence has expired in the meantime.
@@ -199,6 +205,8 @@ type Silences struct {
 	version   int // Increments whenever silences are added.
 	broadcast func([]byte)
 	mc        matcherCache
+	sc        *scheduleCache
+	mi        *matcherIndex
 }
 
 // MaintenanceFunc represents the function to run as part of the periodic maintenance for silences.
@@ -214,6 +222,7 @@ type metrics struct {
 	queryDuration           prometheus.Histogram
 	silencesActive          prometheus.GaugeFunc
 	silencesPending         prometheus.GaugeFunc
+	silencesPendingApproval prometheus.GaugeFunc
 	silencesExpired         prometheus.GaugeFunc
 	propagatedMessagesTotal prometheus.Counter
 	maintenanceTotal        prometheus.Counter
@@ -281,6 +290,7 @@ func newMetrics(r prometheus.Registerer, s *Silences) *metrics {
 	if s != nil {
 		m.silencesActive = newSilenceMetricByState(s, types.SilenceStateActive)
 		m.silencesPending = newSilenceMetricByState(s, types.SilenceStatePending)
+		m.silencesPendingApproval = newSilenceMetricByState(s, types.SilenceStatePendingApproval)
 		m.silencesExpired = newSilenceMetricByState(s, types.SilenceStateExpired)
 	}
 
@@ -294,6 +304,7 @@ func newMetrics(r prometheus.Registerer, s *Silences) *metrics {
 			m.queryDuration,
 			m.silencesActive,
 			m.silencesPending,
+			m.silencesPendingApproval,
 			m.silencesExpired,
 			m.propagatedMessagesTotal,
 			m.maintenanceTotal,
@@ -336,6 +347,8 @@ func New(o Options) (*Silences, error) {
 	s := &Silences{
 		clock:     clock.New(),
 		mc:        matcherCache{},
+		sc:        newScheduleCache(),
+		mi:        newMatcherIndex(),
 		logger:    log.NewNopLogger(),
 		retention: o.Retention,
 		broadcast: func([]byte) {},
@@ -462,6 +475,8 @@ func (s *Silences) GC() (int, error) {
 		if !sil.ExpiresAt.After(now) {
 			delete(s.st, id)
 			delete(s.mc, sil.Silence)
+			s.sc.Delete(id)
+			s.mi.delete(id)
 			n++
 		}
 	}
@@ -530,6 +545,11 @@ func validateSilence(s *pb.Silence) error {
 	if s.UpdatedAt.IsZero() {
 		return errors.New("invalid zero update timestamp")
 	}
+	for i, ti := range s.TimeIntervals {
+		if err := validateTimeInterval(ti); err != nil {
+			return fmt.Errorf("invalid time interval %d: %w", i, err)
+		}
+	}
 	return nil
 }
 
@@ -566,6 +586,7 @@ func (s *Silences) setSilence(sil *pb.Silence, now time.Time, skipValidate bool)
 	}
 
 	if s.st.merge(msil, now) {
+		s.mi.add(sil)
 		s.version++
 	}
 	s.broadcast(b)
@@ -587,6 +608,7 @@ func (s *Silences) Set(sil *pb.Silence) (string, error) {
 	}
 	if ok {
 		if canUpdate(prev, sil, now) {
+			s.addRevision(sil, prev, now)
 			return sil.Id, s.setSilence(sil, now, false)
 		}
 		if getState(prev, s.nowUTC()) != types.SilenceStateExpired {
@@ -594,7 +616,12 @@ func (s *Silences) Set(sil *pb.Silence) (string, error) {
 			if err := s.expire(prev.Id); err != nil {
 				return "", fmt.Errorf("expire previous silence: %w", err)
 			}
+			prev, _ = s.getSilence(prev.Id)
 		}
+		// The replacing silence carries on the history of the old one.
+		s.addRevision(sil, prev, now)
+	} else {
+		sil.Revisions = nil
 	}
 	// If we got here it's either a new silence or a replacing one.
 	uid, err := uuid.NewV4()
@@ -616,8 +643,11 @@ func canUpdate(a, b *pb.Silence, now time.Time) bool {
 	if !reflect.DeepEqual(a.Matchers, b.Matchers) {
 		return false
 	}
+	if !reflect.DeepEqual(a.TimeIntervals, b.TimeIntervals) {
+		return false
+	}
 	// Allowed timestamp modifications depend on the current time.
-	switch st := getState(a, now); st {
+	switch st := getTimeState(a, now); st {
 	case types.SilenceStateActive:
 		if b.StartsAt.Unix() != a.StartsAt.Unix() {
 			return false
@@ -648,23 +678,18 @@ func (s *Silences) Expire(id string) error {
 // It is idempotent, nil is returned if the silence already expired before it is GC'd.
 // If the silence is not found an error is returned.
 func (s *Silences) expire(id string) error {
-	sil, ok := s.getSilence(id)
+	prev, ok := s.getSilence(id)
 	if !ok {
 		return ErrNotFound
 	}
-	sil = cloneSilence(sil)
+	sil := cloneSilence(prev)
 	now := s.nowUTC()
 
-	switch getState(sil, now) {
-	case types.SilenceStateExpired:
+	if getState(sil, now) == types.SilenceStateExpired {
 		return nil
-	case types.SilenceStateActive:
-		sil.EndsAt = now
-	case types.SilenceStatePending:
-		// Set both to now to make Silence move to "expired" state
-		sil.StartsAt = now
-		sil.EndsAt = now
 	}
+	endSilence(sil, now)
+	s.addRevision(sil, prev, now)
 
 	// Skip validation of the silence when expiring it. Without this, silences created
 	// with valid UTF-8 matchers cannot be expired when Alertmanager is run in classic mode.
@@ -677,6 +702,9 @@ type QueryParam func(*query) error
 type query struct {
 	ids     []string
 	filters []silenceFilter
+	// matches narrows down the silences to filter to those that may
+	// match it according to the index.
+	matches model.LabelSet
 }
 
 // silenceFilter is a function that returns true if a silence
@@ -694,6 +722,7 @@ func QIDs(ids ...string) QueryParam {
 // QMatches returns silences that match the given label set.
 func QMatches(set model.LabelSet) QueryParam {
 	return func(q *query) error {
+		q.matches = set
 		f := func(sil *pb.Silence, s *Silences, _ time.Time) (bool, error) {
 			m, err := s.mc.Get(sil)
 			if err != nil {
@@ -706,8 +735,31 @@ func QMatches(set model.LabelSet) QueryParam {
 	}
 }
 
+// endSilence sets the time range of a silence that has not expired yet so
+// that it moves to the "expired" state at the given time.
+func endSilence(sil *pb.Silence, now time.Time) {
+	switch getTimeState(sil, now) {
+	case types.SilenceStateActive:
+		sil.EndsAt = now
+	case types.SilenceStatePending:
+		// Set both to now to make Silence move to "expired" state
+		sil.StartsAt = now
+		sil.EndsAt = now
+	}
+}
+
 // getState returns a silence's SilenceState at the given timestamp.
 func getState(sil *pb.Silence, ts time.Time) types.SilenceState {
+	st := getTimeState(sil, ts)
+	if st != types.SilenceStateExpired && awaitsApproval(sil) {
+		return types.SilenceStatePendingApproval
+	}
+	return st
+}
+
+// getTimeState returns a silence's SilenceState at the given timestamp based
+// on its time range only, regardless of its approval.
+func getTimeState(sil *pb.Silence, ts time.Time) types.SilenceState {
 	if ts.Before(sil.StartsAt) {
 		return types.SilenceStatePending
 	}
@@ -786,8 +838,9 @@ func (s *Silences) CountState(states ...types.SilenceState) (int, error) {
 }
 
 func (s *Silences) query(q *query, now time.Time) ([]*pb.Silence, int, error) {
-	// If we have no ID constraint, all silences are our base set.  This and
-	// the use of post-filter functions is the trivial solution for now.
+	// If we have no ID constraint, the silences that may match the label set
+	// according to the index or else all silences are our base set. The
+	// post-filter functions are applied to the base set in any case.
 	var res []*pb.Silence
 
 	s.mtx.Lock()
@@ -799,6 +852,12 @@ func (s *Silences) query(q *query, now time.Time) ([]*pb.Silence, int, error) {
 				res = append(res, s.Silence)
 			}
 		}
+	} else if q.matches != nil {
+		for _, id := range s.mi.candidates(q.matches) {
+			if s, ok := s.st[id]; ok {
+				res = append(res, s.Silence)
+			}
+		}
 	} else {
 		for _, sil := range s.st {
 			res = append(res, sil.Silence)
@@ -844,6 +903,7 @@ func (s *Silences) loadSnapshot(r io.Reader) error {
 	}
 	s.mtx.Lock()
 	s.st = st
+	s.reindex()
 	s.version++
 	s.mtx.Unlock()
 
@@ -888,6 +948,7 @@ func (s *Silences) Merge(b []byte) error {
 
 	for _, e := range st {
 		if merged := s.st.merge(e, now); merged {
+			s.mi.add(e.Silence)
 			s.version++
 			if !cluster.OversizedMessage(b) {
 				// If this is the first we've seen the message and it's
@@ -377,6 +377,8 @@ func TestSilenceSet(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, id2, id3)
 
+	// The previous version of the silence is kept as a revision.
+	rev1 := cloneSilence(want[id2].Silence)
 	want = state{
 		id1: want[id1],
 		id2: &pb.MeshSilence{
@@ -386,6 +388,7 @@ func TestSilenceSet(t *testing.T) {
 				StartsAt:  start2,
 				EndsAt:    start3.Add(100 * time.Minute),
 				UpdatedAt: start3,
+				Revisions: []*pb.Silence{rev1},
 			},
 			ExpiresAt: start3.Add(100*time.Minute + s.retention),
 		},
@@ -404,6 +407,15 @@ func TestSilenceSet(t *testing.T) {
 	// This new silence gets a new id.
 	require.NotEqual(t, id2, id4)
 
+	rev2 := cloneSilence(want[id2].Silence)
+	rev2.Revisions = nil
+	rev3 := &pb.Silence{
+		Id:        id2,
+		Matchers:  []*pb.Matcher{{Name: "a", Pattern: "b"}},
+		StartsAt:  start2,
+		EndsAt:    start4, // Expired
+		UpdatedAt: start4,
+	}
 	want = state{
 		id1: want[id1],
 		id2: &pb.MeshSilence{
@@ -413,6 +425,7 @@ func TestSilenceSet(t *testing.T) {
 				StartsAt:  start2,
 				EndsAt:    start4, // Expired
 				UpdatedAt: start4,
+				Revisions: []*pb.Silence{rev1, rev2},
 			},
 			ExpiresAt: start4.Add(s.retention),
 		},
@@ -423,6 +436,8 @@ func TestSilenceSet(t *testing.T) {
 				StartsAt:  start4,
 				EndsAt:    start3.Add(100 * time.Minute),
 				UpdatedAt: start4,
+				// The new silence carries on the history of the expired one.
+				Revisions: []*pb.Silence{rev1, rev2, rev3},
 			},
 			ExpiresAt: start3.Add(100*time.Minute + s.retention),
 		},
@@ -452,6 +467,7 @@ func TestSilenceSet(t *testing.T) {
 				StartsAt:  start5, // New silences have their start time set to "now" when created.
 				EndsAt:    start1.Add(5 * time.Minute),
 				UpdatedAt: start5,
+				Revisions: []*pb.Silence{rev1, rev2, rev3},
 			},
 			ExpiresAt: start1.Add(5*time.Minute + s.retention),
 		},
@@ -468,6 +484,7 @@ func TestSetActiveSilence(t *testing.T) {
 	clock := clock.NewMock()
 	s.clock = clock
 	now := clock.Now()
+	createdAt := s.nowUTC()
 
 	startsAt := now.Add(-1 * time.Minute)
 	endsAt := now.Add(5 * time.Minute)
@@ -503,6 +520,13 @@ func TestSetActiveSilence(t *testing.T) {
 				StartsAt:  newStartsAt,
 				EndsAt:    newEndsAt,
 				UpdatedAt: now,
+				Revisions: []*pb.Silence{{
+					Id:        id1,
+					Matchers:  []*pb.Matcher{{Name: "a", Pattern: "b"}},
+					StartsAt:  createdAt, // Start times in the past are set to now.
+					EndsAt:    endsAt,
+					UpdatedAt: createdAt,
+				}},
 			},
 			ExpiresAt: newEndsAt.Add(s.retention),
 		},
@@ -927,6 +951,13 @@ func TestSilenceExpire(t *testing.T) {
 		StartsAt:  now,
 		EndsAt:    now,
 		UpdatedAt: now,
+		Revisions: []*pb.Silence{{
+			Id:        "pending",
+			Matchers:  []*pb.Matcher{m},
+			StartsAt:  now.Add(time.Minute),
+			EndsAt:    now.Add(time.Hour),
+			UpdatedAt: now.Add(-time.Hour),
+		}},
 	}, sil)
 
 	// Let time pass...
@@ -953,6 +984,13 @@ func TestSilenceExpire(t *testing.T) {
 		StartsAt:  now.Add(-time.Minute),
 		EndsAt:    now,
 		UpdatedAt: now,
+		Revisions: []*pb.Silence{{
+			Id:        "active",
+			Matchers:  []*pb.Matcher{m},
+			StartsAt:  now.Add(-time.Minute),
+			EndsAt:    now.Add(time.Hour),
+			UpdatedAt: now.Add(-time.Hour),
+		}},
 	}, sil)
 
 	sil, err = s.QueryOne(QIDs("expired"))
@@ -1596,6 +1634,48 @@ func TestStateDecodingError(t *testing.T) {
 	require.Equal(t, ErrInvalidState, err)
 }
 
+// qScan is QMatches without narrowing down the silences with the index,
+// evaluating the matchers of every silence.
+func qScan(set model.LabelSet) QueryParam {
+	return func(q *query) error {
+		if err := QMatches(set)(q); err != nil {
+			return err
+		}
+		q.matches = nil
+		return nil
+	}
+}
+
+func benchmarkQueryMatches(b *testing.B, s *Silences, lset model.LabelSet, expected int) {
+	for _, bc := range []struct {
+		name string
+		q    func(model.LabelSet) QueryParam
+	}{
+		{name: "index", q: QMatches},
+		{name: "scan", q: qScan},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			// Run things once to populate the matcherCache.
+			sils, _, err := s.Query(
+				QState(types.SilenceStateActive),
+				bc.q(lset),
+			)
+			require.NoError(b, err)
+			require.Len(b, sils, expected)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				sils, _, err := s.Query(
+					QState(types.SilenceStateActive),
+					bc.q(lset),
+				)
+				require.NoError(b, err)
+				require.Len(b, sils, expected)
+			}
+		})
+	}
+}
+
 func benchmarkSilencesQuery(b *testing.B, numSilences int) {
 	s, err := New(Options{})
 	require.NoError(b, err)
@@ -1628,24 +1708,46 @@ func benchmarkSilencesQuery(b *testing.B, numSilences int) {
 			UpdatedAt: now.Add(-time.Hour),
 		}}
 	}
+	s.reindex()
 
-	// Run things once to populate the matcherCache.
-	sils, _, err := s.Query(
-		QState(types.SilenceStateActive),
-		QMatches(lset),
-	)
+	benchmarkQueryMatches(b, s, lset, numSilences/10)
+}
+
+// benchmarkSilencesQueryEqual benchmarks querying silences that all have an
+// equality matcher, as created by automation for specific services.
+func benchmarkSilencesQueryEqual(b *testing.B, numSilences int) {
+	s, err := New(Options{})
 	require.NoError(b, err)
-	require.Len(b, sils, numSilences/10)
-
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		sils, _, err := s.Query(
-			QState(types.SilenceStateActive),
-			QMatches(lset),
-		)
-		require.NoError(b, err)
-		require.Len(b, sils, numSilences/10)
+
+	clock := clock.NewMock()
+	s.clock = clock
+	now := clock.Now()
+
+	lset := model.LabelSet{"service": "api", "aaaa": "AAAA", "bbbb": "BBBB"}
+
+	s.st = state{}
+	for i := 0; i < numSilences; i++ {
+		id := fmt.Sprint("ID", i)
+		service := fmt.Sprint("service", i) // Does not match.
+		if i%10 == 0 {
+			// Every 10th time, have an actually matching service.
+			service = "api"
+		}
+
+		s.st[id] = &pb.MeshSilence{Silence: &pb.Silence{
+			Id: id,
+			Matchers: []*pb.Matcher{
+				{Type: pb.Matcher_REGEXP, Name: "aaaa", Pattern: "A{4}|" + id},
+				{Type: pb.Matcher_EQUAL, Name: "service", Pattern: service},
+			},
+			StartsAt:  now.Add(-time.Minute),
+			EndsAt:    now.Add(time.Hour),
+			UpdatedAt: now.Add(-time.Hour),
+		}}
 	}
+	s.reindex()
+
+	benchmarkQueryMatches(b, s, lset, numSilences/10)
 }
 
 func Benchmark100SilencesQuery(b *testing.B) {
@@ -1660,6 +1762,18 @@ func Benchmark10000SilencesQuery(b *testing.B) {
 	benchmarkSilencesQuery(b, 10000)
 }
 
+func Benchmark100SilencesQueryEqual(b *testing.B) {
+	benchmarkSilencesQueryEqual(b, 100)
+}
+
+func Benchmark1000SilencesQueryEqual(b *testing.B) {
+	benchmarkSilencesQueryEqual(b, 1000)
+}
+
+func Benchmark10000SilencesQueryEqual(b *testing.B) {
+	benchmarkSilencesQueryEqual(b, 10000)
+}
+
 // runtime.Gosched() does not "suspend" the current goroutine so there's no guarantee that the main goroutine won't
 // be able to continue. For more see https://pkg.go.dev/runtime#Gosched.
 func gosched() {
@@ -61,6 +61,38 @@ func (Matcher_Type) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7fc56058cf68dbd8, []int{0, 0}
 }
 
+type Approval_State int32
+
+const (
+	// The silence does not require approval.
+	Approval_NOT_REQUIRED Approval_State = 0
+	Approval_PENDING      Approval_State = 1
+	Approval_APPROVED     Approval_State = 2
+	Approval_REJECTED     Approval_State = 3
+)
+
+var Approval_State_name = map[int32]string{
+	0: "NOT_REQUIRED",
+	1: "PENDING",
+	2: "APPROVED",
+	3: "REJECTED",
+}
+
+var Approval_State_value = map[string]int32{
+	"NOT_REQUIRED": 0,
+	"PENDING":      1,
+	"APPROVED":     2,
+	"REJECTED":     3,
+}
+
+func (x Approval_State) String() string {
+	return proto.EnumName(Approval_State_name, int32(x))
+}
+
+func (Approval_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7fc56058cf68dbd8, []int{3, 0}
+}
+
 // Matcher specifies a rule, which can match or set of labels or not.
 type Matcher struct {
 	Type Matcher_Type `protobuf:"varint,1,opt,name=type,proto3,enum=silencepb.Matcher_Type" json:"type,omitempty"`
@@ -166,11 +198,22 @@ type Silence struct {
 	// DEPRECATED: A set of comments made on the silence.
 	Comments []*Comment `protobuf:"bytes,7,rep,name=comments,proto3" json:"comments,omitempty"`
 	// Comment for the silence.
-	CreatedBy            string   `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	Comment              string   `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	CreatedBy string `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Comment   string `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	// A recurring schedule restricting when the silence mutes alerts. If it
+	// is set, the silence only mutes alerts during the time intervals that
+	// fall within its time range.
+	TimeIntervals []*TimeInterval `protobuf:"bytes,10,rep,name=time_intervals,json=timeIntervals,proto3" json:"time_intervals,omitempty"`
+	// The approval of the silence. A silence requiring approval only mutes
+	// alerts once it has been approved.
+	Approval *Approval `protobuf:"bytes,11,opt,name=approval,proto3" json:"approval,omitempty"`
+	// The previous revisions of the silence, oldest first. A revision is
+	// recorded whenever the silence is changed, including when it is replaced
+	// by a new silence. The revisions do not have revisions themselves.
+	Revisions            []*Silence `protobuf:"bytes,12,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *Silence) Reset()         { *m = Silence{} }
@@ -206,6 +249,182 @@ func (m *Silence) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Silence proto.InternalMessageInfo
 
+// Approval records the decision on a silence requiring approval.
+type Approval struct {
+	State Approval_State `protobuf:"varint,1,opt,name=state,proto3,enum=silencepb.Approval_State" json:"state,omitempty"`
+	// The identity of who approved or rejected the silence.
+	DecidedBy            string    `protobuf:"bytes,2,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	DecidedAt            time.Time `protobuf:"bytes,3,opt,name=decided_at,json=decidedAt,proto3,stdtime" json:"decided_at"`
+	Comment              string    `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Approval) Reset()         { *m = Approval{} }
+func (m *Approval) String() string { return proto.CompactTextString(m) }
+func (*Approval) ProtoMessage()    {}
+func (*Approval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fc56058cf68dbd8, []int{3}
+}
+func (m *Approval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Approval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Approval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Approval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Approval.Merge(m, src)
+}
+func (m *Approval) XXX_Size() int {
+	return m.Size()
+}
+func (m *Approval) XXX_DiscardUnknown() {
+	xxx_messageInfo_Approval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Approval proto.InternalMessageInfo
+
+// TimeInterval describes intervals of time, see timeinterval.TimeInterval.
+type TimeInterval struct {
+	Times       []*TimeInterval_TimeRange      `protobuf:"bytes,1,rep,name=times,proto3" json:"times,omitempty"`
+	Weekdays    []*TimeInterval_InclusiveRange `protobuf:"bytes,2,rep,name=weekdays,proto3" json:"weekdays,omitempty"`
+	DaysOfMonth []*TimeInterval_InclusiveRange `protobuf:"bytes,3,rep,name=days_of_month,json=daysOfMonth,proto3" json:"days_of_month,omitempty"`
+	Months      []*TimeInterval_InclusiveRange `protobuf:"bytes,4,rep,name=months,proto3" json:"months,omitempty"`
+	Years       []*TimeInterval_InclusiveRange `protobuf:"bytes,5,rep,name=years,proto3" json:"years,omitempty"`
+	// The name of the time zone in which the intervals are evaluated.
+	Location             string   `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TimeInterval) Reset()         { *m = TimeInterval{} }
+func (m *TimeInterval) String() string { return proto.CompactTextString(m) }
+func (*TimeInterval) ProtoMessage()    {}
+func (*TimeInterval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fc56058cf68dbd8, []int{4}
+}
+func (m *TimeInterval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeInterval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeInterval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeInterval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeInterval.Merge(m, src)
+}
+func (m *TimeInterval) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeInterval) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeInterval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeInterval proto.InternalMessageInfo
+
+// TimeRange is a range of minutes within a day, exclusive of the end minute.
+type TimeInterval_TimeRange struct {
+	StartMinute          int32    `protobuf:"varint,1,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"`
+	EndMinute            int32    `protobuf:"varint,2,opt,name=end_minute,json=endMinute,proto3" json:"end_minute,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TimeInterval_TimeRange) Reset()         { *m = TimeInterval_TimeRange{} }
+func (m *TimeInterval_TimeRange) String() string { return proto.CompactTextString(m) }
+func (*TimeInterval_TimeRange) ProtoMessage()    {}
+func (*TimeInterval_TimeRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fc56058cf68dbd8, []int{4, 0}
+}
+func (m *TimeInterval_TimeRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeInterval_TimeRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeInterval_TimeRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeInterval_TimeRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeInterval_TimeRange.Merge(m, src)
+}
+func (m *TimeInterval_TimeRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeInterval_TimeRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeInterval_TimeRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeInterval_TimeRange proto.InternalMessageInfo
+
+// InclusiveRange is a range of integers, inclusive of both ends.
+type TimeInterval_InclusiveRange struct {
+	Begin                int32    `protobuf:"varint,1,opt,name=begin,proto3" json:"begin,omitempty"`
+	End                  int32    `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TimeInterval_InclusiveRange) Reset()         { *m = TimeInterval_InclusiveRange{} }
+func (m *TimeInterval_InclusiveRange) String() string { return proto.CompactTextString(m) }
+func (*TimeInterval_InclusiveRange) ProtoMessage()    {}
+func (*TimeInterval_InclusiveRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fc56058cf68dbd8, []int{4, 1}
+}
+func (m *TimeInterval_InclusiveRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeInterval_InclusiveRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeInterval_InclusiveRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeInterval_InclusiveRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeInterval_InclusiveRange.Merge(m, src)
+}
+func (m *TimeInterval_InclusiveRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeInterval_InclusiveRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeInterval_InclusiveRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeInterval_InclusiveRange proto.InternalMessageInfo
+
 // MeshSilence wraps a regular silence with an expiration timestamp
 // after which the silence may be garbage collected.
 type MeshSilence struct {
@@ -220,7 +439,7 @@ func (m *MeshSilence) Reset()         { *m = MeshSilence{} }
 func (m *MeshSilence) String() string { return proto.CompactTextString(m) }
 func (*MeshSilence) ProtoMessage()    {}
 func (*MeshSilence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7fc56058cf68dbd8, []int{3}
+	return fileDescriptor_7fc56058cf68dbd8, []int{5}
 }
 func (m *MeshSilence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,45 +470,72 @@ var xxx_messageInfo_MeshSilence proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterEnum("silencepb.Matcher_Type", Matcher_Type_name, Matcher_Type_value)
+	proto.RegisterEnum("silencepb.Approval_State", Approval_State_name, Approval_State_value)
 	proto.RegisterType((*Matcher)(nil), "silencepb.Matcher")
 	proto.RegisterType((*Comment)(nil), "silencepb.Comment")
 	proto.RegisterType((*Silence)(nil), "silencepb.Silence")
+	proto.RegisterType((*Approval)(nil), "silencepb.Approval")
+	proto.RegisterType((*TimeInterval)(nil), "silencepb.TimeInterval")
+	proto.RegisterType((*TimeInterval_TimeRange)(nil), "silencepb.TimeInterval.TimeRange")
+	proto.RegisterType((*TimeInterval_InclusiveRange)(nil), "silencepb.TimeInterval.InclusiveRange")
 	proto.RegisterType((*MeshSilence)(nil), "silencepb.MeshSilence")
 }
 
 func init() { proto.RegisterFile("silence.proto", fileDescriptor_7fc56058cf68dbd8) }
 
 var fileDescriptor_7fc56058cf68dbd8 = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xcd, 0x8e, 0xd2, 0x50,
-	0x14, 0xc7, 0xb9, 0x85, 0xa1, 0xdc, 0x43, 0x86, 0x90, 0x13, 0xa3, 0x0d, 0x89, 0x40, 0xba, 0x22,
-	0xd1, 0x94, 0x04, 0xb7, 0xba, 0x28, 0x13, 0xe2, 0xc6, 0xf1, 0xa3, 0x62, 0xe2, 0x6e, 0x52, 0xe8,
-	0x11, 0x9a, 0x4c, 0x3f, 0xd2, 0x1e, 0x12, 0x59, 0xe9, 0x23, 0xf8, 0x0c, 0x3e, 0x0d, 0x4b, 0x9f,
-	0xc0, 0x0f, 0xde, 0xc2, 0x9d, 0xe9, 0xed, 0x2d, 0xce, 0x84, 0x55, 0x77, 0xf7, 0xdc, 0xf3, 0xff,
-	0x9f, 0x8f, 0xdf, 0x81, 0xcb, 0x3c, 0xbc, 0xa5, 0x78, 0x4d, 0x4e, 0x9a, 0x25, 0x9c, 0xa0, 0xd4,
-	0x61, 0xba, 0x1a, 0x8c, 0x36, 0x49, 0xb2, 0xb9, 0xa5, 0xa9, 0x4a, 0xac, 0x76, 0x9f, 0xa6, 0x1c,
-	0x46, 0x94, 0xb3, 0x1f, 0xa5, 0xa5, 0x76, 0xf0, 0x60, 0x93, 0x6c, 0x12, 0xf5, 0x9c, 0x16, 0xaf,
-	0xf2, 0xd7, 0xfe, 0x2e, 0xc0, 0xbc, 0xf6, 0x79, 0xbd, 0xa5, 0x0c, 0x9f, 0x40, 0x8b, 0xf7, 0x29,
-	0x59, 0x62, 0x2c, 0x26, 0xbd, 0xd9, 0x23, 0xe7, 0x54, 0xdc, 0xd1, 0x0a, 0x67, 0xb9, 0x4f, 0xc9,
-	0x53, 0x22, 0x44, 0x68, 0xc5, 0x7e, 0x44, 0x96, 0x31, 0x16, 0x13, 0xe9, 0xa9, 0x37, 0x5a, 0x60,
-	0xa6, 0x3e, 0x33, 0x65, 0xb1, 0xd5, 0x54, 0xdf, 0x55, 0x68, 0x3f, 0x87, 0x56, 0xe1, 0x45, 0x09,
-	0x17, 0x8b, 0x77, 0x1f, 0xdc, 0x57, 0xfd, 0x06, 0x02, 0xb4, 0xbd, 0xc5, 0xcb, 0xc5, 0xc7, 0xb7,
-	0x7d, 0x81, 0x97, 0x20, 0x5f, 0xbf, 0x59, 0xde, 0x94, 0x29, 0x03, 0x7b, 0x00, 0x45, 0xa8, 0xd3,
-	0x4d, 0xfb, 0x0b, 0x98, 0x57, 0x49, 0x14, 0x51, 0xcc, 0xf8, 0x10, 0xda, 0xfe, 0x8e, 0xb7, 0x49,
-	0xa6, 0xa6, 0x94, 0x9e, 0x8e, 0x8a, 0xd6, 0xeb, 0x52, 0xa2, 0x27, 0xaa, 0x42, 0x9c, 0x83, 0x3c,
-	0xa1, 0x50, 0x63, 0x75, 0x67, 0x03, 0xa7, 0x84, 0xe5, 0x54, 0xb0, 0x9c, 0x65, 0xa5, 0x98, 0x77,
-	0x0e, 0x3f, 0x47, 0x8d, 0x6f, 0xbf, 0x46, 0xc2, 0xfb, 0x6f, 0xb3, 0xff, 0x1a, 0x60, 0xbe, 0x2f,
-	0x69, 0x60, 0x0f, 0x8c, 0x30, 0xd0, 0xdd, 0x8d, 0x30, 0x40, 0x07, 0x3a, 0x51, 0x89, 0x27, 0xb7,
-	0x8c, 0x71, 0x73, 0xd2, 0x9d, 0xe1, 0x39, 0x39, 0xef, 0xa4, 0x41, 0x17, 0x64, 0xce, 0x7e, 0xc6,
-	0xf9, 0x8d, 0xcf, 0xb5, 0xe6, 0xe9, 0x94, 0x36, 0x97, 0xf1, 0x05, 0x98, 0x14, 0x07, 0xaa, 0x40,
-	0xab, 0x46, 0x81, 0x76, 0x61, 0x72, 0x19, 0xaf, 0x00, 0x76, 0x69, 0xe0, 0x33, 0x05, 0x45, 0x85,
-	0x8b, 0x3a, 0x48, 0xb4, 0xcf, 0xe5, 0x62, 0x6d, 0x4d, 0x38, 0xb7, 0xcc, 0xb3, 0xb5, 0xf5, 0xb9,
-	0xbc, 0x93, 0x06, 0x1f, 0x03, 0xac, 0x33, 0x52, 0x4d, 0x57, 0x7b, 0xab, 0xa3, 0xf0, 0x49, 0xfd,
-	0x33, 0xdf, 0xdf, 0xbd, 0x9f, 0xbc, 0x77, 0x3f, 0xfb, 0xab, 0x80, 0xee, 0x35, 0xe5, 0xdb, 0x8a,
-	0xff, 0x53, 0x30, 0x75, 0x1f, 0x75, 0x84, 0xfb, 0x7d, 0xb5, 0xc8, 0xab, 0x24, 0xc5, 0xae, 0xf4,
-	0x39, 0x0d, 0x33, 0x52, 0xb4, 0x8c, 0x3a, 0xbb, 0x6a, 0x9f, 0xcb, 0xf3, 0xfe, 0xe1, 0xcf, 0xb0,
-	0x71, 0x38, 0x0e, 0xc5, 0x8f, 0xe3, 0x50, 0xfc, 0x3e, 0x0e, 0xc5, 0xaa, 0xad, 0xac, 0xcf, 0xfe,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0xad, 0x09, 0x3a, 0xe9, 0x90, 0x03, 0x00, 0x00,
+	// 813 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xae, 0x93, 0x38, 0xb1, 0x4f, 0xd2, 0xc8, 0x3a, 0xac, 0xc0, 0x44, 0xa2, 0xed, 0xe6, 0x02,
+	0x55, 0x02, 0x39, 0xa8, 0x5c, 0xc0, 0xc5, 0xb2, 0x92, 0xd3, 0x5a, 0xab, 0xae, 0x48, 0xdb, 0x9d,
+	0xed, 0x22, 0xee, 0xa2, 0x49, 0x3c, 0x4d, 0x2d, 0x92, 0xb1, 0x65, 0x4f, 0x02, 0xb9, 0x82, 0x47,
+	0xe0, 0x01, 0xb8, 0xe2, 0x69, 0x7a, 0xc9, 0x13, 0xf0, 0xd3, 0xc7, 0x40, 0x42, 0x42, 0xf3, 0x63,
+	0x6f, 0xaa, 0xee, 0x5e, 0xf8, 0xca, 0x73, 0xe6, 0x7c, 0xdf, 0x37, 0x67, 0xbe, 0x73, 0xc6, 0xb0,
+	0x5f, 0x24, 0x4b, 0xc6, 0xe7, 0x2c, 0xc8, 0xf2, 0x54, 0xa4, 0xe8, 0x9a, 0x30, 0x9b, 0x0d, 0x0e,
+	0x17, 0x69, 0xba, 0x58, 0xb2, 0x91, 0x4a, 0xcc, 0xd6, 0x37, 0x23, 0x91, 0xac, 0x58, 0x21, 0xe8,
+	0x2a, 0xd3, 0xd8, 0xc1, 0x93, 0x45, 0xba, 0x48, 0xd5, 0x72, 0x24, 0x57, 0x7a, 0x77, 0xf8, 0xbb,
+	0x05, 0x9d, 0x09, 0x15, 0xf3, 0x5b, 0x96, 0xe3, 0x67, 0xd0, 0x12, 0xdb, 0x8c, 0xf9, 0xd6, 0x91,
+	0x75, 0xdc, 0x3f, 0xf9, 0x28, 0xa8, 0xc4, 0x03, 0x83, 0x08, 0xae, 0xb7, 0x19, 0x23, 0x0a, 0x84,
+	0x08, 0x2d, 0x4e, 0x57, 0xcc, 0x6f, 0x1c, 0x59, 0xc7, 0x2e, 0x51, 0x6b, 0xf4, 0xa1, 0x93, 0x51,
+	0x21, 0x58, 0xce, 0xfd, 0xa6, 0xda, 0x2e, 0xc3, 0xe1, 0x33, 0x68, 0x49, 0x2e, 0xba, 0x60, 0x47,
+	0xaf, 0xde, 0x84, 0xdf, 0x7a, 0x7b, 0x08, 0xd0, 0x26, 0xd1, 0x8b, 0xe8, 0xfb, 0x2b, 0xcf, 0xc2,
+	0x7d, 0x70, 0x2f, 0x2e, 0xaf, 0xa7, 0x3a, 0xd5, 0xc0, 0x3e, 0x80, 0x0c, 0x4d, 0xba, 0x39, 0xfc,
+	0x19, 0x3a, 0xa7, 0xe9, 0x6a, 0xc5, 0xb8, 0xc0, 0x0f, 0xa1, 0x4d, 0xd7, 0xe2, 0x36, 0xcd, 0x55,
+	0x95, 0x2e, 0x31, 0x91, 0x3c, 0x7a, 0xae, 0x21, 0xa6, 0xa2, 0x32, 0xc4, 0x31, 0xb8, 0x95, 0x15,
+	0xaa, 0xac, 0xee, 0xc9, 0x20, 0xd0, 0x66, 0x05, 0xa5, 0x59, 0xc1, 0x75, 0x89, 0x18, 0x3b, 0x77,
+	0x7f, 0x1e, 0xee, 0xfd, 0xfa, 0xd7, 0xa1, 0x45, 0xde, 0xd2, 0x86, 0xbf, 0xb5, 0xa0, 0xf3, 0x5a,
+	0xbb, 0x81, 0x7d, 0x68, 0x24, 0xb1, 0x39, 0xbd, 0x91, 0xc4, 0x18, 0x80, 0xb3, 0xd2, 0xf6, 0x14,
+	0x7e, 0xe3, 0xa8, 0x79, 0xdc, 0x3d, 0xc1, 0xc7, 0xce, 0x91, 0x0a, 0x83, 0x21, 0xb8, 0x85, 0xa0,
+	0xb9, 0x28, 0xa6, 0x54, 0xd4, 0xaa, 0xc7, 0xd1, 0xb4, 0x50, 0xe0, 0x37, 0xd0, 0x61, 0x3c, 0x56,
+	0x02, 0xad, 0x1a, 0x02, 0x6d, 0x49, 0x0a, 0x05, 0x9e, 0x02, 0xac, 0xb3, 0x98, 0x0a, 0x16, 0x4b,
+	0x05, 0xbb, 0x8e, 0x25, 0x86, 0x17, 0x0a, 0x79, 0x6d, 0xe3, 0x70, 0xe1, 0x77, 0x1e, 0x5d, 0xdb,
+	0xb4, 0x8b, 0x54, 0x18, 0xfc, 0x04, 0x60, 0x9e, 0x33, 0x75, 0xe8, 0x6c, 0xeb, 0x3b, 0xca, 0x3e,
+	0xd7, 0xec, 0x8c, 0xb7, 0xbb, 0xfd, 0x73, 0x1f, 0xf6, 0xef, 0x39, 0xf4, 0x65, 0x23, 0xa6, 0x09,
+	0x17, 0x2c, 0xdf, 0xd0, 0x65, 0xe1, 0x83, 0x3a, 0x6e, 0x77, 0x3e, 0x65, 0xad, 0xe7, 0x26, 0x4f,
+	0xf6, 0xc5, 0x4e, 0x54, 0xe0, 0x08, 0x1c, 0x9a, 0x65, 0x79, 0xba, 0xa1, 0x4b, 0xbf, 0xab, 0xee,
+	0xfa, 0xc1, 0x0e, 0x33, 0x34, 0x29, 0x52, 0x81, 0xf0, 0x0b, 0x70, 0x73, 0xb6, 0x49, 0x8a, 0x24,
+	0xe5, 0x85, 0xdf, 0x7b, 0x74, 0x35, 0x33, 0x07, 0xe4, 0x2d, 0x68, 0xf8, 0x9f, 0x05, 0x4e, 0x29,
+	0x84, 0x23, 0xb0, 0x0b, 0x41, 0x45, 0xf9, 0x8c, 0x3e, 0x7e, 0xc7, 0x61, 0xc1, 0x6b, 0x09, 0x20,
+	0x1a, 0x27, 0x9d, 0x89, 0xd9, 0x3c, 0x89, 0xb5, 0x33, 0x7a, 0x7a, 0x5d, 0xb3, 0x33, 0xde, 0xca,
+	0x6e, 0x95, 0xe9, 0x9a, 0x03, 0x53, 0x8a, 0x84, 0x62, 0xd7, 0xde, 0xd6, 0x03, 0x7b, 0x87, 0x63,
+	0xb0, 0x55, 0x35, 0xe8, 0x41, 0x4f, 0x3f, 0xba, 0x57, 0x6f, 0xce, 0x49, 0x74, 0xe6, 0xed, 0x61,
+	0x17, 0x3a, 0x57, 0xd1, 0xc5, 0xd9, 0xf9, 0xc5, 0x0b, 0xcf, 0xc2, 0x1e, 0x38, 0xe1, 0xd5, 0x15,
+	0xb9, 0xfc, 0x2e, 0x3a, 0xf3, 0x1a, 0x32, 0x22, 0xd1, 0xcb, 0xe8, 0xf4, 0x3a, 0x3a, 0xf3, 0x9a,
+	0xc3, 0x7f, 0x9b, 0xd0, 0xdb, 0x6d, 0x01, 0x7e, 0x05, 0xb6, 0x7a, 0x3c, 0xbe, 0xa5, 0xec, 0x7b,
+	0xfa, 0x9e, 0x56, 0xa9, 0x80, 0x50, 0xbe, 0x60, 0x44, 0xe3, 0x71, 0x0c, 0xce, 0x8f, 0x8c, 0xfd,
+	0x10, 0xd3, 0x6d, 0xf9, 0x98, 0x3e, 0x7d, 0x1f, 0xf7, 0x9c, 0xcf, 0x97, 0xeb, 0x22, 0xd9, 0x18,
+	0x81, 0x8a, 0x87, 0x2f, 0x61, 0x5f, 0x7e, 0xa7, 0xe9, 0xcd, 0x74, 0x95, 0x72, 0x71, 0xeb, 0x37,
+	0x6b, 0x09, 0x75, 0x25, 0xf9, 0xf2, 0x66, 0x22, 0xa9, 0xf8, 0x1c, 0xda, 0x4a, 0xa3, 0xf0, 0x5b,
+	0xb5, 0x44, 0x0c, 0x0b, 0x9f, 0x81, 0xbd, 0x65, 0x34, 0x2f, 0x7c, 0xbb, 0x16, 0x5d, 0x93, 0x70,
+	0x00, 0xce, 0x32, 0x9d, 0x53, 0x91, 0xa4, 0xdc, 0x6f, 0xab, 0xb6, 0x55, 0xf1, 0x60, 0x02, 0x6e,
+	0xe5, 0x1e, 0x3e, 0x85, 0x9e, 0xfa, 0x39, 0x4c, 0x57, 0x09, 0x5f, 0x9b, 0xd1, 0xb3, 0x49, 0x57,
+	0xed, 0x4d, 0xd4, 0x96, 0x9c, 0x32, 0xc6, 0xe3, 0x12, 0xd0, 0x50, 0x00, 0x97, 0xf1, 0x58, 0xa7,
+	0x07, 0x5f, 0x43, 0xff, 0x61, 0x0d, 0xf8, 0x04, 0xec, 0x19, 0x5b, 0x24, 0xdc, 0x88, 0xe9, 0x00,
+	0x3d, 0x68, 0x32, 0x1e, 0x1b, 0xbe, 0x5c, 0x0e, 0x7f, 0xb1, 0xa0, 0x3b, 0x61, 0xc5, 0x6d, 0xf9,
+	0x7f, 0xfc, 0x1c, 0x3a, 0xe6, 0x92, 0x8a, 0xf9, 0xee, 0xc7, 0x53, 0x42, 0xe4, 0x74, 0xb3, 0x9f,
+	0xb2, 0x24, 0x67, 0xea, 0x6f, 0xd6, 0xa8, 0x33, 0xdd, 0x86, 0x17, 0x8a, 0xb1, 0x77, 0xf7, 0xcf,
+	0xc1, 0xde, 0xdd, 0xfd, 0x81, 0xf5, 0xc7, 0xfd, 0x81, 0xf5, 0xf7, 0xfd, 0x81, 0x35, 0x6b, 0x2b,
+	0xea, 0x97, 0xff, 0x0f, 0x00, 0x36, 0x98, 0x28, 0x17, 0x30, 0x07, 0x00, 0x00,
 }
 
 func (m *Matcher) Marshal() (dAtA []byte, err error) {
@@ -411,6 +657,46 @@ func (m *Silence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSilence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.Approval != nil {
+		{
+			size, err := m.Approval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSilence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.TimeIntervals) > 0 {
+		for iNdEx := len(m.TimeIntervals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeIntervals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSilence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Comment) > 0 {
 		i -= len(m.Comment)
 		copy(dAtA[i:], m.Comment)
@@ -439,29 +725,29 @@ func (m *Silence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x3a
 		}
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintSilence(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x2a
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndsAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndsAt):])
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintSilence(dAtA, i, uint64(n3))
 	i--
-	dAtA[i] = 0x22
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartsAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartsAt):])
+	dAtA[i] = 0x2a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndsAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndsAt):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintSilence(dAtA, i, uint64(n4))
 	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartsAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartsAt):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSilence(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0x1a
 	if len(m.Matchers) > 0 {
 		for iNdEx := len(m.Matchers) - 1; iNdEx >= 0; iNdEx-- {
@@ -487,7 +773,7 @@ func (m *Silence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MeshSilence) Marshal() (dAtA []byte, err error) {
+func (m *Approval) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -497,12 +783,12 @@ func (m *MeshSilence) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MeshSilence) MarshalTo(dAtA []byte) (int, error) {
+func (m *Approval) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MeshSilence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Approval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -511,97 +797,329 @@ func (m *MeshSilence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
-	if err5 != nil {
-		return 0, err5
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintSilence(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x22
 	}
-	i -= n5
-	i = encodeVarintSilence(dAtA, i, uint64(n5))
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DecidedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DecidedAt):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintSilence(dAtA, i, uint64(n6))
 	i--
-	dAtA[i] = 0x12
-	if m.Silence != nil {
-		{
-			size, err := m.Silence.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSilence(dAtA, i, uint64(size))
-		}
+	dAtA[i] = 0x1a
+	if len(m.DecidedBy) > 0 {
+		i -= len(m.DecidedBy)
+		copy(dAtA[i:], m.DecidedBy)
+		i = encodeVarintSilence(dAtA, i, uint64(len(m.DecidedBy)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.State != 0 {
+		i = encodeVarintSilence(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSilence(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSilence(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TimeInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Matcher) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovSilence(uint64(m.Type))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSilence(uint64(l))
-	}
-	l = len(m.Pattern)
-	if l > 0 {
-		n += 1 + l + sovSilence(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *TimeInterval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Comment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TimeInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Author)
-	if l > 0 {
-		n += 1 + l + sovSilence(uint64(l))
-	}
-	l = len(m.Comment)
-	if l > 0 {
-		n += 1 + l + sovSilence(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
-	n += 1 + l + sovSilence(uint64(l))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *Silence) Size() (n int) {
-	if m == nil {
-		return 0
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovSilence(uint64(l))
+	if len(m.Location) > 0 {
+		i -= len(m.Location)
+		copy(dAtA[i:], m.Location)
+		i = encodeVarintSilence(dAtA, i, uint64(len(m.Location)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Matchers) > 0 {
-		for _, e := range m.Matchers {
+	if len(m.Years) > 0 {
+		for iNdEx := len(m.Years) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Years[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSilence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Months) > 0 {
+		for iNdEx := len(m.Months) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Months[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSilence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DaysOfMonth) > 0 {
+		for iNdEx := len(m.DaysOfMonth) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DaysOfMonth[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSilence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Weekdays) > 0 {
+		for iNdEx := len(m.Weekdays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Weekdays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSilence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Times) > 0 {
+		for iNdEx := len(m.Times) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Times[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSilence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeInterval_TimeRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeInterval_TimeRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeInterval_TimeRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EndMinute != 0 {
+		i = encodeVarintSilence(dAtA, i, uint64(m.EndMinute))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartMinute != 0 {
+		i = encodeVarintSilence(dAtA, i, uint64(m.StartMinute))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeInterval_InclusiveRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeInterval_InclusiveRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeInterval_InclusiveRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.End != 0 {
+		i = encodeVarintSilence(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Begin != 0 {
+		i = encodeVarintSilence(dAtA, i, uint64(m.Begin))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MeshSilence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MeshSilence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MeshSilence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintSilence(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.Silence != nil {
+		{
+			size, err := m.Silence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSilence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSilence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSilence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Matcher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovSilence(uint64(m.Type))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSilence(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovSilence(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Comment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovSilence(uint64(l))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovSilence(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovSilence(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Silence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSilence(uint64(l))
+	}
+	if len(m.Matchers) > 0 {
+		for _, e := range m.Matchers {
 			l = e.Size()
 			n += 1 + l + sovSilence(uint64(l))
 		}
@@ -626,70 +1144,541 @@ func (m *Silence) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSilence(uint64(l))
 	}
+	if len(m.TimeIntervals) > 0 {
+		for _, e := range m.TimeIntervals {
+			l = e.Size()
+			n += 1 + l + sovSilence(uint64(l))
+		}
+	}
+	if m.Approval != nil {
+		l = m.Approval.Size()
+		n += 1 + l + sovSilence(uint64(l))
+	}
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.Size()
+			n += 1 + l + sovSilence(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *MeshSilence) Size() (n int) {
+func (m *Approval) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Silence != nil {
-		l = m.Silence.Size()
+	if m.State != 0 {
+		n += 1 + sovSilence(uint64(m.State))
+	}
+	l = len(m.DecidedBy)
+	if l > 0 {
 		n += 1 + l + sovSilence(uint64(l))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt)
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.DecidedAt)
 	n += 1 + l + sovSilence(uint64(l))
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovSilence(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func sovSilence(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSilence(x uint64) (n int) {
-	return sovSilence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Matcher) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+func (m *TimeInterval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Times) > 0 {
+		for _, e := range m.Times {
+			l = e.Size()
+			n += 1 + l + sovSilence(uint64(l))
+		}
+	}
+	if len(m.Weekdays) > 0 {
+		for _, e := range m.Weekdays {
+			l = e.Size()
+			n += 1 + l + sovSilence(uint64(l))
+		}
+	}
+	if len(m.DaysOfMonth) > 0 {
+		for _, e := range m.DaysOfMonth {
+			l = e.Size()
+			n += 1 + l + sovSilence(uint64(l))
+		}
+	}
+	if len(m.Months) > 0 {
+		for _, e := range m.Months {
+			l = e.Size()
+			n += 1 + l + sovSilence(uint64(l))
+		}
+	}
+	if len(m.Years) > 0 {
+		for _, e := range m.Years {
+			l = e.Size()
+			n += 1 + l + sovSilence(uint64(l))
+		}
+	}
+	l = len(m.Location)
+	if l > 0 {
+		n += 1 + l + sovSilence(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TimeInterval_TimeRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartMinute != 0 {
+		n += 1 + sovSilence(uint64(m.StartMinute))
+	}
+	if m.EndMinute != 0 {
+		n += 1 + sovSilence(uint64(m.EndMinute))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TimeInterval_InclusiveRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Begin != 0 {
+		n += 1 + sovSilence(uint64(m.Begin))
+	}
+	if m.End != 0 {
+		n += 1 + sovSilence(uint64(m.End))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MeshSilence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Silence != nil {
+		l = m.Silence.Size()
+		n += 1 + l + sovSilence(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovSilence(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSilence(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSilence(x uint64) (n int) {
+	return sovSilence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Matcher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSilence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Matcher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Matcher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= Matcher_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSilence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Comment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSilence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Comment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Comment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSilence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Silence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowSilence
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Silence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Silence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matchers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Matchers = append(m.Matchers, &Matcher{})
+			if err := m.Matchers[len(m.Matchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Matcher: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Matcher: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartsAt", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSilence
@@ -699,14 +1688,128 @@ func (m *Matcher) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= Matcher_Type(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartsAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndsAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndsAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comments = append(m.Comments, &Comment{})
+			if err := m.Comments[len(m.Comments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -734,11 +1837,11 @@ func (m *Matcher) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -750,23 +1853,127 @@ func (m *Matcher) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeIntervals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeIntervals = append(m.TimeIntervals, &TimeInterval{})
+			if err := m.TimeIntervals[len(m.TimeIntervals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Approval == nil {
+				m.Approval = &Approval{}
+			}
+			if err := m.Approval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSilence
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSilence
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pattern = string(dAtA[iNdEx:postIndex])
+			m.Revisions = append(m.Revisions, &Silence{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -790,7 +1997,7 @@ func (m *Matcher) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Comment) Unmarshal(dAtA []byte) error {
+func (m *Approval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -813,15 +2020,34 @@ func (m *Comment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Comment: wiretype end group for non-group")
+			return fmt.Errorf("proto: Approval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Comment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Approval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Approval_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecidedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -849,13 +2075,13 @@ func (m *Comment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
+			m.DecidedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecidedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSilence
@@ -865,29 +2091,30 @@ func (m *Comment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSilence
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSilence
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DecidedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSilence
@@ -897,24 +2124,23 @@ func (m *Comment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSilence
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSilence
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -938,7 +2164,7 @@ func (m *Comment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Silence) Unmarshal(dAtA []byte) error {
+func (m *TimeInterval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -961,17 +2187,17 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Silence: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimeInterval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Silence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimeInterval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Times", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSilence
@@ -981,27 +2207,29 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSilence
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSilence
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Times = append(m.Times, &TimeInterval_TimeRange{})
+			if err := m.Times[len(m.Times)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Matchers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weekdays", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1028,14 +2256,14 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Matchers = append(m.Matchers, &Matcher{})
-			if err := m.Matchers[len(m.Matchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Weekdays = append(m.Weekdays, &TimeInterval_InclusiveRange{})
+			if err := m.Weekdays[len(m.Weekdays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartsAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DaysOfMonth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1062,13 +2290,14 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartsAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.DaysOfMonth = append(m.DaysOfMonth, &TimeInterval_InclusiveRange{})
+			if err := m.DaysOfMonth[len(m.DaysOfMonth)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndsAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Months", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1095,13 +2324,14 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndsAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.Months = append(m.Months, &TimeInterval_InclusiveRange{})
+			if err := m.Months[len(m.Months)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Years", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1128,15 +2358,16 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.Years = append(m.Years, &TimeInterval_InclusiveRange{})
+			if err := m.Years[len(m.Years)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSilence
@@ -1146,31 +2377,80 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSilence
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSilence
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comments = append(m.Comments, &Comment{})
-			if err := m.Comments[len(m.Comments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Location = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSilence(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSilence
 			}
-			var stringLen uint64
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeInterval_TimeRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSilence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartMinute", wireType)
+			}
+			m.StartMinute = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSilence
@@ -1180,29 +2460,86 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartMinute |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSilence
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndMinute", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.EndMinute = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndMinute |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSilence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSilence
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeInterval_InclusiveRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSilence
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InclusiveRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InclusiveRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+			}
+			m.Begin = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSilence
@@ -1212,24 +2549,30 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Begin |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSilence
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSilence
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSilence(dAtA[iNdEx:])
@@ -0,0 +1,224 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// toFloat64 converts numbers and strings holding numbers, such as label
+// values, to a float64.
+func toFloat64(v interface{}) (float64, error) {
+	switch v := v.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case uint:
+		return float64(v), nil
+	case uint32:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(v), 64)
+	default:
+		return 0, fmt.Errorf("can't convert %T to float", v)
+	}
+}
+
+// humanize formats a number with a metric prefix, e.g. 1234567 as 1.235M.
+func humanize(i interface{}) (string, error) {
+	v, err := toFloat64(i)
+	if err != nil {
+		return "", err
+	}
+	if v == 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Sprintf("%.4g", v), nil
+	}
+	prefix := ""
+	if math.Abs(v) >= 1 {
+		for _, p := range []string{"k", "M", "G", "T", "P", "E", "Z", "Y"} {
+			if math.Abs(v) < 1000 {
+				break
+			}
+			prefix = p
+			v /= 1000
+		}
+		return fmt.Sprintf("%.4g%s", v, prefix), nil
+	}
+	for _, p := range []string{"m", "u", "n", "p", "f", "a", "z", "y"} {
+		if math.Abs(v) >= 1 {
+			break
+		}
+		prefix = p
+		v *= 1000
+	}
+	return fmt.Sprintf("%.4g%s", v, prefix), nil
+}
+
+// humanizeDuration formats a duration, or a number of seconds, such as
+// 1d 2h 3m 4s.
+func humanizeDuration(i interface{}) (string, error) {
+	var v float64
+	if d, ok := i.(time.Duration); ok {
+		v = d.Seconds()
+	} else {
+		var err error
+		if v, err = toFloat64(i); err != nil {
+			return "", err
+		}
+	}
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Sprintf("%.4g", v), nil
+	}
+	if v == 0 {
+		return fmt.Sprintf("%.4gs", v), nil
+	}
+	if math.Abs(v) >= 1 {
+		sign := ""
+		if v < 0 {
+			sign = "-"
+			v = -v
+		}
+		duration := int64(v)
+		seconds := duration % 60
+		minutes := (duration / 60) % 60
+		hours := (duration / 60 / 60) % 24
+		days := duration / 60 / 60 / 24
+		switch {
+		case days != 0:
+			return fmt.Sprintf("%s%dd %dh %dm %ds", sign, days, hours, minutes, seconds), nil
+		case hours != 0:
+			return fmt.Sprintf("%s%dh %dm %ds", sign, hours, minutes, seconds), nil
+		case minutes != 0:
+			return fmt.Sprintf("%s%dm %ds", sign, minutes, seconds), nil
+		}
+		return fmt.Sprintf("%s%.4gs", sign, v), nil
+	}
+	prefix := ""
+	for _, p := range []string{"m", "u", "n", "p", "f", "a", "z", "y"} {
+		if math.Abs(v) >= 1 {
+			break
+		}
+		prefix = p
+		v *= 1000
+	}
+	return fmt.Sprintf("%.4g%ss", v, prefix), nil
+}
+
+// tz converts a time to the given IANA time zone.
+func tz(name string, t time.Time) (time.Time, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.In(loc), nil
+}
+
+// toJSON encodes a value as JSON.
+func toJSON(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// alertKey returns the value of an alert used to sort or group alerts by the
+// given key. The keys startsAt, endsAt and status refer to the fields of the
+// alert, any other key to a label.
+func alertKey(a Alert, key string) string {
+	switch key {
+	case "startsAt":
+		return a.StartsAt.UTC().Format(time.RFC3339Nano)
+	case "endsAt":
+		return a.EndsAt.UTC().Format(time.RFC3339Nano)
+	case "status":
+		return a.Status
+	}
+	return a.Labels[key]
+}
+
+// sortAlertsBy returns a copy of the alerts sorted by the given key, see
+// alertKey. A key prefixed with - sorts in descending order.
+func sortAlertsBy(key string, alerts Alerts) Alerts {
+	desc := strings.HasPrefix(key, "-")
+	key = strings.TrimPrefix(key, "-")
+
+	res := make(Alerts, len(alerts))
+	copy(res, alerts)
+	sort.SliceStable(res, func(i, j int) bool {
+		if key == "startsAt" || key == "endsAt" {
+			ti, tj := res[i].StartsAt, res[j].StartsAt
+			if key == "endsAt" {
+				ti, tj = res[i].EndsAt, res[j].EndsAt
+			}
+			if desc {
+				return ti.After(tj)
+			}
+			return ti.Before(tj)
+		}
+		if desc {
+			return alertKey(res[i], key) > alertKey(res[j], key)
+		}
+		return alertKey(res[i], key) < alertKey(res[j], key)
+	})
+	return res
+}
+
+// groupAlertsBy groups the alerts by the value of the given key, see
+// alertKey. Ranging over the result visits groups sorted by value.
+func groupAlertsBy(key string, alerts Alerts) map[string]Alerts {
+	res := map[string]Alerts{}
+	for _, a := range alerts {
+		k := alertKey(a, key)
+		res[k] = append(res[k], a)
+	}
+	return res
+}
+
+// dict builds a map from alternating keys and values.
+func dict(pairs ...interface{}) (map[string]interface{}, error) {
+	if len(pairs)%2 != 0 {
+		return nil, errors.New("dict requires an even number of arguments")
+	}
+	res := make(map[string]interface{}, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		k, ok := pairs[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("dict keys must be strings, got %T", pairs[i])
+		}
+		res[k] = pairs[i+1]
+	}
+	return res, nil
+}
+
+// list builds a list from its arguments.
+func list(items ...interface{}) []interface{} {
+	return items
+}
@@ -0,0 +1,200 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"fmt"
+	tmplhtml "html/template"
+	"io"
+	"os"
+	"path/filepath"
+	tmpltext "text/template"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+	"gopkg.in/yaml.v2"
+)
+
+// dateLayouts are the layouts used by formatDate by locale, falling back to
+// the base language of the locale.
+var dateLayouts = map[string]string{
+	"":      "2006-01-02 15:04:05 MST",
+	"en":    "Jan 2, 2006 15:04 MST",
+	"en-GB": "2 Jan 2006 15:04 MST",
+	"de":    "02.01.2006 15:04 MST",
+	"es":    "02/01/2006 15:04 MST",
+	"fr":    "02/01/2006 15:04 MST",
+	"it":    "02/01/2006 15:04 MST",
+	"ja":    "2006/01/02 15:04 MST",
+	"nl":    "02-01-2006 15:04 MST",
+	"pt":    "02/01/2006 15:04 MST",
+	"zh":    "2006-01-02 15:04 MST",
+}
+
+// catalog holds the translated messages by locale.
+type catalog struct {
+	messages map[string]map[string]string
+}
+
+func newCatalog() *catalog {
+	return &catalog{messages: map[string]map[string]string{}}
+}
+
+// load merges the messages of a translation file, mapping locales to
+// messages to their translation, into the catalog.
+func (c *catalog) load(r io.Reader) error {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	var messages map[string]map[string]string
+	if err := yaml.UnmarshalStrict(b, &messages); err != nil {
+		return err
+	}
+	for locale, msgs := range messages {
+		tag, err := language.Parse(locale)
+		if err != nil {
+			return fmt.Errorf("invalid locale %q: %w", locale, err)
+		}
+		key := tag.String()
+		if c.messages[key] == nil {
+			c.messages[key] = map[string]string{}
+		}
+		for k, v := range msgs {
+			c.messages[key][k] = v
+		}
+	}
+	return nil
+}
+
+// lookup returns the translation of the message for the locale or its base
+// language, or else the message itself.
+func (c *catalog) lookup(tag language.Tag, msg string) string {
+	if tag == language.Und {
+		return msg
+	}
+	if s, ok := c.messages[tag.String()][msg]; ok {
+		return s
+	}
+	if base, _ := tag.Base(); base.String() != tag.String() {
+		if s, ok := c.messages[base.String()][msg]; ok {
+			return s
+		}
+	}
+	return msg
+}
+
+// LoadTranslations loads the translation files matching the path globs into
+// the catalog of the template. Files map locales to messages to their
+// translation, later files overriding earlier ones.
+func (t *Template) LoadTranslations(paths ...string) error {
+	for _, p := range paths {
+		files, err := filepath.Glob(p)
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			f, err := os.Open(file)
+			if err != nil {
+				return err
+			}
+			err = t.catalog.load(f)
+			f.Close()
+			if err != nil {
+				return fmt.Errorf("load translations from %s: %w", file, err)
+			}
+		}
+	}
+	return nil
+}
+
+// WithLocale returns a copy of the template whose tr, formatNumber and
+// formatDate functions use the given locale, e.g. fr or pt-BR. The copy
+// shares the translation catalog of the template.
+func (t *Template) WithLocale(locale string) (*Template, error) {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return nil, fmt.Errorf("invalid locale %q: %w", locale, err)
+	}
+	text, err := t.text.Clone()
+	if err != nil {
+		return nil, err
+	}
+	html, err := t.html.Clone()
+	if err != nil {
+		return nil, err
+	}
+	nt := &Template{
+		text:        text,
+		html:        html,
+		catalog:     t.catalog,
+		locale:      tag,
+		ExternalURL: t.ExternalURL,
+	}
+	nt.addLocaleFuncs()
+	return nt, nil
+}
+
+// Locale returns the locale of the template, empty if it has none.
+func (t *Template) Locale() string {
+	if t.locale == language.Und {
+		return ""
+	}
+	return t.locale.String()
+}
+
+func (t *Template) addLocaleFuncs() {
+	funcs := FuncMap{
+		"tr":           t.translate,
+		"formatNumber": t.formatNumber,
+		"formatDate":   t.formatDate,
+	}
+	t.text.Funcs(tmpltext.FuncMap(funcs))
+	t.html.Funcs(tmplhtml.FuncMap(funcs))
+}
+
+// translate returns the translation of the message. Arguments are formatted
+// into the translation as with fmt.Sprintf, using the number format of the
+// locale.
+func (t *Template) translate(msg string, args ...interface{}) string {
+	s := t.catalog.lookup(t.locale, msg)
+	if len(args) == 0 {
+		return s
+	}
+	return message.NewPrinter(t.locale).Sprintf(s, args...)
+}
+
+// formatNumber formats a number with the decimal and grouping separators of
+// the locale.
+func (t *Template) formatNumber(v interface{}) (string, error) {
+	f, err := toFloat64(v)
+	if err != nil {
+		return "", err
+	}
+	return message.NewPrinter(t.locale).Sprint(number.Decimal(f)), nil
+}
+
+// formatDate formats a time with the date layout of the locale.
+func (t *Template) formatDate(tm time.Time) string {
+	layout, ok := dateLayouts[t.Locale()]
+	if !ok {
+		base, _ := t.locale.Base()
+		if layout, ok = dateLayouts[base.String()]; !ok {
+			layout = dateLayouts[""]
+		}
+	}
+	return tm.Format(layout)
+}
@@ -0,0 +1,236 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestLocaleFuncs(t *testing.T) {
+	tmpl, err := FromGlobs([]string{})
+	require.NoError(t, err)
+
+	date := time.Date(2024, 3, 9, 14, 5, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		title  string
+		locale string
+		in     string
+		data   interface{}
+		exp    string
+	}{
+		{
+			title: "tr without locale",
+			in:    `{{ tr "Labels" }}`,
+			exp:   "Labels",
+		},
+		{
+			title:  "tr with locale",
+			locale: "fr",
+			in:     `{{ tr "Labels" }}`,
+			exp:    "Étiquettes",
+		},
+		{
+			title:  "tr falls back to the base language",
+			locale: "de-AT",
+			in:     `{{ tr "Source" }}`,
+			exp:    "Quelle",
+		},
+		{
+			title:  "tr of an unknown message",
+			locale: "fr",
+			in:     `{{ tr "Unknown" }}`,
+			exp:    "Unknown",
+		},
+		{
+			title:  "tr of an unknown locale",
+			locale: "ja",
+			in:     `{{ tr "Labels" }}`,
+			exp:    "Labels",
+		},
+		{
+			title:  "tr with arguments",
+			locale: "de",
+			in:     `{{ tr "%d alerts" 1234 }}`,
+			exp:    "1.234 alerts",
+		},
+		{
+			title: "formatNumber without locale",
+			in:    `{{ formatNumber 1234567.891 }}`,
+			exp:   "1,234,567.891",
+		},
+		{
+			title:  "formatNumber with locale",
+			locale: "fr",
+			in:     `{{ formatNumber 1234567.891 }}`,
+			exp:    "1 234 567,891",
+		},
+		{
+			title:  "formatNumber of a string",
+			locale: "de",
+			in:     `{{ formatNumber "1234.5" }}`,
+			exp:    "1.234,5",
+		},
+		{
+			title: "formatDate without locale",
+			in:    `{{ formatDate . }}`,
+			data:  date,
+			exp:   "2024-03-09 14:05:00 UTC",
+		},
+		{
+			title:  "formatDate with locale",
+			locale: "de",
+			in:     `{{ formatDate . }}`,
+			data:   date,
+			exp:    "09.03.2024 14:05 UTC",
+		},
+		{
+			title:  "formatDate with region",
+			locale: "en-GB",
+			in:     `{{ formatDate . }}`,
+			data:   date,
+			exp:    "9 Mar 2024 14:05 UTC",
+		},
+		{
+			title:  "formatDate falls back to the base language",
+			locale: "en-US",
+			in:     `{{ formatDate . }}`,
+			data:   date,
+			exp:    "Mar 9, 2024 14:05 UTC",
+		},
+	} {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			ltmpl := tmpl
+			if tc.locale != "" {
+				ltmpl, err = tmpl.WithLocale(tc.locale)
+				require.NoError(t, err)
+			}
+			got, err := ltmpl.ExecuteTextString(tc.in, tc.data)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, got)
+		})
+	}
+}
+
+func TestWithLocale(t *testing.T) {
+	tmpl, err := FromGlobs([]string{})
+	require.NoError(t, err)
+	require.Equal(t, "", tmpl.Locale())
+
+	_, err = tmpl.WithLocale("not a locale")
+	require.Error(t, err)
+
+	fr, err := tmpl.WithLocale("fr")
+	require.NoError(t, err)
+	require.Equal(t, "fr", fr.Locale())
+
+	// The original template is left untranslated.
+	got, err := tmpl.ExecuteTextString(`{{ tr "Labels" }}`, nil)
+	require.NoError(t, err)
+	require.Equal(t, "Labels", got)
+}
+
+func TestLoadTranslations(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yml"), []byte(`
+fr:
+  Labels: Libellés
+  "Hello %s": Bonjour %s
+pt_BR:
+  "Hello %s": Olá %s
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yml"), []byte(`
+fr:
+  "Hello %s": Salut %s
+`), 0o644))
+
+	tmpl, err := FromGlobs([]string{})
+	require.NoError(t, err)
+	require.NoError(t, tmpl.LoadTranslations(filepath.Join(dir, "*.yml")))
+
+	for locale, exp := range map[string]string{
+		"fr":    "Libellés Salut Alice Annotations",
+		"pt-BR": "Labels Olá Alice Annotations",
+		"de":    "Labels Hello Alice Annotationen",
+	} {
+		ltmpl, err := tmpl.WithLocale(locale)
+		require.NoError(t, err)
+		got, err := ltmpl.ExecuteTextString(`{{ tr "Labels" }} {{ tr "Hello %s" "Alice" }} {{ tr "Annotations" }}`, nil)
+		require.NoError(t, err)
+		require.Equal(t, exp, got, locale)
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "c.yml"), []byte(`
+not a locale:
+  "Hello %s": Hi
+`), 0o644))
+	require.Error(t, tmpl.LoadTranslations(filepath.Join(dir, "c.yml")))
+}
+
+func TestDefaultTemplateLocale(t *testing.T) {
+	tmpl, err := FromGlobs([]string{})
+	require.NoError(t, err)
+	tmpl.ExternalURL, _ = url.Parse("http://localhost:9093")
+
+	data := tmpl.Data("receiver", model.LabelSet{"alertname": "Test"}, &types.Alert{
+		Alert: model.Alert{
+			Labels:       model.LabelSet{"alertname": "Test"},
+			Annotations:  model.LabelSet{"summary": "Summary"},
+			StartsAt:     time.Now().Add(-time.Hour),
+			GeneratorURL: "http://generator",
+		},
+	})
+
+	fr, err := tmpl.WithLocale("fr")
+	require.NoError(t, err)
+
+	got, err := fr.ExecuteTextString(`{{ template "__subject" . }}`, data)
+	require.NoError(t, err)
+	require.Equal(t, "[DÉCLENCHÉE:1] Test ", got)
+
+	got, err = fr.ExecuteTextString(`{{ template "__text_alert_list" .Alerts }}`, data)
+	require.NoError(t, err)
+	require.Equal(t, "Étiquettes:\n - alertname = Test\nAnnotations:\n - summary = Summary\nSource: http://generator\n", got)
+
+	got, err = tmpl.ExecuteTextString(`{{ template "__subject" . }}`, data)
+	require.NoError(t, err)
+	require.Equal(t, "[FIRING:1] Test ", got)
+
+	// The email body is localized along with the subject.
+	de, err := tmpl.WithLocale("de")
+	require.NoError(t, err)
+	got, err = de.ExecuteHTMLString(`{{ template "email.default.html" . }}`, data)
+	require.NoError(t, err)
+	for _, s := range []string{"1 Alarm für", "Anzeigen in Alertmanager", "[1] Ausgelöst", "Annotationen", "Quelle", "Gesendet von Alertmanager"} {
+		require.Contains(t, got, s)
+	}
+	for _, s := range []string{"alert for", "View in", "Firing", "Sent by"} {
+		require.NotContains(t, got, s)
+	}
+
+	got, err = tmpl.ExecuteHTMLString(`{{ template "email.default.html" . }}`, data)
+	require.NoError(t, err)
+	for _, s := range []string{"1 alert for", "View in Alertmanager", "[1] Firing", "Sent by Alertmanager"} {
+		require.Contains(t, got, s)
+	}
+}
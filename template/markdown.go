@@ -0,0 +1,593 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// Markup is a format that notification text written in markdown is converted
+// to before being sent to an integration.
+//
+// The markdown dialect is a subset of CommonMark supported by all formats:
+// **bold** (or __bold__), *italic* (or _italic_), ~~strikethrough~~, `code`,
+// fenced code blocks, [links](https://example.com) and <https://example.com>,
+// # headings, - and 1. list items, > quotes and backslash escapes. Formats
+// without headings or lists render them as bold text and bulleted lines.
+type Markup int
+
+const (
+	// MarkupPlain is plain text without markup.
+	MarkupPlain Markup = iota
+	// MarkupHTML is HTML, as in email.
+	MarkupHTML
+	// MarkupSlack is the mrkdwn format of Slack.
+	MarkupSlack
+	// MarkupTelegram is the HTML subset of the Telegram HTML parse mode.
+	MarkupTelegram
+	// MarkupDiscord is the markdown flavor of Discord.
+	MarkupDiscord
+	// MarkupMSTeams is the markdown flavor of Microsoft Teams message cards,
+	// in which line breaks must be paragraph breaks.
+	MarkupMSTeams
+)
+
+// ConvertMarkdown converts notification text written in markdown to the
+// markup, escaping the text as the markup requires.
+func ConvertMarkdown(text string, to Markup) string {
+	s, ok := markupStyles[to]
+	if !ok {
+		panic(fmt.Sprintf("unknown markup %d", to))
+	}
+	return s.render(parseMarkdown(text))
+}
+
+var markdownSpecial = regexp.MustCompile("[\\\\`*_~\\[\\]<>#]")
+
+// EscapeMarkdown escapes the characters of the text that have a meaning in
+// markdown, so that values such as label values render as is.
+func EscapeMarkdown(text string) string {
+	return markdownSpecial.ReplaceAllString(text, `\$0`)
+}
+
+type mdBlockKind int
+
+const (
+	mdParagraph mdBlockKind = iota
+	mdHeading
+	mdList
+	mdQuote
+	mdCode
+)
+
+// mdBlock is a block of markdown. Paragraphs and quotes have a line per
+// source line, lists a line per item and code blocks the raw code lines.
+type mdBlock struct {
+	kind  mdBlockKind
+	level int
+	lines []string
+	// markers are the markers of the list items, "" for bullets.
+	markers []string
+	// gap is true if the block is preceded by a blank line.
+	gap bool
+}
+
+var (
+	mdHeadingLine = regexp.MustCompile(`^(#{1,6})\s+(.*?)(?:\s+#+)?\s*$`)
+	mdBulletLine  = regexp.MustCompile(`^\s*[-*+]\s+(.*)$`)
+	mdOrderedLine = regexp.MustCompile(`^\s*(\d{1,9})[.)]\s+(.*)$`)
+	mdQuoteLine   = regexp.MustCompile(`^\s*>\s?(.*)$`)
+	mdFenceLine   = regexp.MustCompile("^\\s*```")
+)
+
+// parseMarkdown splits markdown into blocks.
+func parseMarkdown(text string) []*mdBlock {
+	var (
+		blocks []*mdBlock
+		cur    *mdBlock
+		gap    bool
+		lines  = strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	)
+	add := func(b *mdBlock) {
+		b.gap = gap && len(blocks) > 0
+		gap = false
+		blocks = append(blocks, b)
+		cur = b
+	}
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		switch {
+		case mdFenceLine.MatchString(line):
+			b := &mdBlock{kind: mdCode}
+			for i++; i < len(lines) && !mdFenceLine.MatchString(lines[i]); i++ {
+				b.lines = append(b.lines, lines[i])
+			}
+			add(b)
+			cur = nil
+		case strings.TrimSpace(line) == "":
+			gap = true
+			cur = nil
+		case mdHeadingLine.MatchString(line):
+			m := mdHeadingLine.FindStringSubmatch(line)
+			add(&mdBlock{kind: mdHeading, level: len(m[1]), lines: []string{m[2]}})
+			cur = nil
+		case mdBulletLine.MatchString(line) || mdOrderedLine.MatchString(line):
+			marker, item := "", ""
+			if m := mdOrderedLine.FindStringSubmatch(line); m != nil {
+				marker, item = m[1]+".", m[2]
+			} else {
+				item = mdBulletLine.FindStringSubmatch(line)[1]
+			}
+			if cur == nil || cur.kind != mdList || gap {
+				add(&mdBlock{kind: mdList})
+			}
+			cur.lines = append(cur.lines, item)
+			cur.markers = append(cur.markers, marker)
+		case mdQuoteLine.MatchString(line):
+			if cur == nil || cur.kind != mdQuote || gap {
+				add(&mdBlock{kind: mdQuote})
+			}
+			cur.lines = append(cur.lines, mdQuoteLine.FindStringSubmatch(line)[1])
+		default:
+			if cur == nil || cur.kind != mdParagraph || gap {
+				add(&mdBlock{kind: mdParagraph})
+			}
+			cur.lines = append(cur.lines, strings.TrimSpace(line))
+		}
+	}
+	return blocks
+}
+
+type mdInlineKind int
+
+const (
+	mdText mdInlineKind = iota
+	mdBold
+	mdItalic
+	mdStrike
+	mdCodeSpan
+	mdLink
+)
+
+// mdInline is an inline element of markdown. Text and code spans hold their
+// text, links their URL and children, other elements their children.
+type mdInline struct {
+	kind     mdInlineKind
+	text     string
+	url      string
+	children []*mdInline
+}
+
+// parseInline parses the inline elements of a line of markdown.
+func parseInline(s string) []*mdInline {
+	var (
+		nodes []*mdInline
+		buf   strings.Builder
+	)
+	flush := func() {
+		if buf.Len() > 0 {
+			nodes = append(nodes, &mdInline{kind: mdText, text: buf.String()})
+			buf.Reset()
+		}
+	}
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && isASCIIPunct(s[i+1]):
+			buf.WriteByte(s[i+1])
+			i += 2
+			continue
+		case c == '`':
+			if j := strings.IndexByte(s[i+1:], '`'); j >= 0 {
+				flush()
+				nodes = append(nodes, &mdInline{kind: mdCodeSpan, text: s[i+1 : i+1+j]})
+				i += j + 2
+				continue
+			}
+		case c == '*' || c == '_' || c == '~':
+			if delim, kind := emphasisDelim(s[i:]); delim != "" && canOpen(s, i, delim) {
+				if j := closeEmphasis(s, i+len(delim), delim); j >= 0 {
+					flush()
+					nodes = append(nodes, &mdInline{kind: kind, children: parseInline(s[i+len(delim) : j])})
+					i = j + len(delim)
+					continue
+				}
+			}
+		case c == '[':
+			if text, url, n := parseLink(s[i:]); n > 0 {
+				flush()
+				nodes = append(nodes, &mdInline{kind: mdLink, url: url, children: parseInline(text)})
+				i += n
+				continue
+			}
+		case c == '<':
+			if j := strings.IndexByte(s[i:], '>'); j > 0 && isAutolink(s[i+1:i+j]) {
+				flush()
+				url := s[i+1 : i+j]
+				nodes = append(nodes, &mdInline{kind: mdLink, url: url, children: []*mdInline{{kind: mdText, text: url}}})
+				i += j + 1
+				continue
+			}
+		}
+		buf.WriteByte(c)
+		i++
+	}
+	flush()
+	return nodes
+}
+
+func isASCIIPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+func isAlnum(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+func isAutolink(s string) bool {
+	if strings.ContainsAny(s, " \t<") {
+		return false
+	}
+	return hasLinkScheme(s)
+}
+
+// hasLinkScheme reports whether the URL has one of the schemes rendered as
+// links. Other schemes, such as javascript:, are rendered as text.
+func hasLinkScheme(url string) bool {
+	l := strings.ToLower(url)
+	return strings.HasPrefix(l, "http://") || strings.HasPrefix(l, "https://") || strings.HasPrefix(l, "mailto:")
+}
+
+// emphasisDelim returns the emphasis delimiter the string starts with and the
+// kind of emphasis.
+func emphasisDelim(s string) (string, mdInlineKind) {
+	switch {
+	case strings.HasPrefix(s, "**"), strings.HasPrefix(s, "__"):
+		return s[:2], mdBold
+	case strings.HasPrefix(s, "~~"):
+		return s[:2], mdStrike
+	case s[0] == '*' || s[0] == '_':
+		return s[:1], mdItalic
+	}
+	return "", mdText
+}
+
+// canOpen returns whether the delimiter at i opens emphasis: it must be
+// followed by a non-space and, for underscores, not be inside a word.
+func canOpen(s string, i int, delim string) bool {
+	next := i + len(delim)
+	if next >= len(s) || s[next] == ' ' || s[next] == '\t' {
+		return false
+	}
+	return delim[0] != '_' || i == 0 || !isAlnum(s[i-1])
+}
+
+// closeEmphasis returns the index of the delimiter closing emphasis opened
+// before start, or -1.
+func closeEmphasis(s string, start int, delim string) int {
+	for k := start; k < len(s); k++ {
+		switch {
+		case s[k] == '\\':
+			k++
+		case s[k] == '`':
+			if j := strings.IndexByte(s[k+1:], '`'); j >= 0 {
+				k += j + 1
+			}
+		case strings.HasPrefix(s[k:], delim):
+			if len(delim) == 1 && k+1 < len(s) && s[k+1] == delim[0] {
+				// Skip the nested strong delimiter.
+				k++
+				continue
+			}
+			if k == start || s[k-1] == ' ' || s[k-1] == '\t' {
+				continue
+			}
+			if delim[0] == '_' && k+len(delim) < len(s) && isAlnum(s[k+len(delim)]) {
+				continue
+			}
+			return k
+		}
+	}
+	return -1
+}
+
+// parseLink parses a [text](url) link at the start of the string and returns
+// its text, URL and length, or a zero length.
+func parseLink(s string) (string, string, int) {
+	depth := 0
+	for k := 0; k < len(s); k++ {
+		switch s[k] {
+		case '\\':
+			k++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth > 0 {
+				continue
+			}
+			if k+1 >= len(s) || s[k+1] != '(' {
+				return "", "", 0
+			}
+			end := strings.IndexByte(s[k+2:], ')')
+			if end < 0 {
+				return "", "", 0
+			}
+			url := strings.TrimSpace(s[k+2 : k+2+end])
+			if url == "" || strings.ContainsAny(url, " \t") {
+				return "", "", 0
+			}
+			return s[1:k], url, k + 3 + end
+		}
+	}
+	return "", "", 0
+}
+
+// markupStyle renders markdown in a markup.
+type markupStyle struct {
+	// escape escapes text, and escapeCode the content of code spans and
+	// blocks.
+	escape, escapeCode func(string) string
+	// bold, italic, strike and code are the opening and closing tags of
+	// inline elements.
+	bold, italic, strike, code [2]string
+	// link renders a link from its rendered text and URL.
+	link func(text, url string) string
+
+	heading   func(level int, text string) string
+	list      func(markers, items []string) string
+	quote     func(lines []string) string
+	codeBlock func(code string) string
+	paragraph func(lines []string) string
+	// separator separates blocks, and blank separates blocks preceded by a
+	// blank line.
+	separator, blank string
+}
+
+func (s *markupStyle) render(blocks []*mdBlock) string {
+	var b strings.Builder
+	for i, block := range blocks {
+		if i > 0 {
+			if block.gap {
+				b.WriteString(s.blank)
+			} else {
+				b.WriteString(s.separator)
+			}
+		}
+		switch block.kind {
+		case mdCode:
+			b.WriteString(s.codeBlock(s.escapeCode(strings.Join(block.lines, "\n"))))
+			continue
+		case mdHeading:
+			b.WriteString(s.heading(block.level, s.inline(block.lines[0])))
+			continue
+		}
+		lines := make([]string, 0, len(block.lines))
+		for _, l := range block.lines {
+			lines = append(lines, s.inline(l))
+		}
+		switch block.kind {
+		case mdList:
+			b.WriteString(s.list(block.markers, lines))
+		case mdQuote:
+			b.WriteString(s.quote(lines))
+		default:
+			b.WriteString(s.paragraph(lines))
+		}
+	}
+	return b.String()
+}
+
+func (s *markupStyle) inline(line string) string {
+	var b strings.Builder
+	s.writeInline(&b, parseInline(line))
+	return b.String()
+}
+
+func (s *markupStyle) writeInline(b *strings.Builder, nodes []*mdInline) {
+	for _, n := range nodes {
+		var tags [2]string
+		switch n.kind {
+		case mdText:
+			b.WriteString(s.escape(n.text))
+			continue
+		case mdCodeSpan:
+			b.WriteString(s.code[0] + s.escapeCode(n.text) + s.code[1])
+			continue
+		case mdLink:
+			var text strings.Builder
+			s.writeInline(&text, n.children)
+			if !hasLinkScheme(n.url) {
+				b.WriteString(text.String() + s.escape(" ("+n.url+")"))
+				continue
+			}
+			b.WriteString(s.link(text.String(), n.url))
+			continue
+		case mdBold:
+			tags = s.bold
+		case mdItalic:
+			tags = s.italic
+		case mdStrike:
+			tags = s.strike
+		}
+		b.WriteString(tags[0])
+		s.writeInline(b, n.children)
+		b.WriteString(tags[1])
+	}
+}
+
+// textList renders list items as lines with their markers, bullets for
+// unordered items.
+func textList(bullet string) func(markers, items []string) string {
+	return func(markers, items []string) string {
+		lines := make([]string, len(items))
+		for i, item := range items {
+			marker := markers[i]
+			if marker == "" {
+				marker = bullet
+			}
+			lines[i] = marker + " " + item
+		}
+		return strings.Join(lines, "\n")
+	}
+}
+
+func prefixLines(prefix string, lines []string) string {
+	return prefix + strings.Join(lines, "\n"+prefix)
+}
+
+func identity(s string) string { return s }
+
+var (
+	// htmlEscaper escapes the characters of HTML text, leaving quotes alone
+	// as they are only special in attributes.
+	htmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	// slackEscaper escapes the control characters of Slack mrkdwn.
+	slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	// slackURLEscaper escapes the URLs of Slack links, whose text starts
+	// after the first "|".
+	slackURLEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "|", "%7C")
+	// markdownEscaper escapes the characters of markdown that flavors of
+	// markdown may otherwise interpret.
+	markdownEscaper = strings.NewReplacer(
+		`\`, `\\`, "*", `\*`, "_", `\_`, "~", `\~`, "`", "\\`", "[", `\[`, "]", `\]`,
+		"<", `\<`, ">", `\>`, "#", `\#`,
+	)
+)
+
+func markdownStyle(lineBreak string) *markupStyle {
+	return &markupStyle{
+		escape:     markdownEscaper.Replace,
+		escapeCode: identity,
+		bold:       [2]string{"**", "**"},
+		italic:     [2]string{"*", "*"},
+		strike:     [2]string{"~~", "~~"},
+		code:       [2]string{"`", "`"},
+		link: func(text, url string) string {
+			return "[" + text + "](" + url + ")"
+		},
+		heading: func(level int, text string) string {
+			return strings.Repeat("#", level) + " " + text
+		},
+		list: func(markers, items []string) string {
+			return strings.ReplaceAll(textList("-")(markers, items), "\n", lineBreak)
+		},
+		quote: func(lines []string) string {
+			return strings.ReplaceAll(prefixLines("> ", lines), "\n", lineBreak)
+		},
+		codeBlock: func(code string) string {
+			return "```\n" + code + "\n```"
+		},
+		paragraph: func(lines []string) string {
+			return strings.Join(lines, lineBreak)
+		},
+		separator: lineBreak,
+		blank:     "\n\n",
+	}
+}
+
+var markupStyles = map[Markup]*markupStyle{
+	MarkupPlain: {
+		escape:     identity,
+		escapeCode: identity,
+		link: func(text, url string) string {
+			if text == url || strings.TrimPrefix(url, "mailto:") == text {
+				return text
+			}
+			return text + " (" + url + ")"
+		},
+		heading:   func(_ int, text string) string { return text },
+		list:      textList("-"),
+		quote:     func(lines []string) string { return prefixLines("> ", lines) },
+		codeBlock: identity,
+		paragraph: func(lines []string) string { return strings.Join(lines, "\n") },
+		separator: "\n",
+		blank:     "\n\n",
+	},
+	MarkupHTML: {
+		escape:     htmlEscaper.Replace,
+		escapeCode: htmlEscaper.Replace,
+		bold:       [2]string{"<strong>", "</strong>"},
+		italic:     [2]string{"<em>", "</em>"},
+		strike:     [2]string{"<del>", "</del>"},
+		code:       [2]string{"<code>", "</code>"},
+		link: func(text, url string) string {
+			return `<a href="` + html.EscapeString(url) + `">` + text + "</a>"
+		},
+		heading: func(level int, text string) string {
+			return fmt.Sprintf("<h%d>%s</h%d>", level, text, level)
+		},
+		list: func(markers, items []string) string {
+			tag := "ul"
+			if markers[0] != "" {
+				tag = "ol"
+			}
+			return "<" + tag + "><li>" + strings.Join(items, "</li><li>") + "</li></" + tag + ">"
+		},
+		quote: func(lines []string) string {
+			return "<blockquote>" + strings.Join(lines, "<br>\n") + "</blockquote>"
+		},
+		codeBlock: func(code string) string {
+			return "<pre><code>" + code + "</code></pre>"
+		},
+		paragraph: func(lines []string) string {
+			return "<p>" + strings.Join(lines, "<br>\n") + "</p>"
+		},
+		separator: "\n",
+		blank:     "\n",
+	},
+	MarkupSlack: {
+		escape:     slackEscaper.Replace,
+		escapeCode: slackEscaper.Replace,
+		bold:       [2]string{"*", "*"},
+		italic:     [2]string{"_", "_"},
+		strike:     [2]string{"~", "~"},
+		code:       [2]string{"`", "`"},
+		link: func(text, url string) string {
+			return "<" + slackURLEscaper.Replace(url) + "|" + text + ">"
+		},
+		heading:   func(_ int, text string) string { return "*" + text + "*" },
+		list:      textList("•"),
+		quote:     func(lines []string) string { return prefixLines("> ", lines) },
+		codeBlock: func(code string) string { return "```\n" + code + "\n```" },
+		paragraph: func(lines []string) string { return strings.Join(lines, "\n") },
+		separator: "\n",
+		blank:     "\n\n",
+	},
+	MarkupTelegram: {
+		escape:     htmlEscaper.Replace,
+		escapeCode: htmlEscaper.Replace,
+		bold:       [2]string{"<b>", "</b>"},
+		italic:     [2]string{"<i>", "</i>"},
+		strike:     [2]string{"<s>", "</s>"},
+		code:       [2]string{"<code>", "</code>"},
+		link: func(text, url string) string {
+			return `<a href="` + html.EscapeString(url) + `">` + text + "</a>"
+		},
+		heading: func(_ int, text string) string { return "<b>" + text + "</b>" },
+		list:    textList("•"),
+		quote: func(lines []string) string {
+			return "<blockquote>" + strings.Join(lines, "\n") + "</blockquote>"
+		},
+		codeBlock: func(code string) string { return "<pre>" + code + "</pre>" },
+		paragraph: func(lines []string) string { return strings.Join(lines, "\n") },
+		separator: "\n",
+		blank:     "\n\n",
+	},
+	MarkupDiscord: markdownStyle("\n"),
+	MarkupMSTeams: markdownStyle("\n\n"),
+}
@@ -0,0 +1,125 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertMarkdown(t *testing.T) {
+	for _, tc := range []struct {
+		title string
+		in    string
+		exp   map[Markup]string
+	}{
+		{
+			title: "inline elements",
+			in:    "**bold** *italic* _italic_ ~~strike~~ `a < b` [link](https://example.com/?a=1&b=2)",
+			exp: map[Markup]string{
+				MarkupPlain:    "bold italic italic strike a < b link (https://example.com/?a=1&b=2)",
+				MarkupHTML:     `<p><strong>bold</strong> <em>italic</em> <em>italic</em> <del>strike</del> <code>a &lt; b</code> <a href="https://example.com/?a=1&amp;b=2">link</a></p>`,
+				MarkupSlack:    "*bold* _italic_ _italic_ ~strike~ `a &lt; b` <https://example.com/?a=1&amp;b=2|link>",
+				MarkupTelegram: `<b>bold</b> <i>italic</i> <i>italic</i> <s>strike</s> <code>a &lt; b</code> <a href="https://example.com/?a=1&amp;b=2">link</a>`,
+				MarkupDiscord:  "**bold** *italic* *italic* ~~strike~~ `a < b` [link](https://example.com/?a=1&b=2)",
+				MarkupMSTeams:  "**bold** *italic* *italic* ~~strike~~ `a < b` [link](https://example.com/?a=1&b=2)",
+			},
+		},
+		{
+			title: "nested emphasis and autolinks",
+			in:    "*see **this** at <https://example.com>*",
+			exp: map[Markup]string{
+				MarkupPlain:    "see this at https://example.com",
+				MarkupHTML:     `<p><em>see <strong>this</strong> at <a href="https://example.com">https://example.com</a></em></p>`,
+				MarkupSlack:    "_see *this* at <https://example.com|https://example.com>_",
+				MarkupTelegram: `<i>see <b>this</b> at <a href="https://example.com">https://example.com</a></i>`,
+				MarkupDiscord:  "*see **this** at [https://example.com](https://example.com)*",
+			},
+		},
+		{
+			title: "text is escaped",
+			in:    `a <b> & c \*d\* snake_case_name 2 * 3`,
+			exp: map[Markup]string{
+				MarkupPlain:    "a <b> & c *d* snake_case_name 2 * 3",
+				MarkupHTML:     "<p>a &lt;b&gt; &amp; c *d* snake_case_name 2 * 3</p>",
+				MarkupSlack:    "a &lt;b&gt; &amp; c *d* snake_case_name 2 * 3",
+				MarkupTelegram: "a &lt;b&gt; &amp; c *d* snake_case_name 2 * 3",
+				MarkupDiscord:  `a \<b\> & c \*d\* snake\_case\_name 2 \* 3`,
+			},
+		},
+		{
+			title: "blocks",
+			in:    "# Alerts\n\n- **a** firing\n- b firing\n\n> quoted\n> twice\nline\nbreak\n\n```\nx := <-ch\n```",
+			exp: map[Markup]string{
+				MarkupPlain:    "Alerts\n\n- a firing\n- b firing\n\n> quoted\n> twice\nline\nbreak\n\nx := <-ch",
+				MarkupHTML:     "<h1>Alerts</h1>\n<ul><li><strong>a</strong> firing</li><li>b firing</li></ul>\n<blockquote>quoted<br>\ntwice</blockquote>\n<p>line<br>\nbreak</p>\n<pre><code>x := &lt;-ch</code></pre>",
+				MarkupSlack:    "*Alerts*\n\n• *a* firing\n• b firing\n\n> quoted\n> twice\nline\nbreak\n\n```\nx := &lt;-ch\n```",
+				MarkupTelegram: "<b>Alerts</b>\n\n• <b>a</b> firing\n• b firing\n\n<blockquote>quoted\ntwice</blockquote>\nline\nbreak\n\n<pre>x := &lt;-ch</pre>",
+				MarkupDiscord:  "# Alerts\n\n- **a** firing\n- b firing\n\n> quoted\n> twice\nline\nbreak\n\n```\nx := <-ch\n```",
+				MarkupMSTeams:  "# Alerts\n\n- **a** firing\n\n- b firing\n\n> quoted\n\n> twice\n\nline\n\nbreak\n\n```\nx := <-ch\n```",
+			},
+		},
+		{
+			title: "ordered list",
+			in:    "1. first\n2. second",
+			exp: map[Markup]string{
+				MarkupPlain:    "1. first\n2. second",
+				MarkupHTML:     "<ol><li>first</li><li>second</li></ol>",
+				MarkupSlack:    "1. first\n2. second",
+				MarkupTelegram: "1. first\n2. second",
+			},
+		},
+		{
+			title: "links of other schemes",
+			in:    "[x](javascript:alert(1)) y (JavaScript:alert%281%29) [z](HTTPS://example.com)",
+			exp: map[Markup]string{
+				MarkupPlain:    "x (javascript:alert(1)) y (JavaScript:alert%281%29) z (HTTPS://example.com)",
+				MarkupHTML:     `<p>x (javascript:alert(1)) y (JavaScript:alert%281%29) <a href="HTTPS://example.com">z</a></p>`,
+				MarkupTelegram: `x (javascript:alert(1)) y (JavaScript:alert%281%29) <a href="HTTPS://example.com">z</a>`,
+			},
+		},
+		{
+			title: "slack link URLs",
+			in:    "[x](https://example.com/?q=a|b>c)",
+			exp: map[Markup]string{
+				MarkupSlack: "<https://example.com/?q=a%7Cb&gt;c|x>",
+			},
+		},
+		{
+			title: "unmatched delimiters",
+			in:    "**open *half [text] (url) <notalink> `tick",
+			exp: map[Markup]string{
+				MarkupPlain: "**open *half [text] (url) <notalink> `tick",
+				MarkupHTML:  "<p>**open *half [text] (url) &lt;notalink&gt; `tick</p>",
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			for markup, exp := range tc.exp {
+				require.Equal(t, exp, ConvertMarkdown(tc.in, markup), "markup %d", markup)
+			}
+		})
+	}
+}
+
+func TestEscapeMarkdown(t *testing.T) {
+	value := "*db_1* <prod> [eu] `x` # \\"
+	escaped := EscapeMarkdown(value)
+	require.Equal(t, "\\*db\\_1\\* \\<prod\\> \\[eu\\] \\`x\\` \\# \\\\", escaped)
+
+	require.Equal(t, value, ConvertMarkdown(escaped, MarkupPlain))
+	require.Equal(t, "<b>*db_1* &lt;prod&gt; [eu] `x` # \\</b>", ConvertMarkdown("**"+escaped+"**", MarkupTelegram))
+}
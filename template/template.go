@@ -39,19 +39,23 @@ type Template struct {
 	text *tmpltext.Template
 	html *tmplhtml.Template
 
+	catalog *catalog
+	locale  language.Tag
+
 	ExternalURL *url.URL
 }
 
 // Option is generic modifier of the text and html templates used by a Template.
 type Option func(text *tmpltext.Template, html *tmplhtml.Template)
 
-// New returns a new Template with the DefaultFuncs added. The DefaultFuncs
-// have precedence over any added custom functions. Options allow customization
-// of the text and html templates in given order.
+// New returns a new Template with the DefaultFuncs and the locale functions
+// added. The DefaultFuncs have precedence over any added custom functions.
+// Options allow customization of the text and html templates in given order.
 func New(options ...Option) (*Template, error) {
 	t := &Template{
-		text: tmpltext.New("").Option("missingkey=zero"),
-		html: tmplhtml.New("").Option("missingkey=zero"),
+		text:    tmpltext.New("").Option("missingkey=zero"),
+		html:    tmplhtml.New("").Option("missingkey=zero"),
+		catalog: newCatalog(),
 	}
 
 	for _, o := range options {
@@ -60,6 +64,7 @@ func New(options ...Option) (*Template, error) {
 
 	t.text.Funcs(tmpltext.FuncMap(DefaultFuncs))
 	t.html.Funcs(tmplhtml.FuncMap(DefaultFuncs))
+	t.addLocaleFuncs()
 
 	return t, nil
 }
@@ -86,6 +91,15 @@ func FromGlobs(paths []string, options ...Option) (*Template, error) {
 		f.Close()
 	}
 
+	f, err := asset.Assets.Open("/templates/translations.yml")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if err := t.catalog.load(f); err != nil {
+		return nil, err
+	}
+
 	for _, tp := range paths {
 		if err := t.FromGlob(tp); err != nil {
 			return nil, err
@@ -192,6 +206,22 @@ var DefaultFuncs = FuncMap{
 	"stringSlice": func(s ...string) []string {
 		return s
 	},
+	"humanize":         humanize,
+	"humanizeDuration": humanizeDuration,
+	"since":            time.Since,
+	"tz":               tz,
+	// date formats a time with a Go reference layout and inverts the
+	// argument order of time.Time.Format for easier pipelining in templates.
+	"date": func(layout string, t time.Time) string {
+		return t.Format(layout)
+	},
+	"toJson":         toJSON,
+	"sortAlertsBy":   sortAlertsBy,
+	"groupAlertsBy":  groupAlertsBy,
+	"urlQueryEscape": url.QueryEscape,
+	"dict":           dict,
+	"list":           list,
+	"escapeMarkdown": EscapeMarkdown,
 }
 
 // Pair is a key/value string pair.
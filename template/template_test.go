@@ -506,6 +506,60 @@ func TestTemplateFuncs(t *testing.T) {
 		title: "Template using reReplaceAll",
 		in:    `{{ reReplaceAll "ab" "AB" "abc" }}`,
 		exp:   "ABc",
+	}, {
+		title: "Template using humanize",
+		in:    `{{ humanize 1234567 }} {{ humanize "0.00123" }} {{ humanize 0 }} {{ humanize 12.5 }}`,
+		exp:   "1.235M 1.23m 0 12.5",
+	}, {
+		title: "Template using humanizeDuration",
+		in:    `{{ humanizeDuration 93784 }} {{ humanizeDuration "3723" }} {{ humanizeDuration 61 }} {{ humanizeDuration 1.5 }} {{ humanizeDuration 0.0012 }} {{ humanizeDuration . }}`,
+		data:  90 * time.Minute,
+		exp:   "1d 2h 3m 4s 1h 2m 3s 1m 1s 1.5s 1.2ms 1h 30m 0s",
+	}, {
+		title: "Template using since",
+		in:    `{{ . | since | humanizeDuration }}`,
+		data:  time.Now().Add(-2 * time.Hour),
+		exp:   "2h 0m 0s",
+	}, {
+		title: "Template using tz and date",
+		in:    `{{ . | tz "Europe/Paris" | date "2006-01-02 15:04 MST" }}`,
+		data:  time.Date(2024, 7, 1, 12, 30, 0, 0, time.UTC),
+		exp:   "2024-07-01 14:30 CEST",
+	}, {
+		title: "Template using toJson",
+		in:    `{{ toJson . }}`,
+		data:  map[string]interface{}{"a": []int{1, 2}},
+		exp:   `{"a":[1,2]}`,
+	}, {
+		title: "Template using sortAlertsBy",
+		in:    `{{ range sortAlertsBy "instance" . }}{{ .Labels.instance }} {{ end }}/ {{ range sortAlertsBy "-startsAt" . }}{{ .Labels.instance }} {{ end }}`,
+		data: Alerts{
+			{Labels: KV{"instance": "b"}, StartsAt: time.Unix(2, 0)},
+			{Labels: KV{"instance": "c"}, StartsAt: time.Unix(1, 0)},
+			{Labels: KV{"instance": "a"}, StartsAt: time.Unix(3, 0)},
+		},
+		exp: "a b c / a b c ",
+	}, {
+		title: "Template using groupAlertsBy",
+		in:    `{{ range $job, $alerts := groupAlertsBy "job" . }}{{ $job }}:{{ len $alerts }} {{ end }}`,
+		data: Alerts{
+			{Labels: KV{"job": "node"}},
+			{Labels: KV{"job": "api"}},
+			{Labels: KV{"job": "node"}},
+		},
+		exp: "api:1 node:2 ",
+	}, {
+		title: "Template using urlQueryEscape",
+		in:    `{{ urlQueryEscape "a b&c" }}`,
+		exp:   "a+b%26c",
+	}, {
+		title: "Template using dict and list",
+		in:    `{{ $d := dict "name" "api" "ports" (list 80 443) }}{{ $d.name }} {{ index $d.ports 1 }} {{ len $d.ports }}`,
+		exp:   "api 443 2",
+	}, {
+		title: "Template using escapeMarkdown",
+		in:    `{{ "*db_1*" | escapeMarkdown }}`,
+		exp:   `\*db\_1\*`,
 	}} {
 		tc := tc
 		t.Run(tc.title, func(t *testing.T) {
@@ -523,3 +577,34 @@ func TestTemplateFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplateFuncsErrors(t *testing.T) {
+	tmpl, err := FromGlobs([]string{})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		in  string
+		err string
+	}{
+		{in: `{{ humanize "abc" }}`, err: `invalid syntax`},
+		{in: `{{ humanizeDuration true }}`, err: `can't convert bool to float`},
+		{in: `{{ . | tz "Nowhere/Unknown" }}`, err: `unknown time zone Nowhere/Unknown`},
+		{in: `{{ dict "a" }}`, err: `dict requires an even number of arguments`},
+		{in: `{{ dict 1 2 }}`, err: `dict keys must be strings, got int`},
+	} {
+		t.Run(tc.in, func(t *testing.T) {
+			_, err := tmpl.ExecuteTextString(tc.in, time.Now())
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestTemplateFuncsHTML(t *testing.T) {
+	tmpl, err := FromGlobs([]string{})
+	require.NoError(t, err)
+
+	got, err := tmpl.ExecuteHTMLString(`<p title="{{ urlQueryEscape .q }}">{{ humanize .v }}</p> {{ toJson . }}`, map[string]interface{}{"q": "a b", "v": 2048})
+	require.NoError(t, err)
+	require.Equal(t, `<p title="a&#43;b">2.048k</p> {&#34;q&#34;:&#34;a b&#34;,&#34;v&#34;:2048}`, got)
+}
@@ -46,12 +46,22 @@ type AlertStatus struct {
 	State       AlertState `json:"state"`
 	SilencedBy  []string   `json:"silencedBy"`
 	InhibitedBy []string   `json:"inhibitedBy"`
+	// Acknowledgement is set if someone acknowledged the alert since it
+	// started firing. It does not affect the state of the alert.
+	Acknowledgement *Acknowledgement `json:"acknowledgement,omitempty"`
 
 	// For internal tracking, not exposed in the API.
 	pendingSilences []string
 	silencesVersion int
 }
 
+// Acknowledgement records that someone is looking into a firing alert.
+type Acknowledgement struct {
+	AcknowledgedBy string    `json:"acknowledgedBy"`
+	Comment        string    `json:"comment"`
+	AcknowledgedAt time.Time `json:"acknowledgedAt"`
+}
+
 // Marker helps to mark alerts as silenced and/or inhibited.
 // All methods are goroutine-safe.
 type Marker interface {
@@ -71,6 +81,10 @@ type Marker interface {
 	// AlertStateActive. Otherwise, it sets the provided alert to
 	// AlertStateSuppressed.
 	SetInhibited(alert model.Fingerprint, alertIDs ...string)
+	// SetAcknowledged replaces the acknowledgement of the given alert. A nil
+	// acknowledgement clears it. Acknowledging an alert does not change its
+	// AlertState.
+	SetAcknowledged(alert model.Fingerprint, ack *Acknowledgement)
 
 	// Count alerts of the given state(s). With no state provided, count all
 	// alerts.
@@ -90,6 +104,7 @@ type Marker interface {
 	Active(model.Fingerprint) bool
 	Silenced(model.Fingerprint) (activeIDs, pendingIDs []string, version int, silenced bool)
 	Inhibited(model.Fingerprint) ([]string, bool)
+	Acknowledged(model.Fingerprint) (*Acknowledgement, bool)
 }
 
 // NewMarker returns an instance of a Marker implementation.
@@ -200,6 +215,26 @@ func (m *memMarker) SetInhibited(alert model.Fingerprint, ids ...string) {
 	s.State = AlertStateSuppressed
 }
 
+// SetAcknowledged implements Marker.
+func (m *memMarker) SetAcknowledged(alert model.Fingerprint, ack *Acknowledgement) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	s, found := m.m[alert]
+	if !found {
+		if ack == nil {
+			return
+		}
+		s = &AlertStatus{
+			State:       AlertStateUnprocessed,
+			SilencedBy:  []string{},
+			InhibitedBy: []string{},
+		}
+		m.m[alert] = s
+	}
+	s.Acknowledgement = ack
+}
+
 // Status implements Marker.
 func (m *memMarker) Status(alert model.Fingerprint) AlertStatus {
 	m.mtx.RLock()
@@ -240,6 +275,12 @@ func (m *memMarker) Inhibited(alert model.Fingerprint) ([]string, bool) {
 		s.State == AlertStateSuppressed && len(s.InhibitedBy) > 0
 }
 
+// Acknowledged implements Marker.
+func (m *memMarker) Acknowledged(alert model.Fingerprint) (*Acknowledgement, bool) {
+	s := m.Status(alert)
+	return s.Acknowledgement, s.Acknowledgement != nil
+}
+
 // Silenced returns whether the alert for the given Fingerprint is in the
 // Silenced state, any associated silence IDs, and the silences state version
 // the result is based on.
@@ -476,6 +517,9 @@ const (
 	SilenceStateExpired SilenceState = "expired"
 	SilenceStateActive  SilenceState = "active"
 	SilenceStatePending SilenceState = "pending"
+	// SilenceStatePendingApproval is the state of a silence that has not
+	// ended yet and needs to be approved before it mutes alerts.
+	SilenceStatePendingApproval SilenceState = "pending_approval"
 )
 
 // CalcSilenceState returns the SilenceState that a silence with the given start
@@ -81,6 +81,34 @@ func TestMemMarker_Count(t *testing.T) {
 	require.Equal(t, 3, countTotal())
 }
 
+func TestMemMarker_Acknowledged(t *testing.T) {
+	marker := NewMarker(prometheus.NewRegistry())
+	var fp model.Fingerprint = 1
+
+	// Clearing the acknowledgement of an unknown alert does not track it.
+	marker.SetAcknowledged(fp, nil)
+	_, ok := marker.Acknowledged(fp)
+	require.False(t, ok)
+	require.Equal(t, 0, marker.Count())
+
+	ack := &Acknowledgement{AcknowledgedBy: "alice", Comment: "looking into it", AcknowledgedAt: time.Now()}
+	marker.SetAcknowledged(fp, ack)
+	got, ok := marker.Acknowledged(fp)
+	require.True(t, ok)
+	require.Equal(t, ack, got)
+	require.Equal(t, AlertStateUnprocessed, marker.Status(fp).State)
+
+	// Acknowledgements do not change the state of alerts.
+	marker.SetActiveOrSilenced(fp, 0, nil, nil)
+	require.Equal(t, AlertStateActive, marker.Status(fp).State)
+	require.Equal(t, ack, marker.Status(fp).Acknowledgement)
+
+	marker.SetAcknowledged(fp, nil)
+	_, ok = marker.Acknowledged(fp)
+	require.False(t, ok)
+	require.Equal(t, AlertStateActive, marker.Status(fp).State)
+}
+
 func TestAlertMerge(t *testing.T) {
 	now := time.Now()
 